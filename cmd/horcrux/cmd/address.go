@@ -7,13 +7,13 @@ import (
 	"strings"
 
 	"github.com/cometbft/cometbft/crypto"
-	cometprivval "github.com/cometbft/cometbft/privval"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/horcrux/v3/signer"
 )
 
 type AddressCmdOutput struct {
+	KeyType           string
 	HexAddress        string
 	PubKey            string
 	ValConsAddress    string
@@ -29,6 +29,10 @@ func addressCmd() *cobra.Command {
 		Args:         cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
+			if err := setKeyUnlockProvider(cmd); err != nil {
+				return err
+			}
+
 			var pubKey crypto.PubKey
 
 			chainID := args[0]
@@ -61,7 +65,10 @@ func addressCmd() *cobra.Command {
 					return fmt.Errorf("error reading priv-validator key: %w, check that key is present for chain ID: %s", err, chainID)
 				}
 
-				filePV := cometprivval.LoadFilePVEmptyState(keyFile, "")
+				filePV, err := signer.LoadFilePV(keyFile, "", false)
+				if err != nil {
+					return fmt.Errorf("error reading priv-validator key: %w", err)
+				}
 				pubKey = filePV.Key.PubKey
 			default:
 				panic(fmt.Errorf("unexpected sign mode: %s", config.Config.SignMode))
@@ -75,6 +82,7 @@ func addressCmd() *cobra.Command {
 			}
 
 			output := AddressCmdOutput{
+				KeyType:    pubKey.Type(),
 				HexAddress: strings.ToUpper(hex.EncodeToString(pubKeyAddress)),
 				PubKey:     pubKeyJSON,
 			}
@@ -107,5 +115,7 @@ func addressCmd() *cobra.Command {
 		},
 	}
 
+	addUnlockFlags(cmd)
+
 	return cmd
 }
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer"
+)
+
+const (
+	flagAuditFile = "file"
+	flagFrom      = "from"
+	flagTo        = "to"
+	flagOutput    = "output"
+)
+
+// openAuditJournal opens the audit journal if it is enabled in the config, otherwise it returns nil.
+func openAuditJournal(logger cometlog.Logger) (*signer.AuditJournal, error) {
+	if !config.Config.AuditJournal {
+		return nil, nil
+	}
+	journal, err := signer.OpenAuditJournal(logger, config.AuditJournalFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit journal: %w", err)
+	}
+	return journal, nil
+}
+
+func auditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the journal of signatures produced by horcrux",
+		Long: `Inspect the journal of signatures produced by horcrux, enabled with auditJournal: true in the config.
+
+In threshold mode, each cosigner journals the signatures it produced as raft leader,
+and the raw bytes signatures it coordinated, so the journals of all cosigners together
+cover every signature of the cluster.`,
+	}
+
+	cmd.PersistentFlags().String(flagAuditFile, "", "audit journal file (default is the journal in the state directory)")
+
+	cmd.AddCommand(auditListCmd())
+	cmd.AddCommand(auditVerifyCmd())
+	cmd.AddCommand(auditExportCmd())
+
+	return cmd
+}
+
+func addAuditFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagChainID, "", "only include entries for this chain ID")
+	cmd.Flags().Int64(flagFrom, 0, "only include entries at or above this height")
+	cmd.Flags().Int64(flagTo, 0, "only include entries at or below this height")
+}
+
+func auditFile(cmd *cobra.Command) string {
+	if file, _ := cmd.Flags().GetString(flagAuditFile); file != "" {
+		return file
+	}
+	return config.AuditJournalFile()
+}
+
+func auditFilter(cmd *cobra.Command) signer.AuditFilter {
+	chainID, _ := cmd.Flags().GetString(flagChainID)
+	from, _ := cmd.Flags().GetInt64(flagFrom)
+	to, _ := cmd.Flags().GetInt64(flagTo)
+	return signer.AuditFilter{ChainID: chainID, FromHeight: from, ToHeight: to}
+}
+
+func auditListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Aliases:      []string{"ls"},
+		Short:        "List journaled signatures",
+		Example:      `horcrux audit list --chain-id cosmoshub-4 --from 18000000 --to 18000100`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter := auditFilter(cmd)
+			out := cmd.OutOrStdout()
+			return signer.ReadAuditJournal(auditFile(cmd), func(e signer.AuditEntry) error {
+				if !filter.Match(e) {
+					return nil
+				}
+				fmt.Fprintf(out, "%d %s %s %d/%d/%d %s leader=%d cosigners=%v sign_bytes_hash=%s signature=%s",
+					e.Index, e.Time.Format("2006-01-02T15:04:05.000Z"), e.ChainID, e.Height, e.Round, e.Step,
+					e.Type, e.Leader, e.Cosigners, e.SignBytesHash, base64.StdEncoding.EncodeToString(e.Signature))
+				if e.UniqueID != "" {
+					fmt.Fprintf(out, " unique_id=%s", e.UniqueID)
+				}
+				fmt.Fprintln(out)
+				return nil
+			})
+		},
+	}
+	addAuditFilterFlags(cmd)
+	return cmd
+}
+
+func auditVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "verify",
+		Short:        "Check that no journal entries have been modified or removed",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			count, err := signer.VerifyAuditJournal(auditFile(cmd))
+			if err != nil {
+				return fmt.Errorf("verified %d entries: %w", count, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Verified %d entries\n", count)
+			return nil
+		},
+	}
+}
+
+func auditExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export journaled signatures as JSON lines",
+		Long: `Export journaled signatures as JSON lines, one entry per line.
+
+Entries keep their hashes, so an export of the whole journal can be verified with
+horcrux audit verify --file.`,
+		Example:      `horcrux audit export --chain-id cosmoshub-4 --output cosmoshub-4-audit.jsonl`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var out io.Writer = cmd.OutOrStdout()
+			if output, _ := cmd.Flags().GetString(flagOutput); output != "" {
+				f, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			filter := auditFilter(cmd)
+			enc := json.NewEncoder(out)
+			return signer.ReadAuditJournal(auditFile(cmd), func(e signer.AuditEntry) error {
+				if !filter.Match(e) {
+					return nil
+				}
+				return enc.Encode(e)
+			})
+		},
+	}
+	addAuditFilterFlags(cmd)
+	cmd.Flags().StringP(flagOutput, "o", "", "write to this file instead of stdout")
+	return cmd
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+)
+
+const flagShardID = "shard-id"
+
+func clusterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Change the cosigner membership of a running cluster",
+		Long: `Change the cosigner membership of a running cluster.
+
+Changes are coordinated by the raft leader. Every cosigner updates its raft voters,
+its peer cosigners, and the cosigners in its config.yaml without restarting.`,
+	}
+
+	cmd.AddCommand(
+		clusterAddCmd(),
+		clusterRemoveCmd(),
+		clusterReplaceCmd(),
+	)
+
+	cmd.PersistentFlags().Duration(flagTimeout, 30*time.Second, "time to wait for the change to commit")
+
+	return cmd
+}
+
+func clusterAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add p2p-addr",
+		Short: "Add a cosigner to the cluster",
+		Long: `Add a cosigner to the cluster.
+
+The new cosigner must already be running with the updated cosigner list in its config.yaml,
+and its ECIES public key must be present in the ecies_keys.json of every cosigner.
+If --shard-id is not provided, the next unused shard ID is assigned.`,
+		Example:      `horcrux cluster add tcp://cosigner-4:2222`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shardID, _ := cmd.Flags().GetInt(flagShardID)
+			return changeMembership(cmd, proto.MembershipOp_MEMBERSHIP_OP_ADD, shardID, args[0])
+		},
+	}
+
+	cmd.Flags().Int(flagShardID, 0, "shard ID of the new cosigner")
+
+	return cmd
+}
+
+func clusterRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove shard-id",
+		Short: "Remove a cosigner from the cluster",
+		Long: `Remove a cosigner from the cluster.
+
+The raft leader cannot remove itself, transfer leadership first with horcrux elect.
+The remaining cosigners must still meet the threshold.`,
+		Example:      `horcrux cluster remove 3`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shardID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid shard ID %q: %w", args[0], err)
+			}
+			return changeMembership(cmd, proto.MembershipOp_MEMBERSHIP_OP_REMOVE, shardID, "")
+		},
+	}
+}
+
+func clusterReplaceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "replace shard-id p2p-addr",
+		Short: "Move a cosigner to a new host",
+		Long: `Move a cosigner to a new host, e.g. to replace a failed host.
+
+The new host must already be running with the same shard ID, key shards, and ECIES key
+as the cosigner it replaces, and the updated cosigner list in its config.yaml.`,
+		Example:      `horcrux cluster replace 2 tcp://cosigner-2b:2222`,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shardID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid shard ID %q: %w", args[0], err)
+			}
+			return changeMembership(cmd, proto.MembershipOp_MEMBERSHIP_OP_REPLACE, shardID, args[1])
+		},
+	}
+}
+
+func changeMembership(cmd *cobra.Command, op proto.MembershipOp, shardID int, p2pAddr string) error {
+	timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+
+	conn, err := dialLeader()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+
+	res, err := proto.NewCosignerClient(conn).ChangeMembership(ctx, &proto.ChangeMembershipRequest{
+		Op:      op,
+		ShardID: int32(shardID),
+		P2PAddr: p2pAddr,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Cluster membership changed for cosigner %d\n", res.ShardID)
+
+	return nil
+}
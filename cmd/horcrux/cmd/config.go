@@ -138,7 +138,8 @@ for threshold signer mode, --cosigner flags and --threshold flag are required.
 		`sign mode, "threshold" (recommended) or "single" (unsupported). threshold mode requires --cosigner (multiple) and --threshold`, //nolint
 	)
 	f.StringSliceP(flagNode, "n", []string{}, "chain nodes in format tcp://{node-addr}:{privval-port} \n"+
-		"(e.g. --node tcp://sentry-1:1234 --node tcp://sentry-2:1234 --node tcp://sentry-3:1234 )")
+		"or unix://{socket-path} (e.g. --node tcp://sentry-1:1234 --node tcp://sentry-2:1234 \n"+
+		"--node unix:///var/run/sentry-3/privval.sock )")
 
 	f.StringSliceP(flagCosigner, "c", []string{},
 		`cosigners in format tcp://{cosigner-addr}:{p2p-port}
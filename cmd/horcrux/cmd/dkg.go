@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer"
+)
+
+const flagTimeout = "timeout"
+
+func dkgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dkg chain-id",
+		Short: "Generate a new validator key with the running cosigners, without a dealer",
+		Long: `Run distributed key generation between all configured cosigners.
+
+Every cosigner must be running horcrux start with the same threshold and cosigner list.
+Each cosigner writes only its own {chain-id}_shard.json; the full private key never exists.
+This command only relays public commitments and encrypted shares, so it can run on any cosigner.`,
+		Example:      `horcrux dkg cosmoshub-4`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.Config.ValidateThresholdModeConfig(); err != nil {
+				return err
+			}
+			thresholdCfg := config.Config.ThresholdModeConfig
+
+			timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+
+			cosignerTLS, err := config.CosignerTLS()
+			if err != nil {
+				return err
+			}
+
+			participants := make([]signer.DKGParticipant, len(thresholdCfg.Cosigners))
+			for i, c := range thresholdCfg.Cosigners {
+				participants[i], err = signer.NewRemoteCosigner(c.ShardID, c.P2PAddr, cosignerTLS)
+				if err != nil {
+					return fmt.Errorf("failed to initialize remote cosigner %d: %w", c.ShardID, err)
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			pubKey, err := signer.RunDKG(ctx, args[0], thresholdCfg.Threshold, participants)
+			if err != nil {
+				return err
+			}
+
+			pubKeyJSON, err := signer.PubKey("", pubKey)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Created %d-of-%d key shards for %s\nHexAddress: %s\nPubKey: %s\n",
+				thresholdCfg.Threshold, len(participants), args[0],
+				strings.ToUpper(hex.EncodeToString(pubKey.Address())), pubKeyJSON)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration(flagTimeout, time.Minute, "time to wait for all cosigners to complete")
+
+	return cmd
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometos "github.com/cometbft/cometbft/libs/os"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+)
+
+const (
+	flagListen    = "listen"
+	flagStateFile = "state-file"
+	flagAddress   = "address"
+	flagTLSCert   = "cert"
+	flagTLSKey    = "key"
+)
+
+func fenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fence",
+		Short: "Run or inspect the lock service fencing an active and a standby cluster",
+		Long: `Run or inspect the lock service which grants the signing lease to one of an active
+and a standby horcrux cluster holding shards of the same key.
+
+Configure the leader of each cluster to hold the lease before signing with:
+
+thresholdMode:
+  fence:
+    type: lock-service
+    clusterID: us-east
+    address: tcp://10.0.0.5:2300
+    leaseDuration: 30s
+
+The standby cluster can only take over the lease once the lease of the active cluster has expired,
+and only sign above the watermark of the active cluster.`,
+	}
+
+	cmd.AddCommand(fenceServeCmd())
+	cmd.AddCommand(fenceStatusCmd())
+
+	return cmd
+}
+
+func addFenceTLSFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagCACert, "", "CA certificate which signed the certificates of the lock service and its clients")
+	cmd.Flags().String(flagTLSCert, "", "TLS certificate")
+	cmd.Flags().String(flagTLSKey, "", "TLS private key")
+}
+
+// fenceTLSFromFlags loads the TLS config from the TLS flags. It returns nil if no flags are set.
+func fenceTLSFromFlags(cmd *cobra.Command, server bool) (*tls.Config, error) {
+	caCert, _ := cmd.Flags().GetString(flagCACert)
+	cert, _ := cmd.Flags().GetString(flagTLSCert)
+	key, _ := cmd.Flags().GetString(flagTLSKey)
+	if caCert == "" && cert == "" && key == "" {
+		return nil, nil
+	}
+	if caCert == "" || cert == "" || key == "" {
+		return nil, fmt.Errorf("%s, %s and %s flags must be provided together", flagCACert, flagTLSCert, flagTLSKey)
+	}
+	return signer.LoadFenceTLS(caCert, cert, key, server)
+}
+
+func fenceServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Start the lock service",
+		Long: `Start the lock service. Run it on a host reachable from both clusters, outside of
+either region, since signing stops in both clusters while it is unreachable.
+
+The lease holder and the watermark of every chain are persisted to the state file,
+so the lock service can be restarted without losing them.`,
+		Example:      `horcrux fence serve --listen 0.0.0.0:2300 --ca-cert fence-ca.crt --cert fence.crt --key fence.key`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tlsConfig, err := fenceTLSFromFlags(cmd, true)
+			if err != nil {
+				return err
+			}
+
+			listen, _ := cmd.Flags().GetString(flagListen)
+			stateFile, _ := cmd.Flags().GetString(flagStateFile)
+			if stateFile == "" {
+				stateFile = filepath.Join(config.StateDir, "fence_state.json")
+			}
+			if err := cometos.EnsureDir(filepath.Dir(stateFile), 0700); err != nil {
+				return err
+			}
+
+			logger := cometlog.NewTMLogger(cometlog.NewSyncWriter(cmd.OutOrStdout())).With("module", "fence")
+
+			server, err := signer.NewFenceServer(logger, listen, stateFile, tlsConfig)
+			if err != nil {
+				return err
+			}
+			if err := server.Start(); err != nil {
+				return fmt.Errorf("failed to start lock service: %w", err)
+			}
+
+			done := make(chan struct{})
+			cometos.TrapSignal(logger, func() {
+				if err := server.Stop(); err != nil {
+					logger.Error("Failed to stop lock service", "error", err)
+				}
+				close(done)
+			})
+			<-done
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagListen, "0.0.0.0:2300", "address to listen on")
+	cmd.Flags().String(flagStateFile, "", "state file (default is fence_state.json in the state directory)")
+	addFenceTLSFlags(cmd)
+
+	return cmd
+}
+
+func fenceStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the lease holder and the watermark of every chain",
+		Long: `Show the lease holder and the watermark of every chain. Before failing over, check that
+the watermarks are at least as high as the last sign state of the cluster taking over.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			address, _ := cmd.Flags().GetString(flagAddress)
+			tlsConfig, err := fenceTLSFromFlags(cmd, false)
+			if err != nil {
+				return err
+			}
+
+			// default to the fence of this cosigner's config.
+			if fenceCfg := configuredFence(); fenceCfg != nil {
+				if address == "" {
+					address = fenceCfg.Address
+				}
+				if tlsConfig == nil {
+					if tlsConfig, err = config.SignFenceTLS(); err != nil {
+						return err
+					}
+				}
+			}
+			if address == "" {
+				return fmt.Errorf("--%s is required if no fence is configured", flagAddress)
+			}
+
+			client, err := signer.NewFenceClient(address, tlsConfig)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+			defer cancel()
+
+			res, err := client.FenceStatus(ctx, &proto.FenceStatusRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to get lock service status: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			expiration := time.Unix(0, res.LeaseExpiration)
+			switch {
+			case res.Holder == "":
+				fmt.Fprintln(out, "Lease is not held")
+			case expiration.After(time.Now()):
+				fmt.Fprintf(out, "Lease is held by %s until %s\n", res.Holder, expiration.Format(time.RFC3339))
+			default:
+				fmt.Fprintf(out, "Lease of %s expired at %s\n", res.Holder, expiration.Format(time.RFC3339))
+			}
+			for _, wm := range res.Watermarks {
+				fmt.Fprintf(out, "%s %d/%d/%d signed by %s\n", wm.ChainID, wm.Height, wm.Round, wm.Step, wm.ClusterID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "address of the lock service (default is the fence address in the config)")
+	addFenceTLSFlags(cmd)
+
+	return cmd
+}
+
+func configuredFence() *signer.SignFenceConfig {
+	if config.Config.ThresholdModeConfig == nil {
+		return nil
+	}
+	return config.Config.ThresholdModeConfig.Fence
+}
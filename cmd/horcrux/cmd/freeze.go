@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+)
+
+const (
+	flagHaltHeight = "halt-height"
+	flagWindow     = "window"
+	flagReason     = "reason"
+)
+
+func freezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Stop signing for a chain at a halt height or during a window of time",
+		Long: `Stop signing for a chain at and above a halt height, or during windows of time, e.g. for a chain upgrade.
+
+Freezes set with these commands are replicated to every cosigner through raft and apply
+in addition to the haltHeight and freezeWindows of the chain in config.yaml.`,
+	}
+
+	cmd.AddCommand(
+		freezeSetCmd(),
+		freezeClearCmd(),
+		freezeListCmd(),
+	)
+
+	return cmd
+}
+
+// parseFreezeWindow parses a window in the form start/end, with RFC 3339 times.
+func parseFreezeWindow(window, reason string) (signer.FreezeWindow, error) {
+	start, end, ok := strings.Cut(window, "/")
+	if !ok {
+		return signer.FreezeWindow{}, fmt.Errorf("invalid window %q, must be start/end", window)
+	}
+	w := signer.FreezeWindow{Start: start, End: end, Reason: reason}
+	return w, w.Validate()
+}
+
+func freezeSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set chain-id",
+		Short: "Set the runtime freeze of a chain",
+		Long: `Set the runtime freeze of a chain, replacing any earlier runtime freeze of the chain.
+
+Windows are given as start/end in RFC 3339, and --window can be repeated.`,
+		Example: `horcrux freeze set cosmoshub-4 --halt-height 18500000
+horcrux freeze set cosmoshub-4 --window 2026-10-20T14:00:00Z/2026-10-20T16:00:00Z --reason "v19 upgrade"`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			haltHeight, _ := cmd.Flags().GetInt64(flagHaltHeight)
+			windows, _ := cmd.Flags().GetStringArray(flagWindow)
+			reason, _ := cmd.Flags().GetString(flagReason)
+
+			freeze := signer.SignFreeze{ChainID: args[0], HaltHeight: haltHeight}
+			for _, window := range windows {
+				w, err := parseFreezeWindow(window, reason)
+				if err != nil {
+					return err
+				}
+				freeze.FreezeWindows = append(freeze.FreezeWindows, w)
+			}
+			if freeze.IsZero() {
+				return fmt.Errorf("--%s or --%s is required, use horcrux freeze clear to clear a freeze",
+					flagHaltHeight, flagWindow)
+			}
+
+			if err := setSignFreeze(cmd, freeze); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Sign freeze set for %s\n", freeze.ChainID)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHaltHeight, 0, "stop signing at and above this height")
+	cmd.Flags().StringArray(flagWindow, nil, "stop signing during this window, as start/end in RFC 3339")
+	cmd.Flags().String(flagReason, "", "reason for the freeze windows, included in signing errors")
+
+	return cmd
+}
+
+func freezeClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "clear chain-id",
+		Short:        "Clear the runtime freeze of a chain",
+		Long:         `Clear the runtime freeze of a chain. A freeze in config.yaml must be removed from the config.`,
+		Example:      `horcrux freeze clear cosmoshub-4`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := setSignFreeze(cmd, signer.SignFreeze{ChainID: args[0]}); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Sign freeze cleared for %s\n", args[0])
+			return nil
+		},
+	}
+}
+
+func freezeListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "list",
+		Aliases:      []string{"ls"},
+		Short:        "List the freezes of the config and of the cluster",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			out := cmd.OutOrStdout()
+			for _, chain := range config.Config.Chains {
+				if freeze := chain.SignFreeze(); !freeze.IsZero() {
+					printSignFreeze(cmd, "config", freeze)
+				}
+			}
+
+			conn, err := dialLeader()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+			defer cancel()
+
+			res, err := proto.NewCosignerClient(conn).GetSignFreezes(ctx, &proto.GetSignFreezesRequest{})
+			if err != nil {
+				return err
+			}
+			for _, freeze := range res.Freezes {
+				printSignFreeze(cmd, "runtime", signer.SignFreezeFromProto(freeze))
+			}
+			if len(res.Freezes) == 0 {
+				fmt.Fprintln(out, "No runtime freezes")
+			}
+			return nil
+		},
+	}
+}
+
+func printSignFreeze(cmd *cobra.Command, source string, freeze signer.SignFreeze) {
+	out := cmd.OutOrStdout()
+	if freeze.HaltHeight != 0 {
+		fmt.Fprintf(out, "%s %s halt-height=%d\n", source, freeze.ChainID, freeze.HaltHeight)
+	}
+	for _, w := range freeze.FreezeWindows {
+		fmt.Fprintf(out, "%s %s window=%s/%s reason=%q\n", source, freeze.ChainID, w.Start, w.End, w.Reason)
+	}
+}
+
+func setSignFreeze(cmd *cobra.Command, freeze signer.SignFreeze) error {
+	conn, err := dialLeader()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+
+	_, err = proto.NewCosignerClient(conn).SetSignFreeze(ctx, &proto.SetSignFreezeRequest{
+		Freeze: signer.SignFreezeToProto(freeze),
+	})
+	return err
+}
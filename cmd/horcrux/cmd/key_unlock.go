@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer"
+)
+
+const (
+	flagUnlock         = "unlock"
+	flagPassphraseFD   = "passphrase-fd"
+	flagPassphraseFile = "passphrase-file"
+	flagUnlockKeyFile  = "unlock-key-file"
+
+	unlockProviderPassphrase = "passphrase"
+	unlockProviderLocal      = "local"
+
+	// envKeyPassphrase is read for the passphrase if neither --passphrase-fd nor --passphrase-file is set.
+	envKeyPassphrase = "HORCRUX_KEY_PASSPHRASE"
+)
+
+func addUnlockFlags(cmd *cobra.Command) {
+	f := cmd.Flags()
+	f.String(flagUnlock, "", "unlock provider for encrypted key files (passphrase, local)")
+	f.Int(flagPassphraseFD, -1, "file descriptor to read the key passphrase from")
+	f.String(flagPassphraseFile, "", "file to read the key passphrase from")
+	f.String(flagUnlockKeyFile, "", "key encryption key file for the local unlock provider")
+}
+
+// unlockProvider builds the key unlock provider selected by the unlock flags.
+// It returns nil if no provider is selected.
+func unlockProvider(cmd *cobra.Command) (signer.KeyUnlockProvider, error) {
+	f := cmd.Flags()
+	name, _ := f.GetString(flagUnlock)
+
+	switch name {
+	case "":
+		return nil, nil
+	case unlockProviderPassphrase:
+		passphrase, err := readPassphrase(cmd)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewPassphraseUnlockProvider(passphrase)
+	case unlockProviderLocal:
+		keyFile, _ := f.GetString(flagUnlockKeyFile)
+		if keyFile == "" {
+			return nil, fmt.Errorf("--%s is required for the local unlock provider", flagUnlockKeyFile)
+		}
+		return signer.NewLocalUnlockProvider(keyFile)
+	default:
+		return nil, fmt.Errorf("unknown unlock provider: %s", name)
+	}
+}
+
+// readPassphrase reads the passphrase from a file descriptor, a file, or the environment, in that order.
+// A single trailing newline is removed.
+func readPassphrase(cmd *cobra.Command) ([]byte, error) {
+	f := cmd.Flags()
+	fd, _ := f.GetInt(flagPassphraseFD)
+	file, _ := f.GetString(flagPassphraseFile)
+
+	var passphrase []byte
+	switch {
+	case fd >= 0:
+		r := os.NewFile(uintptr(fd), "passphrase-fd")
+		if r == nil {
+			return nil, fmt.Errorf("invalid passphrase file descriptor %d", fd)
+		}
+		defer r.Close()
+		bz, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read passphrase from file descriptor %d: %w", fd, err)
+		}
+		passphrase = bz
+	case file != "":
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read passphrase file: %w", err)
+		}
+		passphrase = bz
+	default:
+		env, ok := os.LookupEnv(envKeyPassphrase)
+		if !ok {
+			return nil, fmt.Errorf("passphrase not provided, use --%s, --%s, or %s",
+				flagPassphraseFD, flagPassphraseFile, envKeyPassphrase)
+		}
+		passphrase = []byte(env)
+	}
+
+	passphrase = bytes.TrimSuffix(passphrase, []byte("\n"))
+	return bytes.TrimSuffix(passphrase, []byte("\r")), nil
+}
+
+// setKeyUnlockProvider configures the signer package to read encrypted key files with the provider
+// selected by the unlock flags.
+func setKeyUnlockProvider(cmd *cobra.Command) error {
+	provider, err := unlockProvider(cmd)
+	if err != nil {
+		return err
+	}
+	if provider != nil {
+		signer.SetKeyUnlockProvider(provider)
+	}
+	return nil
+}
+
+// keyFiles returns the existing key files in the key directory.
+func keyFiles() ([]string, error) {
+	var files []string
+	for _, pattern := range []string{
+		config.KeyFilePathCosigner("*"),
+		config.KeyFilePathSingleSigner("*"),
+	} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	extra := []string{config.KeyFilePathCosignerECIES(), config.KeyFilePathCosignerRSA()}
+	for _, chain := range config.Config.Chains {
+		if chain.KeyFile != "" {
+			extra = append(extra, config.KeyFilePathCosigner(chain.ChainID))
+		}
+	}
+	for _, file := range extra {
+		if _, err := os.Stat(file); err == nil && !slices.Contains(files, file) {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+func encryptShardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt [key-file...]",
+		Short: "Encrypt key files at rest",
+		Long: `Encrypt key shards, ECIES and RSA keys, and single signer keys in place.
+
+If no files are provided, all key files in the key directory are encrypted. Files which are
+already encrypted are skipped. Once encrypted, horcrux start must be given the same unlock flags.`,
+		Example: `horcrux shards encrypt --unlock passphrase --passphrase-file /run/secrets/horcrux
+horcrux shards encrypt --unlock local --unlock-key-file /mnt/keys/horcrux.key cosmoshub-4_shard.json`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return convertKeyFiles(cmd, args, true)
+		},
+	}
+
+	addUnlockFlags(cmd)
+
+	return cmd
+}
+
+func decryptShardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt [key-file...]",
+		Short: "Decrypt encrypted key files",
+		Long: `Decrypt key files encrypted with horcrux shards encrypt in place.
+
+If no files are provided, all key files in the key directory are decrypted. Files which are
+not encrypted are skipped.`,
+		Example:      `horcrux shards decrypt --unlock passphrase --passphrase-fd 3 3<passphrase.txt`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return convertKeyFiles(cmd, args, false)
+		},
+	}
+
+	addUnlockFlags(cmd)
+
+	return cmd
+}
+
+func convertKeyFiles(cmd *cobra.Command, files []string, encrypt bool) error {
+	provider, err := unlockProvider(cmd)
+	if err != nil {
+		return err
+	}
+	if provider == nil {
+		return fmt.Errorf("--%s is required", flagUnlock)
+	}
+
+	if len(files) == 0 {
+		if files, err = keyFiles(); err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return errors.New("no key files found in the key directory")
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	for _, file := range files {
+		encrypted, err := signer.IsEncryptedKeyFile(file)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case encrypt && encrypted:
+			fmt.Fprintf(out, "Skipping %s, already encrypted\n", file)
+		case !encrypt && !encrypted:
+			fmt.Fprintf(out, "Skipping %s, not encrypted\n", file)
+		case encrypt:
+			if err := signer.EncryptKeyFile(file, provider); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Encrypted %s\n", file)
+		default:
+			if err := signer.DecryptKeyFile(file, provider); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Decrypted %s\n", file)
+		}
+	}
+
+	return nil
+}
+
+func createUnlockKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-unlock-key key-file",
+		Short: "Create a key encryption key for the local unlock provider",
+		Long: `Create a random key encryption key for the local unlock provider.
+
+Keep the key encryption key on separate storage from the key directory, e.g. removable media,
+otherwise encrypting the key files does not protect them.`,
+		Example:      `horcrux shards create-unlock-key /mnt/keys/horcrux.key`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := signer.CreateLocalUnlockKey(args[0]); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created local unlock key %s\n", args[0])
+			return nil
+		},
+	}
+}
@@ -19,6 +19,47 @@ func init() {
 	multiresolver.Register()
 }
 
+// dialLeader connects to whichever cosigner is currently the raft leader.
+func dialLeader() (*grpc.ClientConn, error) {
+	if config.Config.ThresholdModeConfig == nil {
+		return nil, fmt.Errorf("threshold mode configuration is not present in config file")
+	}
+
+	if len(config.Config.ThresholdModeConfig.Cosigners) == 0 {
+		return nil, fmt.Errorf("threshold mode configuration has no cosigners")
+	}
+
+	serviceConfig := `{"healthCheckConfig": {"serviceName": "Leader"}, "loadBalancingConfig": [ { "round_robin": {} } ]}`
+	retryOpts := []grpcretry.CallOption{
+		grpcretry.WithBackoff(grpcretry.BackoffExponential(100 * time.Millisecond)),
+		grpcretry.WithMax(5),
+	}
+
+	grpcAddress, err := config.Config.ThresholdModeConfig.LeaderElectMultiAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	cosignerTLS, err := config.CosignerTLS()
+	if err != nil {
+		return nil, err
+	}
+	if cosignerTLS != nil {
+		creds = cosignerTLS.ClusterCredentials()
+	}
+
+	fmt.Printf("Broadcasting to address: %s\n", grpcAddress)
+	conn, err := grpc.Dial(grpcAddress,
+		grpc.WithDefaultServiceConfig(serviceConfig), grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+		grpc.WithUnaryInterceptor(grpcretry.UnaryClientInterceptor(retryOpts...)))
+	if err != nil {
+		return nil, fmt.Errorf("dialing failed: %v", err)
+	}
+	return conn, nil
+}
+
 func leaderElectionCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "elect [node_id]",
@@ -31,33 +72,10 @@ To choose a specific leader, pass that leader's ID as an argument.
 horcrux elect 2 # elect specific leader`,
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			if config.Config.ThresholdModeConfig == nil {
-				return fmt.Errorf("threshold mode configuration is not present in config file")
-			}
-
-			if len(config.Config.ThresholdModeConfig.Cosigners) == 0 {
-				return fmt.Errorf("threshold mode configuration has no cosigners")
-			}
-
-			serviceConfig := `{"healthCheckConfig": {"serviceName": "Leader"}, "loadBalancingConfig": [ { "round_robin": {} } ]}`
-			retryOpts := []grpcretry.CallOption{
-				grpcretry.WithBackoff(grpcretry.BackoffExponential(100 * time.Millisecond)),
-				grpcretry.WithMax(5),
-			}
-
-			grpcAddress, err := config.Config.ThresholdModeConfig.LeaderElectMultiAddress()
+			conn, err := dialLeader()
 			if err != nil {
 				return err
 			}
-
-			fmt.Printf("Broadcasting to address: %s\n", grpcAddress)
-			conn, err := grpc.Dial(grpcAddress,
-				grpc.WithDefaultServiceConfig(serviceConfig), grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
-				grpc.WithUnaryInterceptor(grpcretry.UnaryClientInterceptor(retryOpts...)))
-			if err != nil {
-				return fmt.Errorf("dialing failed: %v", err)
-			}
 			defer conn.Close()
 
 			leaderID := ""
@@ -153,9 +171,18 @@ func getLeaderCmd() *cobra.Command {
 				return err
 			}
 
+			creds := insecure.NewCredentials()
+			cosignerTLS, err := config.CosignerTLS()
+			if err != nil {
+				return err
+			}
+			if cosignerTLS != nil {
+				creds = cosignerTLS.PeerCredentials(id)
+			}
+
 			fmt.Printf("Request address: %s\n", grpcAddress)
 			conn, err := grpc.Dial(grpcAddress,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithTransportCredentials(creds),
 				grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
 				grpc.WithUnaryInterceptor(grpcretry.UnaryClientInterceptor(retryOpts...)))
 			if err != nil {
@@ -36,9 +36,17 @@ To use ECIES instead, run:
 horcrux create-ecies-shards
 `
 	cmd.AddCommand(rsaCmd)
+	cmd.AddCommand(createCosignerTLSCertsCmd())
+	cmd.AddCommand(dkgCmd())
+	cmd.AddCommand(shardsCmd())
+	cmd.AddCommand(shardSignerCmd())
+	cmd.AddCommand(fenceCmd())
+	cmd.AddCommand(clusterCmd())
+	cmd.AddCommand(freezeCmd())
 	cmd.AddCommand(leaderElectionCmd())
 	cmd.AddCommand(getLeaderCmd())
 	cmd.AddCommand(stateCmd())
+	cmd.AddCommand(auditCmd())
 	cmd.AddCommand(versionCmd())
 
 	cmd.PersistentFlags().StringVar(
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os/user"
+	"strconv"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometos "github.com/cometbft/cometbft/libs/os"
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/v3/signer"
+)
+
+const (
+	flagSocket      = "socket"
+	flagSocketGroup = "socket-group"
+)
+
+func shardSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shard-signer",
+		Short: "Start a shard signer process for the external threshold signer backend",
+		Long: `Start a shard signer process which holds the key shards of this cosigner and signs for
+horcrux start over a unix socket, so that the shards are never loaded into the horcrux process.
+
+Configure horcrux start to use it with:
+
+thresholdMode:
+  backend:
+    type: external
+    socket: shard_signer.sock
+
+The shard signer reads the key shards from the key directory of the same config. Run it as a
+separate user which owns the key shards. Only that user can connect to the socket, unless a
+group is set with backend.socketGroup or --socket-group. Add the user running horcrux start to
+that group, so it can reach the shard signer on the socket.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.Config.ValidateThresholdModeConfig(); err != nil {
+				return err
+			}
+
+			if err := setKeyUnlockProvider(cmd); err != nil {
+				return err
+			}
+
+			socket, _ := cmd.Flags().GetString(flagSocket)
+			if socket == "" {
+				socket = config.ShardSignerSocket()
+			}
+			if socket == "" {
+				return fmt.Errorf("--%s is required if the backend socket is not configured", flagSocket)
+			}
+
+			group, _ := cmd.Flags().GetString(flagSocketGroup)
+			if group == "" && config.Config.ThresholdModeConfig.Backend != nil {
+				group = config.Config.ThresholdModeConfig.Backend.SocketGroup
+			}
+
+			logger := cometlog.NewTMLogger(cometlog.NewSyncWriter(cmd.OutOrStdout())).With("module", "shard_signer")
+
+			server := signer.NewShardSignerServer(logger, &config, socket)
+			if group != "" {
+				gid, err := lookupGroupID(group)
+				if err != nil {
+					return err
+				}
+				server.SetSocketGroup(gid)
+			}
+			if err := server.Start(); err != nil {
+				return fmt.Errorf("failed to start shard signer: %w", err)
+			}
+
+			done := make(chan struct{})
+			cometos.TrapSignal(logger, func() {
+				if err := server.Stop(); err != nil {
+					logger.Error("Failed to stop shard signer", "error", err)
+				}
+				close(done)
+			})
+			<-done
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSocket, "", "unix socket to listen on, defaults to the backend socket in the config")
+	cmd.Flags().String(flagSocketGroup, "",
+		"group which can connect to the socket, defaults to the backend socket group in the config")
+	addUnlockFlags(cmd)
+
+	return cmd
+}
+
+// lookupGroupID returns the ID of group, which is a group name or a numeric group ID.
+func lookupGroupID(group string) (int, error) {
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up socket group: %w", err)
+	}
+	return strconv.Atoi(g.Gid)
+}
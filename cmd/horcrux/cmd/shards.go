@@ -16,12 +16,18 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/horcrux/v3/signer"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
 )
 
 func createCosignerDirectoryIfNecessary(out string, id int) (string, error) {
@@ -251,3 +257,205 @@ func createCosignerRSAShardsCmd() *cobra.Command {
 	addOutputDirFlag(cmd)
 	return cmd
 }
+
+const (
+	flagCACert = "ca-cert"
+	flagCAKey  = "ca-key"
+)
+
+// createCosignerTLSCertsCmd is a cobra command for creating the certificates used
+// for mutual TLS between cosigners.
+func createCosignerTLSCertsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-tls-certs",
+		Args:  cobra.NoArgs,
+		Short: "Create cosigner mutual TLS certificates",
+		Long: `Create a certificate for each cosigner, identifying it by shard ID.
+
+A new certificate authority is created unless an existing one is provided
+with --ca-cert and --ca-key.`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+
+			shards, _ := flags.GetUint8(flagShards)
+			caCertFile, _ := flags.GetString(flagCACert)
+			caKeyFile, _ := flags.GetString(flagCAKey)
+
+			if shards <= 0 {
+				return fmt.Errorf("shards must be greater than zero (%d): %w", shards, err)
+			}
+
+			if (caCertFile == "") != (caKeyFile == "") {
+				return fmt.Errorf("%s and %s flags must be provided together", flagCACert, flagCAKey)
+			}
+
+			out, _ := flags.GetString(flagOutputDir)
+			if out != "" {
+				if err := os.MkdirAll(out, 0700); err != nil {
+					return err
+				}
+			}
+
+			var caCert, caKey []byte
+			if caCertFile != "" {
+				if caCert, err = os.ReadFile(caCertFile); err != nil {
+					return fmt.Errorf("error reading CA certificate (%s): %w", caCertFile, err)
+				}
+				if caKey, err = os.ReadFile(caKeyFile); err != nil {
+					return fmt.Errorf("error reading CA key (%s): %w", caKeyFile, err)
+				}
+			} else {
+				if caCert, caKey, err = signer.CreateCosignerTLSCA(); err != nil {
+					return err
+				}
+				caCertFile = filepath.Join(out, signer.CosignerTLSCACertFile)
+				if err := os.WriteFile(caCertFile, caCert, 0600); err != nil {
+					return err
+				}
+				caKeyFile = filepath.Join(out, signer.CosignerTLSCAKeyFile)
+				if err := os.WriteFile(caKeyFile, caKey, 0600); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Created TLS CA %s\n", caCertFile)
+			}
+
+			// silence usage after all input has been validated
+			cmd.SilenceUsage = true
+
+			for id := 1; id <= int(shards); id++ {
+				cert, key, err := signer.CreateCosignerTLSCert(caCert, caKey, id)
+				if err != nil {
+					return err
+				}
+				dir, err := createCosignerDirectoryIfNecessary(out, id)
+				if err != nil {
+					return err
+				}
+				if err := signer.WriteCosignerTLSFiles(dir, caCert, cert, key); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Created TLS certificate %s\n", filepath.Join(dir, signer.CosignerTLSCertFile))
+			}
+			return nil
+		},
+	}
+	addTotalShardsFlag(cmd)
+	addOutputDirFlag(cmd)
+
+	f := cmd.Flags()
+	f.String(flagCACert, "", "existing CA certificate to issue cosigner certificates from")
+	f.String(flagCAKey, "", "private key of the existing CA certificate")
+	return cmd
+}
+
+func shardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shards",
+		Short: "Manage the key shards of a running cosigner cluster",
+	}
+
+	cmd.AddCommand(refreshShardsCmd())
+	cmd.AddCommand(reshareShardsCmd())
+	cmd.AddCommand(encryptShardsCmd())
+	cmd.AddCommand(decryptShardsCmd())
+	cmd.AddCommand(createUnlockKeyCmd())
+
+	return cmd
+}
+
+func refreshShardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh chain-id",
+		Short: "Re-randomize the key shards of all cosigners without changing the public key",
+		Long: `Proactively refresh the key shards for a chain ID.
+
+The raft leader coordinates the cosigners to add a random sharing of zero to their shards.
+The validator public key does not change. Once the refresh commits through raft, every cosigner
+replaces its {chain-id}_shard.json, and shards from before the refresh can no longer be combined
+with the new ones. All cosigners must be online.`,
+		Example:      `horcrux shards refresh cosmoshub-4`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+
+			conn, err := dialLeader()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			res, err := proto.NewCosignerClient(conn).RefreshShards(ctx, &proto.RefreshShardsRequest{
+				ChainID: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			pubKey := cometcryptoed25519.PubKey(res.PubKey)
+			fmt.Fprintf(cmd.OutOrStdout(), "Refreshed key shards for %s\nHexAddress: %s\n",
+				args[0], strings.ToUpper(hex.EncodeToString(pubKey.Address())))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration(flagTimeout, time.Minute, "time to wait for all cosigners to complete")
+
+	return cmd
+}
+
+func reshareShardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reshare",
+		Short: "Reshare the key shards of all chains to the current cosigners with a new threshold",
+		Long: `Reshare the key shards of every chain held by the raft leader to all current cosigners.
+
+Every cosigner holding a shard deals it to the others, and every cosigner interpolates a new shard
+for the same validator public key, so the key is never reconstructed on any single host. Once the
+reshare commits through raft, every cosigner replaces its {chain-id}_shard.json files and sets the
+threshold in its config.yaml. Shards from before the reshare can no longer be combined with the
+new ones. All cosigners must be online.
+
+To grow a cluster, first add the new cosigners with horcrux cluster add, then reshare.`,
+		Example: `horcrux shards reshare --threshold 3
+horcrux shards reshare # hand out shards to new cosigners, keeping the threshold`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+			threshold, _ := cmd.Flags().GetUint8(flagThreshold)
+
+			conn, err := dialLeader()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			res, err := proto.NewCosignerClient(conn).ReshareShards(ctx, &proto.ReshareShardsRequest{
+				Threshold: int32(threshold),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Reshared key shards with threshold %d for %s\n",
+				res.Threshold, strings.Join(res.ChainIDs, ", "))
+
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.Uint8(flagThreshold, 0, "new threshold number of shards required to sign, defaults to the current threshold")
+	f.Duration(flagTimeout, 5*time.Minute, "time to wait for all chains to be reshared")
+
+	return cmd
+}
@@ -139,3 +139,53 @@ func TestRSAShards(t *testing.T) {
 		})
 	}
 }
+
+func TestTLSCerts(t *testing.T) {
+	tmp := t.TempDir()
+
+	tcs := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name:      "valid shards",
+			args:      []string{"--shards", "3"},
+			expectErr: false,
+		},
+		{
+			name: "existing CA",
+			args: []string{
+				"--shards", "2",
+				"--ca-cert", filepath.Join(tmp, "tls_ca.crt"),
+				"--ca-key", filepath.Join(tmp, "tls_ca.key"),
+			},
+			expectErr: false,
+		},
+		{
+			name:      "CA cert without key",
+			args:      []string{"--shards", "2", "--ca-cert", filepath.Join(tmp, "tls_ca.crt")},
+			expectErr: true,
+		},
+		{
+			name:      "invalid shards",
+			args:      []string{"--shards", "0"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := rootCmd()
+			cmd.SetOutput(io.Discard)
+			args := append([]string{"create-tls-certs", "--home", tmp, "--out", tmp}, tc.args...)
+			cmd.SetArgs(args)
+			err := cmd.Execute()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
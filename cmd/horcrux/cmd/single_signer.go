@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 
+	cometlog "github.com/cometbft/cometbft/libs/log"
 	"github.com/strangelove-ventures/horcrux/v3/signer"
 )
 
@@ -22,6 +23,7 @@ as it is not officially supported by Strangelove.`
 
 func NewSingleSignerValidator(
 	out io.Writer,
+	logger cometlog.Logger,
 	acceptRisk bool,
 ) (*signer.SingleSignerValidator, error) {
 	fmt.Fprintln(out, singleSignerWarning)
@@ -34,5 +36,13 @@ func NewSingleSignerValidator(
 		return nil, err
 	}
 
-	return signer.NewSingleSignerValidator(&config), nil
+	journal, err := openAuditJournal(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	val := signer.NewSingleSignerValidator(&config)
+	val.SetAuditJournal(journal)
+
+	return val, nil
 }
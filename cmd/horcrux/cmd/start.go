@@ -6,6 +6,7 @@ import (
 
 	cometlog "github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/horcrux/v3/signer"
 )
@@ -25,6 +26,10 @@ func startCmd() *cobra.Command {
 				return err
 			}
 
+			if err := setKeyUnlockProvider(cmd); err != nil {
+				return err
+			}
+
 			if _, err := legacyConfig(); err == nil {
 				return fmt.Errorf("this is a legacy config. run `horcrux config migrate` to migrate to the latest format")
 			}
@@ -52,7 +57,7 @@ func startCmd() *cobra.Command {
 					return err
 				}
 			case signer.SignModeSingle:
-				val, err = NewSingleSignerValidator(out, acceptRisk)
+				val, err = NewSingleSignerValidator(out, logger, acceptRisk)
 				if err != nil {
 					return err
 				}
@@ -60,6 +65,15 @@ func startCmd() *cobra.Command {
 				panic(fmt.Errorf("unexpected sign mode: %s", config.Config.SignMode))
 			}
 
+			if shadowCfg := config.Config.Shadow; shadowCfg != nil {
+				pubKeys, err := shadowCfg.ParsePubKeys()
+				if err != nil {
+					return err
+				}
+				logger.Info("Shadow mode enabled, signatures will be withheld from chain nodes")
+				val = signer.NewShadowValidator(logger, val, pubKeys)
+			}
+
 			if config.Config.GRPCAddr != "" {
 				grpcServer := signer.NewRemoteSignerGRPCServer(logger, val, config.Config.GRPCAddr)
 				services = append(services, grpcServer)
@@ -71,11 +85,27 @@ func startCmd() *cobra.Command {
 
 			go EnableDebugAndMetrics(cmd.Context(), out)
 
-			services, err = signer.StartRemoteSigners(services, logger, val, config.Config.Nodes())
+			nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+			if err != nil {
+				return fmt.Errorf("failed to load node key: %w", err)
+			}
+			logger.Info("Horcrux node key", "node_id", nodeKey.ID())
+
+			protocols := config.Config.PrivValProtocols()
+			services, err = signer.StartRemoteSigners(
+				services, logger, val, config.Config.ChainNodes, nodeKey.PrivKey, protocols,
+			)
 			if err != nil {
 				return fmt.Errorf("failed to start remote signer(s): %w", err)
 			}
 
+			services, err = signer.StartListenRemoteSigners(
+				services, logger, val, config.Config.PrivValListeners, nodeKey.PrivKey, protocols,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to start privval listener(s): %w", err)
+			}
+
 			signer.WaitAndTerminate(logger, services, config.PidFile)
 
 			return nil
@@ -83,6 +113,7 @@ func startCmd() *cobra.Command {
 	}
 
 	cmd.Flags().Bool(flagAcceptRisk, false, "Single-signer-mode unsupported. Required to accept risk and proceed.")
+	addUnlockFlags(cmd)
 
 	return cmd
 }
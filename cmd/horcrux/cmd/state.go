@@ -34,6 +34,7 @@ func stateCmd() *cobra.Command {
 	cmd.AddCommand(showStateCmd())
 	cmd.AddCommand(setStateCmd())
 	cmd.AddCommand(importStateCmd())
+	cmd.AddCommand(historyStateCmd())
 
 	return cmd
 }
@@ -53,21 +54,31 @@ func showStateCmd() *cobra.Command {
 				return fmt.Errorf("%s does not exist, initialize config with horcrux config init and try again", config.HomeDir)
 			}
 
-			pv, err := signer.LoadSignState(config.PrivValStateFile(chainID))
+			pv, err := config.LoadSignState(config.PrivValStateFile(chainID))
 			if err != nil {
 				return err
 			}
+			defer pv.Close()
 
-			cs, err := signer.LoadSignState(config.CosignerStateFile(chainID))
+			cs, err := config.LoadSignState(config.CosignerStateFile(chainID))
 			if err != nil {
 				return err
 			}
+			defer cs.Close()
 
 			out := cmd.OutOrStdout()
 			fmt.Fprintln(out, "Private Validator State:")
 			printSignState(out, pv)
 			fmt.Fprintln(out, "Share Sign State:")
 			printSignState(out, cs)
+			fmt.Fprintln(out, "Sentries:")
+			for _, n := range config.Config.ChainNodes.ForChain(chainID) {
+				if n.ChainID == "" {
+					fmt.Fprintf(out, "  %s (any chain ID)\n", n.PrivValAddr)
+				} else {
+					fmt.Fprintf(out, "  %s\n", n.PrivValAddr)
+				}
+			}
 			return nil
 		},
 	}
@@ -97,15 +108,17 @@ func setStateCmd() *cobra.Command {
 				return err
 			}
 
-			pv, err := signer.LoadOrCreateSignState(config.PrivValStateFile(chainID))
+			pv, err := config.LoadOrCreateSignState(config.PrivValStateFile(chainID))
 			if err != nil {
 				return err
 			}
+			defer pv.Close()
 
-			cs, err := signer.LoadOrCreateSignState(config.CosignerStateFile(chainID))
+			cs, err := config.LoadOrCreateSignState(config.CosignerStateFile(chainID))
 			if err != nil {
 				return err
 			}
+			defer cs.Close()
 
 			height, err := strconv.ParseInt(args[1], 10, 64)
 			if err != nil {
@@ -164,16 +177,18 @@ func importStateCmd() *cobra.Command {
 			}
 
 			// Recreate privValStateFile if necessary
-			pv, err := signer.LoadOrCreateSignState(config.PrivValStateFile(chainID))
+			pv, err := config.LoadOrCreateSignState(config.PrivValStateFile(chainID))
 			if err != nil {
 				return err
 			}
+			defer pv.Close()
 
 			// shareStateFile does not exist during default config init, so create if necessary
-			cs, err := signer.LoadOrCreateSignState(config.CosignerStateFile(chainID))
+			cs, err := config.LoadOrCreateSignState(config.CosignerStateFile(chainID))
 			if err != nil {
 				return err
 			}
+			defer cs.Close()
 
 			// Allow user to paste in priv_validator_state.json
 
@@ -232,6 +247,47 @@ func importStateCmd() *cobra.Command {
 	}
 }
 
+func historyStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history chain-id",
+		Short: "Show the blocks signed for a specific chain-id",
+		Long: `Show the height, round, step, sign bytes and signature of every block signed for a chain-id.
+
+Requires signStateStore: db in the config. The sign state database is locked while horcrux is running.`,
+		Example:      `horcrux state history cosmoshub-4 --from 18000000 --to 18000100`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			from, _ := cmd.Flags().GetInt64(flagFrom)
+			to, _ := cmd.Flags().GetInt64(flagTo)
+
+			pv, err := config.LoadSignState(config.PrivValStateFile(chainID))
+			if err != nil {
+				return err
+			}
+			defer pv.Close()
+
+			history := pv.History()
+			if history == nil {
+				return fmt.Errorf("sign state store %q does not keep a signing history, set signStateStore: %s",
+					config.Config.SignStateStore, signer.SignStateStoreDB)
+			}
+
+			out := cmd.OutOrStdout()
+			return history.History(from, to, func(ssc signer.SignStateConsensus) error {
+				fmt.Fprintf(out, "%d/%d/%d %s %s\n", ssc.Height, ssc.Round, ssc.Step,
+					base64.StdEncoding.EncodeToString(ssc.Signature), ssc.SignBytes)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Int64(flagFrom, 0, "first height to show")
+	cmd.Flags().Int64(flagTo, 0, "last height to show, 0 for the latest")
+	return cmd
+}
+
 func printSignState(out io.Writer, ss *signer.SignState) {
 	fmt.Fprintf(out, "  Height:    %v\n"+
 		"  Round:     %v\n"+
@@ -39,9 +39,14 @@ func NewThresholdValidator(
 		}
 	}
 
+	cosignerTLS, err := config.CosignerTLS()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize cosigner TLS: %w", err)
+	}
+
 	for _, c := range thresholdCfg.Cosigners {
 		if c.ShardID != security.GetID() {
-			rc, err := signer.NewRemoteCosigner(c.ShardID, c.P2PAddr)
+			rc, err := signer.NewRemoteCosigner(c.ShardID, c.P2PAddr, cosignerTLS)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to initialize remote cosigner: %w", err)
 			}
@@ -80,6 +85,7 @@ func NewThresholdValidator(
 	// Start RAFT store listener
 	raftStore := signer.NewRaftStore(nodeID,
 		raftDir, p2pListen, raftTimeout, logger, localCosigner, remoteCosigners)
+	raftStore.SetTLS(cosignerTLS)
 	if err := raftStore.Start(); err != nil {
 		return nil, nil, fmt.Errorf("error starting raft store: %w", err)
 	}
@@ -97,6 +103,19 @@ func NewThresholdValidator(
 	)
 
 	raftStore.SetThresholdValidator(val)
+	val.SetLeadershipTransferer(raftStore)
+
+	journal, err := openAuditJournal(logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	val.SetAuditJournal(journal)
+
+	fence, err := signer.NewSignFence(&config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sign fence: %w", err)
+	}
+	val.SetSignFence(fence)
 
 	if err := val.Start(ctx); err != nil {
 		return nil, nil, fmt.Errorf("failed to start threshold validator: %w", err)
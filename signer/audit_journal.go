@@ -0,0 +1,306 @@
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	cometbytes "github.com/cometbft/cometbft/libs/bytes"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+)
+
+// maxAuditEntrySize bounds the size of a single journal line when reading.
+const maxAuditEntrySize = 1 << 20
+
+// auditTypeRawBytes is the type of entries for raw bytes signatures.
+const auditTypeRawBytes = "raw_bytes"
+
+// AuditEntry records one signature produced by horcrux.
+// Each entry includes the hash of the previous entry, so that removing or modifying
+// an entry breaks the hash chain.
+type AuditEntry struct {
+	Index                  uint64              `json:"index"`
+	Time                   time.Time           `json:"time"`
+	ChainID                string              `json:"chainID"`
+	Height                 int64               `json:"height"`
+	Round                  int64               `json:"round"`
+	Step                   int8                `json:"step"`
+	Type                   string              `json:"type"`
+	SignBytesHash          cometbytes.HexBytes `json:"signBytesHash"`
+	Signature              []byte              `json:"signature"`
+	VoteExtensionSignature []byte              `json:"voteExtensionSignature,omitempty"`
+	// UniqueID is the unique ID of a raw bytes signature. It is empty for consensus signatures.
+	UniqueID string `json:"uniqueID,omitempty"`
+
+	// Cosigners are the shard IDs of the cosigners whose partial signatures were combined.
+	// It is empty in single signer mode.
+	Cosigners []int `json:"cosigners,omitempty"`
+	// Leader is the shard ID of the cosigner which led the signing, or coordinated it for raw bytes.
+	// It is 0 in single signer mode.
+	Leader int `json:"leader,omitempty"`
+
+	PrevHash cometbytes.HexBytes `json:"prevHash"`
+	Hash     cometbytes.HexBytes `json:"hash"`
+}
+
+// NewAuditEntry returns an entry for a signature of block on chainID.
+func NewAuditEntry(chainID string, block Block, signature, voteExtSignature []byte) AuditEntry {
+	signBytesHash := sha256.Sum256(block.SignBytes)
+	return AuditEntry{
+		ChainID:                chainID,
+		Height:                 block.Height,
+		Round:                  block.Round,
+		Step:                   block.Step,
+		Type:                   signType(block.Step),
+		SignBytesHash:          signBytesHash[:],
+		Signature:              signature,
+		VoteExtensionSignature: voteExtSignature,
+	}
+}
+
+// NewRawBytesAuditEntry returns an entry for a signature of the domain separated signBytes
+// of raw bytes with uniqueID on chainID.
+func NewRawBytesAuditEntry(chainID, uniqueID string, signBytes, signature []byte) AuditEntry {
+	signBytesHash := sha256.Sum256(signBytes)
+	return AuditEntry{
+		ChainID:       chainID,
+		Type:          auditTypeRawBytes,
+		UniqueID:      uniqueID,
+		SignBytesHash: signBytesHash[:],
+		Signature:     signature,
+	}
+}
+
+// computeHash returns the hash of the entry, which covers every field except Hash.
+func (e AuditEntry) computeHash() ([]byte, error) {
+	e.Hash = nil
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// AuditFilter selects journal entries. Zero values match everything.
+type AuditFilter struct {
+	ChainID    string
+	FromHeight int64
+	ToHeight   int64
+}
+
+func (f AuditFilter) Match(e AuditEntry) bool {
+	if f.ChainID != "" && e.ChainID != f.ChainID {
+		return false
+	}
+	if e.Height < f.FromHeight {
+		return false
+	}
+	return f.ToHeight == 0 || e.Height <= f.ToHeight
+}
+
+// AuditJournal appends an AuditEntry for every signature to a JSON lines file.
+// Entries are written as they are appended, and synced to disk in the background so that signing
+// does not wait on fsync. Entries written just before a crash of the host may be lost.
+type AuditJournal struct {
+	logger cometlog.Logger
+
+	mu        sync.Mutex
+	file      *os.File
+	prevHash  []byte
+	nextIndex uint64
+
+	// syncCh wakes the sync loop after entries are written, done stops it and stopped is closed once it returns.
+	syncCh  chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// OpenAuditJournal opens the journal at filePath for appending, creating it if necessary.
+func OpenAuditJournal(logger cometlog.Logger, filePath string) (*AuditJournal, error) {
+	j := &AuditJournal{
+		logger:  logger,
+		syncCh:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	// continue the hash chain from the last entry.
+	if err := ReadAuditJournal(filePath, func(e AuditEntry) error {
+		j.prevHash = e.Hash
+		j.nextIndex = e.Index + 1
+		return nil
+	}); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read audit journal %s: %w", filePath, err)
+	}
+
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	j.file = f
+
+	go j.syncLoop()
+
+	return j, nil
+}
+
+// syncLoop syncs the journal to disk whenever entries were written since the last sync.
+func (j *AuditJournal) syncLoop() {
+	defer close(j.stopped)
+	for {
+		select {
+		case <-j.done:
+			return
+		case <-j.syncCh:
+			if err := j.file.Sync(); err != nil {
+				totalAuditJournalErrors.Inc()
+				j.logger.Error("Failed to sync audit journal", "error", err)
+			}
+		}
+	}
+}
+
+// Append adds e to the journal and returns it as written. It is synced to disk in the background.
+func (j *AuditJournal) Append(e AuditEntry) (AuditEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Time = e.Time.UTC()
+	e.Index = j.nextIndex
+	e.PrevHash = j.prevHash
+
+	hash, err := e.computeHash()
+	if err != nil {
+		return e, err
+	}
+	e.Hash = hash
+
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return e, err
+	}
+	if _, err := j.file.Write(append(bz, '\n')); err != nil {
+		return e, err
+	}
+
+	j.prevHash = e.Hash
+	j.nextIndex++
+
+	// a sync which is already pending also covers this entry.
+	select {
+	case j.syncCh <- struct{}{}:
+	default:
+	}
+
+	return e, nil
+}
+
+// Record appends e to the journal, logging instead of returning an error,
+// since a signature must not be withheld because it could not be journaled.
+// Record is a no-op on a nil journal.
+func (j *AuditJournal) Record(e AuditEntry) {
+	if j == nil {
+		return
+	}
+	if _, err := j.Append(e); err != nil {
+		totalAuditJournalErrors.Inc()
+		j.logger.Error(
+			"Failed to write audit journal entry",
+			"chain_id", e.ChainID,
+			"height", e.Height,
+			"round", e.Round,
+			"step", e.Step,
+			"unique_id", e.UniqueID,
+			"error", err,
+		)
+	}
+}
+
+// Close syncs and closes the journal. It is a no-op on a nil journal.
+// Every remote signer stops the validator sharing the journal, so only the first call closes the file,
+// and later calls return the same result.
+func (j *AuditJournal) Close() error {
+	if j == nil {
+		return nil
+	}
+	j.closeOnce.Do(func() {
+		close(j.done)
+		<-j.stopped
+
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		j.closeErr = errors.Join(j.file.Sync(), j.file.Close())
+	})
+	return j.closeErr
+}
+
+// ReadAuditJournal calls fn for every entry of the journal at filePath, in order.
+func ReadAuditJournal(filePath string, fn func(AuditEntry) error) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return readAuditEntries(f, fn)
+}
+
+func readAuditEntries(r io.Reader, fn func(AuditEntry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxAuditEntrySize)
+	for line := 1; scanner.Scan(); line++ {
+		var e AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ErrAuditJournalBroken is returned when the hash chain of a journal does not verify.
+var ErrAuditJournalBroken = errors.New("audit journal hash chain is broken")
+
+// VerifyAuditJournal checks the hash and index of every entry and that each entry links to the previous one.
+// It returns the number of entries verified.
+func VerifyAuditJournal(filePath string) (int, error) {
+	var (
+		count    int
+		prevHash []byte
+	)
+	err := ReadAuditJournal(filePath, func(e AuditEntry) error {
+		if e.Index != uint64(count) {
+			return fmt.Errorf("%w: entry %d has index %d", ErrAuditJournalBroken, count, e.Index)
+		}
+		if !bytes.Equal(e.PrevHash, prevHash) {
+			return fmt.Errorf("%w: entry %d does not link to the previous entry", ErrAuditJournalBroken, e.Index)
+		}
+		hash, err := e.computeHash()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, e.Hash) {
+			return fmt.Errorf("%w: entry %d has been modified", ErrAuditJournalBroken, e.Index)
+		}
+		prevHash = e.Hash
+		count++
+		return nil
+	})
+	return count, err
+}
@@ -0,0 +1,83 @@
+package signer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditJournal(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit_journal.jsonl")
+
+	journal, err := OpenAuditJournal(cometlog.NewNopLogger(), file)
+	require.NoError(t, err)
+
+	for h := int64(1); h <= 3; h++ {
+		entry := NewAuditEntry(testChainID, Block{Height: h, Step: stepPrecommit, SignBytes: []byte{byte(h)}},
+			[]byte{0xAA, byte(h)}, nil)
+		entry.Leader = 1
+		entry.Cosigners = []int{1, 3}
+		_, err := journal.Append(entry)
+		require.NoError(t, err)
+	}
+	require.NoError(t, journal.Close())
+
+	// reopening continues the hash chain.
+	journal, err = OpenAuditJournal(cometlog.NewNopLogger(), file)
+	require.NoError(t, err)
+	entry, err := journal.Append(NewAuditEntry(testChainID2, Block{Height: 1, Step: stepPropose}, []byte{0xBB}, nil))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), entry.Index)
+	require.NoError(t, journal.Close())
+
+	// closing again, as every remote signer stopping the validator does, is not an error.
+	require.NoError(t, journal.Close())
+
+	count, err := VerifyAuditJournal(file)
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+
+	var entries []AuditEntry
+	filter := AuditFilter{ChainID: testChainID, FromHeight: 2}
+	require.NoError(t, ReadAuditJournal(file, func(e AuditEntry) error {
+		if filter.Match(e) {
+			entries = append(entries, e)
+		}
+		return nil
+	}))
+	require.Len(t, entries, 2)
+	require.Equal(t, int64(2), entries[0].Height)
+	require.Equal(t, "precommit", entries[0].Type)
+	require.Equal(t, []int{1, 3}, entries[0].Cosigners)
+
+	// modifying an entry breaks the hash chain.
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	bz = bytes.Replace(bz, []byte(`"height":2`), []byte(`"height":5`), 1)
+	require.NoError(t, os.WriteFile(file, bz, 0600))
+
+	count, err = VerifyAuditJournal(file)
+	require.ErrorIs(t, err, ErrAuditJournalBroken)
+	require.ErrorContains(t, err, "entry 1 has been modified")
+	require.Equal(t, 1, count)
+}
+
+func BenchmarkAuditJournalAppend(b *testing.B) {
+	journal, err := OpenAuditJournal(cometlog.NewNopLogger(), filepath.Join(b.TempDir(), "audit_journal.jsonl"))
+	require.NoError(b, err)
+	defer journal.Close()
+
+	signature := bytes.Repeat([]byte{0xAA}, 64)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		block := Block{Height: int64(i + 1), Step: stepPrecommit, SignBytes: []byte{byte(i)}}
+		if _, err := journal.Append(NewAuditEntry(testChainID, block, signature, nil)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
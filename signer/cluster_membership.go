@@ -0,0 +1,260 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/raft"
+)
+
+// MembershipOp is a change to the cosigner set of a running cluster.
+type MembershipOp int
+
+const (
+	// MembershipOpAdd adds a cosigner as a raft voter and peer.
+	MembershipOpAdd MembershipOp = iota
+	// MembershipOpRemove removes a cosigner from raft and the peer set.
+	MembershipOpRemove
+	// MembershipOpReplace moves an existing shard ID to a new p2p address, e.g. to replace a failed host.
+	MembershipOpReplace
+)
+
+func (op MembershipOp) String() string {
+	switch op {
+	case MembershipOpAdd:
+		return "add"
+	case MembershipOpRemove:
+		return "remove"
+	case MembershipOpReplace:
+		return "replace"
+	default:
+		return fmt.Sprintf("MembershipOp(%d)", int(op))
+	}
+}
+
+// ClusterMembership is replicated through raft so that every cosigner applies the same cosigner set.
+type ClusterMembership struct {
+	Cosigners CosignersConfig `json:"cosigners"`
+}
+
+// changeCosigners returns the cosigner set after applying op to current.
+// For MembershipOpAdd, a shardID of 0 assigns the next unused shard ID.
+// leaderID cannot be removed or replaced, since it is coordinating the change.
+func changeCosigners(
+	current CosignersConfig,
+	leaderID int,
+	op MembershipOp,
+	shardID int,
+	p2pAddr string,
+) (CosignersConfig, int, error) {
+	var existing *CosignerConfig
+	for i, c := range current {
+		if c.ShardID == shardID {
+			existing = &current[i]
+		}
+		if op != MembershipOpRemove && c.P2PAddr == p2pAddr {
+			return nil, 0, fmt.Errorf("p2p address %s is already used by cosigner %d", p2pAddr, c.ShardID)
+		}
+	}
+
+	next := make(CosignersConfig, 0, len(current)+1)
+
+	switch op {
+	case MembershipOpAdd:
+		if shardID == 0 {
+			shardID = current.MaxShardID() + 1
+		} else if existing != nil {
+			return nil, 0, fmt.Errorf("cosigner %d is already a member of the cluster", shardID)
+		}
+		next = append(next, current...)
+		next = append(next, CosignerConfig{ShardID: shardID, P2PAddr: p2pAddr})
+	case MembershipOpRemove, MembershipOpReplace:
+		if existing == nil {
+			return nil, 0, fmt.Errorf("cosigner %d is not a member of the cluster", shardID)
+		}
+		if shardID == leaderID {
+			return nil, 0, fmt.Errorf("cannot %s cosigner %d while it is the raft leader, "+
+				"transfer leadership first with horcrux elect", op, shardID)
+		}
+		for _, c := range current {
+			if c.ShardID != shardID {
+				next = append(next, c)
+			} else if op == MembershipOpReplace {
+				// the cosigner keeps its leader priority and region on its new host.
+				c.P2PAddr = p2pAddr
+				next = append(next, c)
+			}
+		}
+	default:
+		return nil, 0, fmt.Errorf("unknown membership op: %s", op)
+	}
+
+	return next, shardID, nil
+}
+
+// SetCosigners replaces the cosigner set in config and persists it to the config file.
+// Signers which are already loaded are reloaded so that signature shares combine with the new set.
+func (cosigner *LocalCosigner) SetCosigners(cosigners CosignersConfig) error {
+	cosigner.membershipMu.Lock()
+	defer cosigner.membershipMu.Unlock()
+
+	cosigner.config.Config.ThresholdModeConfig.Cosigners = cosigners
+
+	var err error
+	cosigner.chainState.Range(func(k, v interface{}) bool {
+		err = cosigner.reloadSigner(k.(string), v.(*ChainState))
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if cosigner.config.ConfigFile == "" {
+		return nil
+	}
+	return cosigner.config.WriteConfigFile()
+}
+
+// validateThresholdModeConfig checks that the threshold config remains valid with the given threshold
+// and cosigner set, and that nonces can be encrypted for every cosigner in it.
+func (cosigner *LocalCosigner) validateThresholdModeConfig(threshold int, cosigners CosignersConfig) error {
+	cosigner.membershipMu.RLock()
+	cfg := cosigner.config.Config
+	thresholdCfg := *cfg.ThresholdModeConfig
+	cosigner.membershipMu.RUnlock()
+
+	thresholdCfg.Threshold = threshold
+	thresholdCfg.Cosigners = cosigners
+	cfg.ThresholdModeConfig = &thresholdCfg
+	if err := cfg.ValidateThresholdModeConfig(); err != nil {
+		return err
+	}
+
+	// the security layer only exposes encryption, so probe it with an empty payload.
+	for _, c := range cosigners {
+		if c.ShardID == cosigner.GetID() {
+			continue
+		}
+		if _, err := cosigner.security.EncryptAndSign(c.ShardID, nil, nil); err != nil {
+			return fmt.Errorf("cosigner %d is missing from the cosigner encryption keys: %w", c.ShardID, err)
+		}
+	}
+
+	return nil
+}
+
+// reloadSigner replaces the signer for chainID, keeping the last sign state and raw bytes sign state.
+// The caller must hold membershipMu.
+func (cosigner *LocalCosigner) reloadSigner(chainID string, ccs *ChainState) error {
+	signer, err := NewThresholdSigner(cosigner.config, cosigner.GetID(), chainID)
+	if err != nil {
+		return err
+	}
+	cosigner.chainState.Store(chainID, &ChainState{
+		lastSignState: ccs.lastSignState,
+		signer:        signer,
+		pubShares:     cosigner.loadPubShares(chainID),
+		rawBytes:      ccs.rawBytes,
+	})
+	return nil
+}
+
+// ChangeMembership changes the cosigner set of the cluster and returns the affected shard ID.
+// Only the raft leader can change membership. The raft configuration is updated, then the
+// new cosigner set is replicated so that every cosigner updates its peers and config file.
+func (s *RaftStore) ChangeMembership(op MembershipOp, shardID int, p2pAddr string) (int, error) {
+	if !s.IsLeader() {
+		return 0, errors.New("not leader")
+	}
+
+	s.membershipMu.Lock()
+	defer s.membershipMu.Unlock()
+
+	cosigners, shardID, err := changeCosigners(s.cosigner.cosigners(), s.cosigner.GetID(), op, shardID, p2pAddr)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.cosigner.validateThresholdModeConfig(s.cosigner.threshold(), cosigners); err != nil {
+		return 0, err
+	}
+
+	nodeID := fmt.Sprint(shardID)
+	membership := ClusterMembership{Cosigners: cosigners}
+
+	switch op {
+	case MembershipOpAdd, MembershipOpReplace:
+		// The raft transport only dials cosigners which mutual TLS knows, so it must know the new one
+		// before it joins. Every other cosigner learns it when the membership change is committed.
+		if s.tls != nil {
+			s.tls.SetCosigners(cosigners)
+		}
+		// Join replaces an existing server with the same ID.
+		if err := s.Join(nodeID, p2pURLToRaftAddress(p2pAddr)); err != nil {
+			if s.tls != nil {
+				s.tls.SetCosigners(s.cosigner.cosigners())
+			}
+			return 0, fmt.Errorf("failed to update raft configuration: %w", err)
+		}
+		if err := s.Emit(raftEventMembership, membership); err != nil {
+			return 0, fmt.Errorf("failed to commit membership change: %w", err)
+		}
+	case MembershipOpRemove:
+		// commit first so the removed cosigner also learns it is no longer a member.
+		if err := s.Emit(raftEventMembership, membership); err != nil {
+			return 0, fmt.Errorf("failed to commit membership change: %w", err)
+		}
+		if err := s.raft.RemoveServer(raft.ServerID(nodeID), 0, 0).Error(); err != nil {
+			return 0, fmt.Errorf("failed to update raft configuration: %w", err)
+		}
+	}
+
+	s.logger.Info(
+		"Changed cluster membership",
+		"op", op.String(),
+		"shard_id", shardID,
+		"p2p_addr", p2pAddr,
+	)
+
+	return shardID, nil
+}
+
+// applyMembership updates this node's peers and config to the replicated cosigner set.
+func (s *RaftStore) applyMembership(membership ClusterMembership) error {
+	id := s.cosigner.GetID()
+	current := Cosigners(s.getCosigners())
+
+	member := false
+	peers := make([]Cosigner, 0, len(membership.Cosigners))
+	for _, c := range membership.Cosigners {
+		if c.ShardID == id {
+			member = true
+			continue
+		}
+		if existing := current.GetByID(c.ShardID); existing != nil && existing.GetAddress() == c.P2PAddr {
+			peers = append(peers, existing)
+			continue
+		}
+		peer, err := NewRemoteCosigner(c.ShardID, c.P2PAddr, s.tls)
+		if err != nil {
+			return err
+		}
+		peers = append(peers, peer)
+	}
+
+	if err := s.cosigner.SetCosigners(membership.Cosigners); err != nil {
+		return err
+	}
+	if s.tls != nil {
+		s.tls.SetCosigners(membership.Cosigners)
+	}
+	s.setCosigners(peers)
+	if s.thresholdValidator != nil {
+		s.thresholdValidator.SetPeerCosigners(peers)
+	}
+
+	if !member {
+		s.logger.Error("This cosigner was removed from the cluster and will no longer participate in signing")
+	}
+
+	return nil
+}
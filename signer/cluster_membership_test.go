@@ -0,0 +1,192 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeCosigners(t *testing.T) {
+	current := CosignersConfig{
+		{ShardID: 1, P2PAddr: "tcp://cosigner-1:2222"},
+		{ShardID: 2, P2PAddr: "tcp://cosigner-2:2222"},
+		{ShardID: 3, P2PAddr: "tcp://cosigner-3:2222", LeaderPriority: 10, Region: "eu-west"},
+	}
+
+	type testCase struct {
+		name        string
+		op          MembershipOp
+		shardID     int
+		p2pAddr     string
+		expect      CosignersConfig
+		expectID    int
+		expectError string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "add next shard ID",
+			op:      MembershipOpAdd,
+			p2pAddr: "tcp://cosigner-4:2222",
+			expect: CosignersConfig{
+				{ShardID: 1, P2PAddr: "tcp://cosigner-1:2222"},
+				{ShardID: 2, P2PAddr: "tcp://cosigner-2:2222"},
+				{ShardID: 3, P2PAddr: "tcp://cosigner-3:2222", LeaderPriority: 10, Region: "eu-west"},
+				{ShardID: 4, P2PAddr: "tcp://cosigner-4:2222"},
+			},
+			expectID: 4,
+		},
+		{
+			name:        "add existing shard ID",
+			op:          MembershipOpAdd,
+			shardID:     2,
+			p2pAddr:     "tcp://cosigner-4:2222",
+			expectError: "cosigner 2 is already a member of the cluster",
+		},
+		{
+			name:        "add existing address",
+			op:          MembershipOpAdd,
+			p2pAddr:     "tcp://cosigner-3:2222",
+			expectError: "p2p address tcp://cosigner-3:2222 is already used by cosigner 3",
+		},
+		{
+			name:    "remove",
+			op:      MembershipOpRemove,
+			shardID: 2,
+			expect: CosignersConfig{
+				{ShardID: 1, P2PAddr: "tcp://cosigner-1:2222"},
+				{ShardID: 3, P2PAddr: "tcp://cosigner-3:2222", LeaderPriority: 10, Region: "eu-west"},
+			},
+			expectID: 2,
+		},
+		{
+			name:        "remove leader",
+			op:          MembershipOpRemove,
+			shardID:     1,
+			expectError: "cannot remove cosigner 1 while it is the raft leader",
+		},
+		{
+			name:        "remove unknown",
+			op:          MembershipOpRemove,
+			shardID:     5,
+			expectError: "cosigner 5 is not a member of the cluster",
+		},
+		{
+			name:    "replace",
+			op:      MembershipOpReplace,
+			shardID: 3,
+			p2pAddr: "tcp://cosigner-3b:2222",
+			expect: CosignersConfig{
+				{ShardID: 1, P2PAddr: "tcp://cosigner-1:2222"},
+				{ShardID: 2, P2PAddr: "tcp://cosigner-2:2222"},
+				{ShardID: 3, P2PAddr: "tcp://cosigner-3b:2222", LeaderPriority: 10, Region: "eu-west"},
+			},
+			expectID: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, shardID, err := changeCosigners(current, 1, tc.op, tc.shardID, tc.p2pAddr)
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, next)
+			require.Equal(t, tc.expectID, shardID)
+		})
+	}
+
+	// the current set is not modified.
+	require.Len(t, current, 3)
+	require.Equal(t, "tcp://cosigner-3:2222", current[2].P2PAddr)
+}
+
+func TestRemoveCosignerKeepsSigning(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+
+	// remove cosigner 2, leaving non-contiguous shard IDs.
+	remaining := []*LocalCosigner{cosigners[0], cosigners[2]}
+	for _, c := range remaining {
+		defer c.waitForSignStatesToFlushToDisk()
+
+		require.NoError(t, c.LoadSignStateIfNecessary(testChainID))
+		require.NoError(t, c.SetCosigners(CosignersConfig{{ShardID: 1}, {ShardID: 3}}))
+	}
+
+	signBytes, sig := testSignWithLocalCosigners(t, remaining, testChainID, 1)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+}
+
+func TestApplyMembershipUpdatesCosignerTLS(t *testing.T) {
+	cosigners, _ := getTestLocalCosigners(t, 2, 3)
+
+	caCert, caKey, err := CreateCosignerTLSCA()
+	require.NoError(t, err)
+
+	cosigner1 := newTestCosignerTLS(t, caCert, caKey, 1)
+	cosigner2 := newTestCosignerTLS(t, caCert, caKey, 2)
+	cosigner4 := newTestCosignerTLS(t, caCert, caKey, 4)
+
+	store := &RaftStore{
+		logger:   cometlog.NewNopLogger(),
+		cosigner: cosigners[0],
+	}
+	store.SetTLS(cosigner1)
+
+	// cosigner 4 is not a member yet, so it can neither connect nor be dialed.
+	_, serverErr := testTLSHandshake(t, cosigner4.ClientConfig(1), cosigner1.ServerConfig())
+	require.ErrorContains(t, serverErr, "not in the cluster")
+	require.ErrorContains(t, testTransportHandshake(t, cosigner1, "127.0.0.1:2224", cosigner4),
+		"address does not belong to a configured cosigner")
+
+	// replace cosigner 2 with cosigner 4.
+	require.NoError(t, store.applyMembership(ClusterMembership{Cosigners: CosignersConfig{
+		{ShardID: 1, P2PAddr: "tcp://127.0.0.1:2221"},
+		{ShardID: 3, P2PAddr: "tcp://127.0.0.1:2223"},
+		{ShardID: 4, P2PAddr: "tcp://127.0.0.1:2224"},
+	}}))
+
+	clientErr, serverErr := testTLSHandshake(t, cosigner4.ClientConfig(1), cosigner1.ServerConfig())
+	require.NoError(t, clientErr)
+	require.NoError(t, serverErr)
+	require.NoError(t, testTransportHandshake(t, cosigner1, "127.0.0.1:2224", cosigner4))
+
+	// cosigner 2 was removed, so it can neither connect nor be dialed anymore.
+	_, serverErr = testTLSHandshake(t, cosigner2.ClientConfig(1), cosigner1.ServerConfig())
+	require.ErrorContains(t, serverErr, "not in the cluster")
+	require.ErrorContains(t, testTransportHandshake(t, cosigner1, "127.0.0.1:2222", cosigner2),
+		"address does not belong to a configured cosigner")
+}
+
+// testTransportHandshake dials server through the raft transport credentials of client,
+// as if server listened on the p2p host:port address.
+func testTransportHandshake(t *testing.T, client *CosignerTLS, address string, server *CosignerTLS) error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = tls.Server(conn, server.ServerConfig()).Handshake()
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tlsConn, _, err := client.TransportCredentials().ClientHandshake(context.Background(), address, conn)
+	if tlsConn != nil {
+		tlsConn.Close()
+	}
+	return err
+}
@@ -1,19 +1,28 @@
 package signer
 
 import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto"
+	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cometjson "github.com/cometbft/cometbft/libs/json"
+	cometnet "github.com/cometbft/cometbft/libs/net"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/strangelove-ventures/horcrux/v3/client"
@@ -35,6 +44,42 @@ type Config struct {
 	ChainNodes          ChainNodes           `yaml:"chainNodes"`
 	DebugAddr           string               `yaml:"debugAddr"`
 	GRPCAddr            string               `yaml:"grpcAddr"`
+
+	// PrivValListeners accept privval connections from chain nodes, in addition to dialing ChainNodes.
+	PrivValListeners PrivValListeners `yaml:"privValListeners,omitempty"`
+
+	// SignStateStore is where sign state is kept, either file (default) or db.
+	SignStateStore string `yaml:"signStateStore,omitempty"`
+
+	// AuditJournal enables the journal of every signature produced, see AuditJournal.
+	AuditJournal bool `yaml:"auditJournal,omitempty"`
+
+	// Chains lists the chain IDs horcrux may sign for. Any chain ID with a key file is allowed when empty.
+	Chains ChainsConfig `yaml:"chains,omitempty"`
+
+	// Shadow runs horcrux in shadow mode when set, withholding every signature, see ShadowValidator.
+	Shadow *ShadowConfig `yaml:"shadow,omitempty"`
+}
+
+// ShadowConfig configures shadow mode.
+type ShadowConfig struct {
+	// PubKeys are the public keys returned to chain nodes by chain ID, instead of the key horcrux signs with,
+	// in the JSON printed by cometbft show-validator. This allows a shadow cluster to sign with a throwaway
+	// test key while chain nodes still send it the sign requests of the production validator.
+	PubKeys map[string]string `yaml:"pubKeys,omitempty"`
+}
+
+// ParsePubKeys returns the public keys to return to chain nodes by chain ID.
+func (cfg *ShadowConfig) ParsePubKeys() (map[string]crypto.PubKey, error) {
+	pubKeys := make(map[string]crypto.PubKey, len(cfg.PubKeys))
+	for chainID, pubKeyJSON := range cfg.PubKeys {
+		var pubKey crypto.PubKey
+		if err := cometjson.Unmarshal([]byte(pubKeyJSON), &pubKey); err != nil {
+			return nil, fmt.Errorf("invalid shadow pubKey for chain %s: %w", chainID, err)
+		}
+		pubKeys[chainID] = pubKey
+	}
+	return pubKeys, nil
 }
 
 func (c *Config) Nodes() (out []string) {
@@ -53,10 +98,102 @@ func (c *Config) MustMarshalYaml() []byte {
 }
 
 func (c *Config) ValidateSingleSignerConfig() error {
-	return c.ChainNodes.Validate()
+	if err := c.ChainNodes.Validate(); err != nil {
+		return err
+	}
+	if err := c.PrivValListeners.Validate(); err != nil {
+		return err
+	}
+	switch c.SignStateStore {
+	case "", SignStateStoreFile, SignStateStoreDB:
+	default:
+		return fmt.Errorf("signStateStore must be %s or %s, got %q", SignStateStoreFile, SignStateStoreDB, c.SignStateStore)
+	}
+	if c.Shadow != nil {
+		if _, err := c.Shadow.ParsePubKeys(); err != nil {
+			return err
+		}
+	}
+	return c.Chains.Validate()
+}
+
+// CheckChainID returns an ErrChainNotAllowed error if chainID is not in the chains allow-list.
+func (c *Config) CheckChainID(chainID string) error {
+	if len(c.Chains) == 0 || c.Chains.Get(chainID) != nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is not listed in chains", ErrChainNotAllowed, chainID)
+}
+
+// CheckSignPolicy returns an error if the chain policy does not allow signing chainID at height.
+func (c *Config) CheckSignPolicy(chainID string, height int64) error {
+	if err := c.CheckChainID(chainID); err != nil {
+		return err
+	}
+	chain := c.Chains.Get(chainID)
+	if chain == nil {
+		return nil
+	}
+	if chain.SignWindow != nil {
+		if err := chain.SignWindow.Check(chainID, height); err != nil {
+			return err
+		}
+	}
+	return chain.SignFreeze().Check(height, time.Now())
+}
+
+// ChainPrivValProtocol returns the privval protocol of the chain nodes of chainID.
+func (c *Config) ChainPrivValProtocol(chainID string) PrivValProtocol {
+	if chain := c.Chains.Get(chainID); chain != nil && chain.PrivValProtocol != "" {
+		return chain.PrivValProtocol
+	}
+	return PrivValProtocolCometBFT038
+}
+
+// PrivValProtocols returns the privval protocol of every chain which sets one.
+func (c *Config) PrivValProtocols() map[string]PrivValProtocol {
+	protocols := make(map[string]PrivValProtocol)
+	for _, chain := range c.Chains {
+		if chain.PrivValProtocol != "" {
+			protocols[chain.ChainID] = chain.PrivValProtocol
+		}
+	}
+	return protocols
+}
+
+// ChainSigningProtocol returns the threshold signing protocol of the cosigners for chainID.
+func (c *Config) ChainSigningProtocol(chainID string) SigningProtocol {
+	if chain := c.Chains.Get(chainID); chain != nil && chain.SigningProtocol != "" {
+		return chain.SigningProtocol
+	}
+	return SigningProtocolTSED25519
+}
+
+// CheckRawBytesPolicy returns an error if the chain policy does not allow signing raw bytes for uniqueID.
+// Raw bytes are only signed for chains listed in chains with rawBytes set, and not during a freeze window.
+func (c *Config) CheckRawBytesPolicy(chainID, uniqueID string) error {
+	chain := c.Chains.Get(chainID)
+	if chain == nil || chain.RawBytes == nil {
+		return fmt.Errorf("%w for chain %s, rawBytes is not set for the chain", ErrRawBytesNotAllowed, chainID)
+	}
+	if err := chain.SignFreeze().Check(0, time.Now()); err != nil {
+		return err
+	}
+	return chain.RawBytes.CheckUniqueID(uniqueID)
+}
+
+// ChainThreshold returns the number of cosigners required to sign for chainID,
+// which is the cluster threshold unless the chain raises it.
+func (c *Config) ChainThreshold(chainID string, threshold int) int {
+	if chain := c.Chains.Get(chainID); chain != nil && chain.Threshold > threshold {
+		return chain.Threshold
+	}
+	return threshold
 }
 
 func (c *Config) ValidateThresholdModeConfig() error {
+	// the chain nodes, privval listeners, sign state store, shadow public keys and chains are validated
+	// the same in both sign modes.
 	if err := c.ValidateSingleSignerConfig(); err != nil {
 		return err
 	}
@@ -86,10 +223,50 @@ func (c *Config) ValidateThresholdModeConfig() error {
 		return fmt.Errorf("invalid grpcTimeout: %w", err)
 	}
 
-	if err := c.ThresholdModeConfig.Cosigners.Validate(); err != nil {
+	if c.ThresholdModeConfig.TLS != nil {
+		if err := c.ThresholdModeConfig.TLS.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.ThresholdModeConfig.Backend.Validate(); err != nil {
 		return err
 	}
 
+	if c.ThresholdModeConfig.Fence != nil {
+		if err := c.ThresholdModeConfig.Fence.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, chain := range c.Chains {
+		// the external shard signer only signs partial signatures for dealt nonces.
+		if chain.SigningProtocol == SigningProtocolFROST &&
+			c.ThresholdModeConfig.Backend.BackendType() == ThresholdSignerBackendExternal {
+			return fmt.Errorf("signingProtocol %s for chain %s is not supported by the %s backend",
+				SigningProtocolFROST, chain.ChainID, ThresholdSignerBackendExternal)
+		}
+		if chain.Threshold == 0 {
+			continue
+		}
+		// key shards and nonces are dealt with the cluster threshold, so a chain can only require more cosigners.
+		if chain.Threshold < c.ThresholdModeConfig.Threshold || chain.Threshold > numShards {
+			return fmt.Errorf("threshold (%d) for chain %s must be between the cluster threshold (%d) and "+
+				"number of shards (%d)", chain.Threshold, chain.ChainID, c.ThresholdModeConfig.Threshold, numShards)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, region := range c.ThresholdModeConfig.LeaderRegions {
+		if region == "" {
+			return fmt.Errorf("leaderRegions must not contain an empty region")
+		}
+		if seen[region] {
+			return fmt.Errorf("leaderRegions contains region %s more than once", region)
+		}
+		seen[region] = true
+	}
+
 	return c.ThresholdModeConfig.Cosigners.Validate()
 }
 
@@ -137,6 +314,9 @@ func (c RuntimeConfig) cachedKeyDirectory() string {
 }
 
 func (c RuntimeConfig) KeyFilePathSingleSigner(chainID string) string {
+	if chain := c.Config.Chains.Get(chainID); chain != nil && chain.KeyFile != "" {
+		return c.homeDirPath(chain.KeyFile)
+	}
 	keyDir := c.HomeDir
 	if kd := c.cachedKeyDirectory(); kd != "" {
 		keyDir = kd
@@ -145,6 +325,9 @@ func (c RuntimeConfig) KeyFilePathSingleSigner(chainID string) string {
 }
 
 func (c RuntimeConfig) KeyFilePathCosigner(chainID string) string {
+	if chain := c.Config.Chains.Get(chainID); chain != nil && chain.KeyFile != "" {
+		return c.homeDirPath(chain.KeyFile)
+	}
 	keyDir := c.HomeDir
 	if kd := c.cachedKeyDirectory(); kd != "" {
 		keyDir = kd
@@ -152,6 +335,11 @@ func (c RuntimeConfig) KeyFilePathCosigner(chainID string) string {
 	return filepath.Join(keyDir, fmt.Sprintf("%s_shard.json", chainID))
 }
 
+// KeyFilePathCosignerRefresh is where a refreshed key shard is staged until the refresh session commits.
+func (c RuntimeConfig) KeyFilePathCosignerRefresh(chainID string, sessionID []byte) string {
+	return fmt.Sprintf("%s.%x.pending", c.KeyFilePathCosigner(chainID), sessionID)
+}
+
 func (c RuntimeConfig) KeyFilePathCosignerRSA() string {
 	keyDir := c.HomeDir
 	if kd := c.cachedKeyDirectory(); kd != "" {
@@ -168,14 +356,103 @@ func (c RuntimeConfig) KeyFilePathCosignerECIES() string {
 	return filepath.Join(keyDir, "ecies_keys.json")
 }
 
+// CosignerTLS loads the mutual TLS material for cosigner p2p connections.
+// It returns nil if TLS is not configured.
+func (c RuntimeConfig) CosignerTLS() (*CosignerTLS, error) {
+	thresholdCfg := c.Config.ThresholdModeConfig
+	if thresholdCfg == nil || thresholdCfg.TLS == nil {
+		return nil, nil
+	}
+	if err := thresholdCfg.TLS.Validate(); err != nil {
+		return nil, err
+	}
+	return LoadCosignerTLS(
+		c.homeDirPath(thresholdCfg.TLS.CACert),
+		c.homeDirPath(thresholdCfg.TLS.Cert),
+		c.homeDirPath(thresholdCfg.TLS.Key),
+		thresholdCfg.Cosigners,
+	)
+}
+
+// SignFenceTLS loads the mutual TLS material for connections to the lock service.
+// It returns nil if the fence is not configured with TLS.
+func (c RuntimeConfig) SignFenceTLS() (*tls.Config, error) {
+	thresholdCfg := c.Config.ThresholdModeConfig
+	if thresholdCfg == nil || thresholdCfg.Fence == nil || thresholdCfg.Fence.TLS == nil {
+		return nil, nil
+	}
+	tlsCfg := thresholdCfg.Fence.TLS
+	return LoadFenceTLS(c.homeDirPath(tlsCfg.CACert), c.homeDirPath(tlsCfg.Cert), c.homeDirPath(tlsCfg.Key), false)
+}
+
+// ShardSignerSocket is the unix socket of the shard signer for the external backend.
+// It returns an empty string if no socket is configured.
+func (c RuntimeConfig) ShardSignerSocket() string {
+	thresholdCfg := c.Config.ThresholdModeConfig
+	if thresholdCfg == nil || thresholdCfg.Backend == nil || thresholdCfg.Backend.Socket == "" {
+		return ""
+	}
+	return c.homeDirPath(thresholdCfg.Backend.Socket)
+}
+
+func (c RuntimeConfig) homeDirPath(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(c.HomeDir, file)
+}
+
+// NodeKeyFile is the key horcrux authenticates with on the secret connections to chain nodes.
+func (c RuntimeConfig) NodeKeyFile() string {
+	return filepath.Join(c.HomeDir, "node_key.json")
+}
+
 func (c RuntimeConfig) PrivValStateFile(chainID string) string {
 	return filepath.Join(c.StateDir, fmt.Sprintf("%s_priv_validator_state.json", chainID))
 }
 
+// RawBytesStateFile is the raw bytes sign state of chainID, see RawBytesSignState.
+func (c RuntimeConfig) RawBytesStateFile(chainID string) string {
+	return filepath.Join(c.StateDir, fmt.Sprintf("%s_raw_bytes_state.json", chainID))
+}
+
+func (c RuntimeConfig) AuditJournalFile() string {
+	return filepath.Join(c.StateDir, "audit_journal.jsonl")
+}
+
 func (c RuntimeConfig) CosignerStateFile(chainID string) string {
 	return filepath.Join(c.StateDir, fmt.Sprintf("%s_share_sign_state.json", chainID))
 }
 
+// LoadSignState loads the sign state for stateFile from the configured store.
+func (c RuntimeConfig) LoadSignState(stateFile string) (*SignState, error) {
+	store, err := NewSignStateStore(c.Config.SignStateStore, stateFile)
+	if err != nil {
+		return nil, err
+	}
+	state, err := LoadSignStateFromStore(store)
+	if err != nil {
+		_ = store.Close()
+		return nil, err
+	}
+	return state, nil
+}
+
+// LoadOrCreateSignState loads the sign state for stateFile from the configured store,
+// creating an empty sign state if none exists.
+func (c RuntimeConfig) LoadOrCreateSignState(stateFile string) (*SignState, error) {
+	store, err := NewSignStateStore(c.Config.SignStateStore, stateFile)
+	if err != nil {
+		return nil, err
+	}
+	state, err := LoadOrCreateSignStateFromStore(store)
+	if err != nil {
+		_ = store.Close()
+		return nil, err
+	}
+	return state, nil
+}
+
 func (c RuntimeConfig) WriteConfigFile() error {
 	return os.WriteFile(c.ConfigFile, c.Config.MustMarshalYaml(), 0600)
 }
@@ -221,6 +498,131 @@ type ThresholdModeConfig struct {
 	Cosigners   CosignersConfig `yaml:"cosigners"`
 	GRPCTimeout string          `yaml:"grpcTimeout"`
 	RaftTimeout string          `yaml:"raftTimeout"`
+
+	// TLS enables mutual TLS on the cosigner p2p port when set.
+	TLS *CosignerTLSConfig `yaml:"tls,omitempty"`
+
+	// Backend selects where the key shards are held. The soft backend is used when unset.
+	Backend *ThresholdSignerBackendConfig `yaml:"backend,omitempty"`
+
+	// Fence requires the leader to hold a lease from an external fence before signing,
+	// so that an active and a standby cluster can not both sign. No fence is used when unset.
+	Fence *SignFenceConfig `yaml:"fence,omitempty"`
+
+	// LeaderRegions are the regions of the cosigners preferred for raft leadership, most preferred first.
+	// Cosigners in no listed region are preferred last.
+	LeaderRegions []string `yaml:"leaderRegions,omitempty"`
+}
+
+// ThresholdSignerBackendConfig selects the ThresholdSigner backend which holds the key shards.
+type ThresholdSignerBackendConfig struct {
+	Type string `yaml:"type"`
+
+	// Socket is the unix socket of the shard signer process for the external backend.
+	// Relative paths are resolved against the horcrux home directory.
+	Socket string `yaml:"socket,omitempty"`
+
+	// SocketGroup is the group which can connect to the socket in addition to the user of the shard signer,
+	// e.g. the group of the user running horcrux start. Only the user of the shard signer can when unset.
+	SocketGroup string `yaml:"socketGroup,omitempty"`
+}
+
+// BackendType returns the configured backend type, defaulting to the soft backend.
+func (cfg *ThresholdSignerBackendConfig) BackendType() string {
+	if cfg == nil || cfg.Type == "" {
+		return ThresholdSignerBackendSoft
+	}
+	return cfg.Type
+}
+
+func (cfg *ThresholdSignerBackendConfig) Validate() error {
+	if _, err := getThresholdSignerBackend(cfg.BackendType()); err != nil {
+		return err
+	}
+	if cfg.BackendType() == ThresholdSignerBackendExternal && cfg.Socket == "" {
+		return fmt.Errorf("backend socket must not be empty for the %s backend", ThresholdSignerBackendExternal)
+	}
+	return nil
+}
+
+// SignFenceConfig configures the SignFence which the leader must pass before signing.
+type SignFenceConfig struct {
+	Type string `yaml:"type"`
+
+	// ClusterID identifies this cluster to the fence. The active and standby clusters must use different IDs.
+	ClusterID string `yaml:"clusterID"`
+
+	// Address is the address of the lock service for the lock-service fence, e.g. tcp://10.0.0.5:2300.
+	Address string `yaml:"address,omitempty"`
+
+	// LeaseDuration is how long the lease is held after each signature. Defaults to 30s.
+	LeaseDuration string `yaml:"leaseDuration,omitempty"`
+
+	// TLS enables mutual TLS with the lock service when set.
+	TLS *CosignerTLSConfig `yaml:"tls,omitempty"`
+}
+
+// FenceType returns the configured fence type, defaulting to the lock service fence.
+func (cfg *SignFenceConfig) FenceType() string {
+	if cfg.Type == "" {
+		return SignFenceLockService
+	}
+	return cfg.Type
+}
+
+// Lease returns the configured lease duration.
+func (cfg *SignFenceConfig) Lease() (time.Duration, error) {
+	if cfg.LeaseDuration == "" {
+		return defaultFenceLeaseDuration, nil
+	}
+	lease, err := time.ParseDuration(cfg.LeaseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid fence leaseDuration: %w", err)
+	}
+	if lease <= 0 {
+		return 0, fmt.Errorf("fence leaseDuration must be positive")
+	}
+	return lease, nil
+}
+
+func (cfg *SignFenceConfig) Validate() error {
+	if _, err := getSignFence(cfg.FenceType()); err != nil {
+		return err
+	}
+	if cfg.ClusterID == "" {
+		return fmt.Errorf("fence clusterID must not be empty")
+	}
+	if cfg.FenceType() == SignFenceLockService && cfg.Address == "" {
+		return fmt.Errorf("fence address must not be empty for the %s fence", SignFenceLockService)
+	}
+	if _, err := cfg.Lease(); err != nil {
+		return err
+	}
+	if cfg.TLS != nil {
+		return cfg.TLS.Validate()
+	}
+	return nil
+}
+
+// CosignerTLSConfig references the certificates used for mutual TLS between cosigners.
+// Relative paths are resolved against the horcrux home directory.
+type CosignerTLSConfig struct {
+	CACert string `yaml:"caCert"`
+	Cert   string `yaml:"cert"`
+	Key    string `yaml:"key"`
+}
+
+func (cfg *CosignerTLSConfig) Validate() error {
+	if cfg.CACert == "" {
+		return fmt.Errorf("tls caCert must not be empty")
+	}
+	if cfg.Cert == "" {
+		return fmt.Errorf("tls cert must not be empty")
+	}
+	if cfg.Key == "" {
+		return fmt.Errorf("tls key must not be empty")
+	}
+	return nil
 }
 
 func (cfg *ThresholdModeConfig) LeaderElectMultiAddress() (string, error) {
@@ -235,6 +637,39 @@ func (cfg *ThresholdModeConfig) LeaderElectMultiAddress() (string, error) {
 type CosignerConfig struct {
 	ShardID int    `yaml:"shardID"`
 	P2PAddr string `yaml:"p2pAddr"`
+
+	// LeaderPriority ranks the cosigner for raft leadership, higher first. The leader transfers
+	// leadership to a healthy cosigner which ranks higher than itself.
+	LeaderPriority int `yaml:"leaderPriority,omitempty"`
+
+	// Region tags where the cosigner runs, for the leaderRegions of the threshold config.
+	Region string `yaml:"region,omitempty"`
+}
+
+// leaderRank orders cosigners for raft leadership: cosigners in an earlier region of regions first,
+// then cosigners with a higher leader priority.
+type leaderRank struct {
+	region   int
+	priority int
+}
+
+func (c CosignerConfig) leaderRank(regions []string) leaderRank {
+	region := len(regions)
+	for i, r := range regions {
+		if r == c.Region {
+			region = i
+			break
+		}
+	}
+	return leaderRank{region: region, priority: c.LeaderPriority}
+}
+
+// above returns true if r ranks higher than o.
+func (r leaderRank) above(o leaderRank) bool {
+	if r.region != o.region {
+		return r.region < o.region
+	}
+	return r.priority > o.priority
 }
 
 type CosignersConfig []CosignerConfig
@@ -245,13 +680,10 @@ func (cosigners CosignersConfig) Validate() error {
 		return fmt.Errorf("found duplicate cosigner shard ID(s) in args: %v", dupl)
 	}
 
-	shards := len(cosigners)
-
-	// Make sure that the cosigner IDs match the number of cosigners.
+	// Shard IDs do not need to be contiguous, since cosigners can be removed from a running cluster.
 	for _, cosigner := range cosigners {
-		if cosigner.ShardID < 1 || cosigner.ShardID > shards {
-			return fmt.Errorf("cosigner shard ID %d in args is out of range, must be between 1 and %d, inclusive",
-				cosigner.ShardID, shards)
+		if cosigner.ShardID < 1 {
+			return fmt.Errorf("cosigner shard ID %d in args is out of range, must be at least 1", cosigner.ShardID)
 		}
 
 		url, err := url.Parse(cosigner.P2PAddr)
@@ -267,17 +699,37 @@ func (cosigners CosignersConfig) Validate() error {
 		if host == "0.0.0.0" {
 			return fmt.Errorf("host cannot be 0.0.0.0, must be reachable from other cosigners")
 		}
-	}
 
-	// Check that exactly {num-shards} cosigners are in the list
-	if len(cosigners) != shards {
-		return fmt.Errorf("incorrect number of cosigners. expected (%d shards = %d cosigners)",
-			shards, shards)
+		if cosigner.LeaderPriority < 0 {
+			return fmt.Errorf("cosigner (shard ID: %d) leaderPriority must not be negative", cosigner.ShardID)
+		}
 	}
 
 	return nil
 }
 
+// MaxShardID returns the highest shard ID in the cosigner set.
+// Key and nonce shares are indexed by shard ID, so this is the size of the share set.
+func (cosigners CosignersConfig) MaxShardID() int {
+	maxID := 0
+	for _, cosigner := range cosigners {
+		if cosigner.ShardID > maxID {
+			maxID = cosigner.ShardID
+		}
+	}
+	return maxID
+}
+
+// IDs returns the shard IDs in the cosigner set in ascending order.
+func (cosigners CosignersConfig) IDs() []int {
+	ids := make([]int, len(cosigners))
+	for i, cosigner := range cosigners {
+		ids[i] = cosigner.ShardID
+	}
+	sort.Ints(ids)
+	return ids
+}
+
 func duplicateCosigners(cosigners []CosignerConfig) (duplicates map[int][]string) {
 	idAddrs := make(map[int][]string)
 	for _, cosigner := range cosigners {
@@ -312,15 +764,179 @@ func CosignersFromFlag(cosigners []string) (out []CosignerConfig, err error) {
 	return out, nil
 }
 
+// ErrChainNotAllowed is returned for requests for a chain ID which is not in the chains allow-list.
+var ErrChainNotAllowed = errors.New("chain ID is not allowed")
+
+// ChainConfig allows horcrux to sign for a chain ID, with an optional per-chain policy.
+type ChainConfig struct {
+	ChainID string `yaml:"chainID"`
+
+	// KeyFile overrides the path of the key shard, or the key in single signer mode.
+	// Relative paths are resolved against the horcrux home directory.
+	KeyFile string `yaml:"keyFile,omitempty"`
+
+	// Threshold raises the number of cosigners which must sign for this chain above the cluster threshold.
+	Threshold int `yaml:"threshold,omitempty"`
+
+	// SignWindow restricts the heights which may be signed for this chain.
+	SignWindow *SignWindow `yaml:"signWindow,omitempty"`
+
+	// HaltHeight stops signing for this chain at and above this height, e.g. for a chain upgrade.
+	HaltHeight int64 `yaml:"haltHeight,omitempty"`
+
+	// FreezeWindows stop signing for this chain during periods of time.
+	FreezeWindows []FreezeWindow `yaml:"freezeWindows,omitempty"`
+
+	// RawBytes allows SignRawBytes requests for this chain. They are refused when it is not set.
+	RawBytes *RawBytesConfig `yaml:"rawBytes,omitempty"`
+
+	// PrivValProtocol is the privval protocol of the chain nodes of this chain, v0.38 when empty.
+	// Set it to v0.37 or v0.34 for chain nodes before CometBFT v0.38, which have no vote extensions.
+	PrivValProtocol PrivValProtocol `yaml:"privValProtocol,omitempty"`
+
+	// SigningProtocol is the threshold signing protocol of the cosigners for this chain, tsed25519 when empty.
+	// Set it to frost to sign with FROST, which needs a backend holding the key shard in horcrux.
+	SigningProtocol SigningProtocol `yaml:"signingProtocol,omitempty"`
+}
+
+// SignFreeze returns the halt height and freeze windows of the chain.
+func (c ChainConfig) SignFreeze() SignFreeze {
+	return SignFreeze{
+		ChainID:       c.ChainID,
+		HaltHeight:    c.HaltHeight,
+		FreezeWindows: c.FreezeWindows,
+	}
+}
+
+// SignWindow is a range of heights which may be signed. A zero bound is unbounded.
+type SignWindow struct {
+	StartHeight int64 `yaml:"startHeight,omitempty"`
+	EndHeight   int64 `yaml:"endHeight,omitempty"`
+}
+
+func (w *SignWindow) Validate() error {
+	if w.StartHeight < 0 || w.EndHeight < 0 {
+		return fmt.Errorf("signWindow heights must not be negative")
+	}
+	if w.EndHeight != 0 && w.EndHeight < w.StartHeight {
+		return fmt.Errorf("signWindow endHeight (%d) must not be below startHeight (%d)", w.EndHeight, w.StartHeight)
+	}
+	return nil
+}
+
+// Check returns an error if height is outside the window.
+func (w *SignWindow) Check(chainID string, height int64) error {
+	if height < w.StartHeight {
+		return fmt.Errorf("height %d for chain %s is below the sign window start height %d",
+			height, chainID, w.StartHeight)
+	}
+	if w.EndHeight != 0 && height > w.EndHeight {
+		return fmt.Errorf("height %d for chain %s is above the sign window end height %d",
+			height, chainID, w.EndHeight)
+	}
+	return nil
+}
+
+type ChainsConfig []ChainConfig
+
+// Get returns the config for chainID, or nil if it is not listed.
+func (cs ChainsConfig) Get(chainID string) *ChainConfig {
+	for i, c := range cs {
+		if c.ChainID == chainID {
+			return &cs[i]
+		}
+	}
+	return nil
+}
+
+func (cs ChainsConfig) Validate() error {
+	seen := make(map[string]bool, len(cs))
+	for _, c := range cs {
+		if c.ChainID == "" {
+			return fmt.Errorf("chains entry is missing chainID")
+		}
+		if seen[c.ChainID] {
+			return fmt.Errorf("chain %s is listed more than once in chains", c.ChainID)
+		}
+		seen[c.ChainID] = true
+		if c.Threshold < 0 {
+			return fmt.Errorf("threshold for chain %s must not be negative", c.ChainID)
+		}
+		if c.SignWindow != nil {
+			if err := c.SignWindow.Validate(); err != nil {
+				return fmt.Errorf("chain %s: %w", c.ChainID, err)
+			}
+		}
+		if err := c.SignFreeze().Validate(); err != nil {
+			return fmt.Errorf("chain %s: %w", c.ChainID, err)
+		}
+		if err := c.PrivValProtocol.Validate(); err != nil {
+			return fmt.Errorf("chain %s: %w", c.ChainID, err)
+		}
+		if err := c.SigningProtocol.Validate(); err != nil {
+			return fmt.Errorf("chain %s: %w", c.ChainID, err)
+		}
+	}
+	return nil
+}
+
 type ChainNode struct {
 	PrivValAddr string `json:"privValAddr" yaml:"privValAddr"`
+
+	// ChainID restricts the node to requests for a single chain ID. Any chain ID is allowed when empty.
+	ChainID string `json:"chainID,omitempty" yaml:"chainID,omitempty"`
+
+	// PubKey is the base64 ed25519 public key the node must authenticate with on the secret connection.
+	// Any key is accepted when empty. Unix sockets have no secret connection, so it can not be set for them.
+	PubKey string `json:"pubKey,omitempty" yaml:"pubKey,omitempty"`
 }
 
 func (cn ChainNode) Validate() error {
-	_, err := url.Parse(cn.PrivValAddr)
+	proto, _, err := parsePrivValAddr(cn.PrivValAddr)
+	if err != nil {
+		return err
+	}
+	if proto == protocolUnix && cn.PubKey != "" {
+		return fmt.Errorf("chain node %s: pubKey can not be pinned on a unix socket", cn.PrivValAddr)
+	}
+	_, err = cn.RemotePubKey()
 	return err
 }
 
+// RemotePubKey returns the public key expected of the node, or nil if any key is accepted.
+func (cn ChainNode) RemotePubKey() (crypto.PubKey, error) {
+	if cn.PubKey == "" {
+		return nil, nil
+	}
+	bz, err := base64.StdEncoding.DecodeString(cn.PubKey)
+	if err != nil || len(bz) != cometcryptoed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid pubKey %q for chain node %s, must be a base64 ed25519 public key",
+			cn.PubKey, cn.PrivValAddr)
+	}
+	return cometcryptoed25519.PubKey(bz), nil
+}
+
+const protocolUnix = "unix"
+
+// parsePrivValAddr returns the protocol and address of a privval address, which must be a tcp address
+// or a unix socket with an absolute path. Addresses without a protocol are tcp addresses.
+func parsePrivValAddr(addr string) (proto string, address string, err error) {
+	if _, err := url.Parse(addr); err != nil {
+		return "", "", err
+	}
+	proto, address = cometnet.ProtocolAndAddress(addr)
+	switch proto {
+	case "tcp":
+	case protocolUnix:
+		if !filepath.IsAbs(address) {
+			return "", "", fmt.Errorf("unix socket path of %s must be absolute", addr)
+		}
+	default:
+		return "", "", fmt.Errorf("%s must be a tcp:// or unix:// address", addr)
+	}
+	return proto, address, nil
+}
+
 type ChainNodes []ChainNode
 
 func (cns ChainNodes) Validate() error {
@@ -335,6 +951,63 @@ func (cns ChainNodes) Validate() error {
 	return nil
 }
 
+// ForChain returns the nodes which are allowed to make requests for chainID.
+func (cns ChainNodes) ForChain(chainID string) ChainNodes {
+	var out ChainNodes
+	for _, cn := range cns {
+		if cn.ChainID == "" || cn.ChainID == chainID {
+			out = append(out, cn)
+		}
+	}
+	return out
+}
+
+// PrivValListener accepts privval connections from chain nodes which dial horcrux.
+type PrivValListener struct {
+	// ListenAddr is the tcp:// or unix:// address to listen on.
+	ListenAddr string `json:"listenAddr" yaml:"listenAddr"`
+
+	// ChainID restricts the connections to requests for a single chain ID. Any chain ID is allowed when empty.
+	ChainID string `json:"chainID,omitempty" yaml:"chainID,omitempty"`
+
+	// MaxConnections limits the number of connections served at once. Defaults to 10.
+	MaxConnections int `json:"maxConnections,omitempty" yaml:"maxConnections,omitempty"`
+
+	// AllowedNodeIDs only accepts connections from chain nodes whose secret connection key has one of these IDs.
+	// Any key is accepted when empty. Unix sockets have no secret connection, so it can not be set for them.
+	AllowedNodeIDs []string `json:"allowedNodeIDs,omitempty" yaml:"allowedNodeIDs,omitempty"`
+}
+
+func (l PrivValListener) Validate() error {
+	proto, _, err := parsePrivValAddr(l.ListenAddr)
+	if err != nil {
+		return fmt.Errorf("invalid privval listenAddr: %w", err)
+	}
+	if proto == protocolUnix && len(l.AllowedNodeIDs) > 0 {
+		return fmt.Errorf("privval listener %s: allowedNodeIDs can not be checked on a unix socket", l.ListenAddr)
+	}
+	if l.MaxConnections < 0 {
+		return fmt.Errorf("privval listener maxConnections must not be negative")
+	}
+	for _, id := range l.AllowedNodeIDs {
+		if bz, err := hex.DecodeString(id); err != nil || len(bz) != crypto.AddressSize {
+			return fmt.Errorf("invalid node ID %q in allowedNodeIDs, must be %d hex characters", id, 2*crypto.AddressSize)
+		}
+	}
+	return nil
+}
+
+type PrivValListeners []PrivValListener
+
+func (ls PrivValListeners) Validate() error {
+	for _, l := range ls {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ChainNodesFromFlag(nodes []string) (ChainNodes, error) {
 	out := make(ChainNodes, len(nodes))
 	for i, n := range nodes {
@@ -365,7 +1038,7 @@ func PubKey(bech32BasePrefix string, pubKey crypto.PubKey) (string, error) {
 	marshaler := codec.NewProtoCodec(registry)
 	var pk *cryptotypes.PubKey
 	registry.RegisterInterface("cosmos.crypto.PubKey", pk)
-	registry.RegisterImplementations(pk, &ed25519.PubKey{})
+	registry.RegisterImplementations(pk, &ed25519.PubKey{}, &secp256k1.PubKey{})
 	sdkPK, err := cryptocodec.FromCmtPubKeyInterface(pubKey)
 	if err != nil {
 		return "", err
@@ -29,6 +29,70 @@ func TestNodes(t *testing.T) {
 	require.Equal(t, []string{"tcp://0.0.0.0:1234", "tcp://0.0.0.0:5678"}, c.Nodes())
 }
 
+func TestChainNodesForChain(t *testing.T) {
+	nodes := signer.ChainNodes{
+		{PrivValAddr: "tcp://0.0.0.0:1234", ChainID: "cosmoshub-4"},
+		{PrivValAddr: "tcp://0.0.0.0:5678", ChainID: "osmosis-1"},
+		{PrivValAddr: "tcp://0.0.0.0:9012"},
+	}
+
+	require.Equal(t, signer.ChainNodes{nodes[0], nodes[2]}, nodes.ForChain("cosmoshub-4"))
+	require.Equal(t, signer.ChainNodes{nodes[1], nodes[2]}, nodes.ForChain("osmosis-1"))
+	require.Equal(t, signer.ChainNodes{nodes[2]}, nodes.ForChain("juno-1"))
+}
+
+func TestChainNodeValidate(t *testing.T) {
+	pubKey := "0bRyHkUe3qPdTS/Ie2jd5ETm8R6Hv3qJb9s7HWZKqUY="
+
+	require.NoError(t, signer.ChainNode{PrivValAddr: "tcp://10.168.0.1:1234", PubKey: pubKey}.Validate())
+	require.NoError(t, signer.ChainNode{PrivValAddr: "unix:///var/run/sentry/privval.sock"}.Validate())
+
+	require.ErrorContains(t, signer.ChainNode{PrivValAddr: "unix://privval.sock"}.Validate(), "must be absolute")
+	require.ErrorContains(t, signer.ChainNode{PrivValAddr: "udp://10.168.0.1:1234"}.Validate(),
+		"must be a tcp:// or unix:// address")
+	require.ErrorContains(t, signer.ChainNode{
+		PrivValAddr: "unix:///var/run/sentry/privval.sock",
+		PubKey:      pubKey,
+	}.Validate(), "pubKey can not be pinned on a unix socket")
+	require.ErrorContains(t, signer.ChainNode{PrivValAddr: "tcp://10.168.0.1:1234", PubKey: "AAAA"}.Validate(),
+		"must be a base64 ed25519 public key")
+}
+
+func TestChainsConfig(t *testing.T) {
+	var unrestricted signer.Config
+	require.NoError(t, unrestricted.CheckSignPolicy("juno-1", 1))
+	require.Equal(t, 2, unrestricted.ChainThreshold("juno-1", 2))
+
+	c := signer.Config{
+		Chains: signer.ChainsConfig{
+			{ChainID: "cosmoshub-4", Threshold: 3},
+			{ChainID: "osmosis-1", SignWindow: &signer.SignWindow{StartHeight: 100, EndHeight: 200}},
+		},
+	}
+
+	require.NoError(t, c.CheckChainID("cosmoshub-4"))
+	err := c.CheckChainID("juno-1")
+	require.ErrorIs(t, err, signer.ErrChainNotAllowed)
+	require.EqualError(t, err, "chain ID is not allowed: juno-1 is not listed in chains")
+
+	require.NoError(t, c.CheckSignPolicy("osmosis-1", 100))
+	require.NoError(t, c.CheckSignPolicy("osmosis-1", 200))
+	require.EqualError(t, c.CheckSignPolicy("osmosis-1", 99),
+		"height 99 for chain osmosis-1 is below the sign window start height 100")
+	require.EqualError(t, c.CheckSignPolicy("osmosis-1", 201),
+		"height 201 for chain osmosis-1 is above the sign window end height 200")
+
+	require.Equal(t, 3, c.ChainThreshold("cosmoshub-4", 2))
+	require.Equal(t, 2, c.ChainThreshold("osmosis-1", 2))
+
+	rc := signer.RuntimeConfig{HomeDir: "/horcrux", Config: signer.Config{
+		Chains: signer.ChainsConfig{{ChainID: "cosmoshub-4", KeyFile: "keys/hub_shard.json"}},
+	}}
+	require.Equal(t, "/horcrux/keys/hub_shard.json", rc.KeyFilePathCosigner("cosmoshub-4"))
+	require.Equal(t, "/horcrux/keys/hub_shard.json", rc.KeyFilePathSingleSigner("cosmoshub-4"))
+	require.Equal(t, "/horcrux/osmosis-1_shard.json", rc.KeyFilePathCosigner("osmosis-1"))
+}
+
 func TestValidateSingleSignerConfig(t *testing.T) {
 	type testCase struct {
 		name      string
@@ -59,6 +123,25 @@ func TestValidateSingleSignerConfig(t *testing.T) {
 			},
 			expectErr: &url.Error{Op: "parse", URL: "abc://\\invalid_addr", Err: url.InvalidHostError("\\")},
 		},
+		{
+			name: "duplicate chain",
+			config: signer.Config{
+				Chains: signer.ChainsConfig{
+					{ChainID: "cosmoshub-4"},
+					{ChainID: "cosmoshub-4"},
+				},
+			},
+			expectErr: fmt.Errorf("chain cosmoshub-4 is listed more than once in chains"),
+		},
+		{
+			name: "invalid sign window",
+			config: signer.Config{
+				Chains: signer.ChainsConfig{
+					{ChainID: "cosmoshub-4", SignWindow: &signer.SignWindow{StartHeight: 10, EndHeight: 5}},
+				},
+			},
+			expectErr: fmt.Errorf("chain cosmoshub-4: signWindow endHeight (5) must not be below startHeight (10)"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -307,6 +390,90 @@ func TestValidateThresholdModeConfig(t *testing.T) {
 			},
 			expectErr: &url.Error{Op: "parse", URL: "abc://\\invalid_addr", Err: url.InvalidHostError("\\")},
 		},
+		{
+			name: "chain threshold above number of shards",
+			config: signer.Config{
+				ThresholdModeConfig: &signer.ThresholdModeConfig{
+					Threshold:   2,
+					RaftTimeout: "1000ms",
+					GRPCTimeout: "1000ms",
+					Cosigners: signer.CosignersConfig{
+						{
+							ShardID: 1,
+							P2PAddr: "tcp://127.0.0.1:2222",
+						},
+						{
+							ShardID: 2,
+							P2PAddr: "tcp://127.0.0.1:2223",
+						},
+						{
+							ShardID: 3,
+							P2PAddr: "tcp://127.0.0.1:2224",
+						},
+					},
+				},
+				Chains: signer.ChainsConfig{
+					{ChainID: "cosmoshub-4", Threshold: 4},
+				},
+			},
+			expectErr: fmt.Errorf("threshold (4) for chain cosmoshub-4 must be between the cluster threshold (2) " +
+				"and number of shards (3)"),
+		},
+		{
+			name: "duplicate leader region",
+			config: signer.Config{
+				ThresholdModeConfig: &signer.ThresholdModeConfig{
+					Threshold:   2,
+					RaftTimeout: "1000ms",
+					GRPCTimeout: "1000ms",
+					Cosigners: signer.CosignersConfig{
+						{
+							ShardID: 1,
+							P2PAddr: "tcp://127.0.0.1:2222",
+							Region:  "us-east",
+						},
+						{
+							ShardID: 2,
+							P2PAddr: "tcp://127.0.0.1:2223",
+							Region:  "eu-west",
+						},
+						{
+							ShardID: 3,
+							P2PAddr: "tcp://127.0.0.1:2224",
+							Region:  "eu-west",
+						},
+					},
+					LeaderRegions: []string{"eu-west", "us-east", "eu-west"},
+				},
+			},
+			expectErr: fmt.Errorf("leaderRegions contains region eu-west more than once"),
+		},
+		{
+			name: "negative leader priority",
+			config: signer.Config{
+				ThresholdModeConfig: &signer.ThresholdModeConfig{
+					Threshold:   2,
+					RaftTimeout: "1000ms",
+					GRPCTimeout: "1000ms",
+					Cosigners: signer.CosignersConfig{
+						{
+							ShardID:        1,
+							P2PAddr:        "tcp://127.0.0.1:2222",
+							LeaderPriority: -1,
+						},
+						{
+							ShardID: 2,
+							P2PAddr: "tcp://127.0.0.1:2223",
+						},
+						{
+							ShardID: 3,
+							P2PAddr: "tcp://127.0.0.1:2224",
+						},
+					},
+				},
+			},
+			expectErr: fmt.Errorf("cosigner (shard ID: 1) leaderPriority must not be negative"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -320,6 +487,34 @@ func TestValidateThresholdModeConfig(t *testing.T) {
 	}
 }
 
+func TestValidateShadowConfig(t *testing.T) {
+	shadow := &signer.ShadowConfig{PubKeys: map[string]string{"cosmoshub-4": "not a public key"}}
+
+	single := signer.Config{Shadow: shadow}
+	require.ErrorContains(t, single.ValidateSingleSignerConfig(), "invalid shadow pubKey for chain cosmoshub-4")
+
+	threshold := signer.Config{
+		Shadow: shadow,
+		ThresholdModeConfig: &signer.ThresholdModeConfig{
+			Threshold:   2,
+			RaftTimeout: "1000ms",
+			GRPCTimeout: "1000ms",
+			Cosigners: signer.CosignersConfig{
+				{ShardID: 1, P2PAddr: "tcp://127.0.0.1:2222"},
+				{ShardID: 2, P2PAddr: "tcp://127.0.0.1:2223"},
+				{ShardID: 3, P2PAddr: "tcp://127.0.0.1:2224"},
+			},
+		},
+	}
+	require.ErrorContains(t, threshold.ValidateThresholdModeConfig(), "invalid shadow pubKey for chain cosmoshub-4")
+
+	// a cluster with valid shadow public keys is valid.
+	threshold.Shadow = &signer.ShadowConfig{PubKeys: map[string]string{
+		"cosmoshub-4": `{"type":"tendermint/PubKeyEd25519","value":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}`,
+	}}
+	require.NoError(t, threshold.ValidateThresholdModeConfig())
+}
+
 func TestRuntimeConfigKeyFilePath(t *testing.T) {
 	dir := t.TempDir()
 	c := signer.RuntimeConfig{
@@ -506,7 +701,7 @@ func TestCosignerRSAPubKeysConfigValidate(t *testing.T) {
 			expectErr: nil,
 		},
 		{
-			name: "too many cosigners",
+			name: "non-contiguous shard IDs",
 			cosigners: signer.CosignersConfig{
 				{
 					ShardID: 2,
@@ -517,7 +712,21 @@ func TestCosignerRSAPubKeysConfigValidate(t *testing.T) {
 					P2PAddr: "tcp://127.0.0.1:2224",
 				},
 			},
-			expectErr: fmt.Errorf("cosigner shard ID 3 in args is out of range, must be between 1 and 2, inclusive"),
+			expectErr: nil,
+		},
+		{
+			name: "shard ID out of range",
+			cosigners: signer.CosignersConfig{
+				{
+					ShardID: 0,
+					P2PAddr: "tcp://127.0.0.1:2223",
+				},
+				{
+					ShardID: 1,
+					P2PAddr: "tcp://127.0.0.1:2224",
+				},
+			},
+			expectErr: fmt.Errorf("cosigner shard ID 0 in args is out of range, must be at least 1"),
 		},
 		{
 			name: "duplicate cosigner",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	cometcrypto "github.com/cometbft/cometbft/crypto"
@@ -54,6 +55,10 @@ type CosignerSignRequest struct {
 	UUID                   uuid.UUID
 	VoteExtensionSignBytes []byte
 	VoteExtUUID            uuid.UUID
+
+	// NonceDealers are the shard IDs of the cosigners whose nonces are combined.
+	// Every nonce the cosigner has for the UUIDs is combined when empty.
+	NonceDealers []int
 }
 
 type CosignerSignResponse struct {
@@ -70,6 +75,9 @@ type CosignerNonce struct {
 	PubKey        []byte
 	Share         []byte
 	Signature     []byte
+
+	// ShareCommitments are the commitments of the source cosigner to its nonce share for every shard ID.
+	ShareCommitments [][]byte
 }
 
 func (secretPart *CosignerNonce) toProto() *proto.Nonce {
@@ -79,6 +87,8 @@ func (secretPart *CosignerNonce) toProto() *proto.Nonce {
 		PubKey:        secretPart.PubKey,
 		Share:         secretPart.Share,
 		Signature:     secretPart.Signature,
+
+		ShareCommitments: secretPart.ShareCommitments,
 	}
 }
 
@@ -98,6 +108,8 @@ func CosignerNonceFromProto(secretPart *proto.Nonce) CosignerNonce {
 		PubKey:        secretPart.PubKey,
 		Share:         secretPart.Share,
 		Signature:     secretPart.Signature,
+
+		ShareCommitments: secretPart.ShareCommitments,
 	}
 }
 
@@ -123,6 +135,18 @@ type CosignerUUIDNonces struct {
 	Nonces CosignerNonces
 }
 
+// Dealers returns the shard IDs of the cosigners which dealt the nonces.
+func (n *CosignerUUIDNonces) Dealers() []int {
+	var dealers []int
+	for _, nonce := range n.Nonces {
+		if !slices.Contains(dealers, nonce.SourceID) {
+			dealers = append(dealers, nonce.SourceID)
+		}
+	}
+	slices.Sort(dealers)
+	return dealers
+}
+
 func (n *CosignerUUIDNonces) For(id int) *CosignerUUIDNonces {
 	res := &CosignerUUIDNonces{UUID: n.UUID}
 	for _, nonce := range n.Nonces {
@@ -155,9 +179,29 @@ type CosignerSetNoncesAndSignRequest struct {
 
 	VoteExtensionNonces    *CosignerUUIDNonces
 	VoteExtensionSignBytes []byte
-}
 
-func verifySignPayload(chainID string, signBytes, voteExtensionSignBytes []byte) (HRSTKey, bool, error) {
+	// NonceDealers are the shard IDs of the cosigners whose nonces are combined, for both the nonces
+	// and the vote extension nonces. A cosigner which did not deal must not combine its own nonce.
+	NonceDealers []int
+
+	// RawBytesUniqueID is set to sign RawBytes for the unique ID instead of a vote or proposal.
+	// The cosigner derives the sign bytes itself, so SignBytes and HRST are not used.
+	RawBytesUniqueID string
+	RawBytes         []byte
+}
+
+// verifySignPayload returns the HRST of the vote or proposal sign bytes, and whether vote extension sign bytes
+// of the vote must be signed. Vote extension sign bytes are refused for chains on a legacy privval protocol.
+// The canonical vote and proposal encodings are the same from Tendermint v0.34 to CometBFT v0.38.
+func verifySignPayload(
+	chainID string,
+	signBytes, voteExtensionSignBytes []byte,
+	protocol PrivValProtocol,
+) (HRSTKey, bool, error) {
+	if err := protocol.checkVoteExtension(chainID, voteExtensionSignBytes); err != nil {
+		return HRSTKey{}, false, err
+	}
+
 	var vote cometproto.CanonicalVote
 	voteErr := protoio.UnmarshalDelimited(signBytes, &vote)
 	if voteErr == nil && (vote.Type == cometproto.PrevoteType || vote.Type == cometproto.PrecommitType) {
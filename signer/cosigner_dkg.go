@@ -0,0 +1,835 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"filippo.io/edwards25519"
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+	"golang.org/x/sync/errgroup"
+)
+
+// dkgSessionExpiration bounds how long a cosigner keeps the secret state of an unfinished DKG session.
+const dkgSessionExpiration = 5 * time.Minute
+
+// DKGMode selects what a DKG session produces.
+type DKGMode int
+
+const (
+	// DKGModeGenerate generates a new key. Each dealer shares a random secret.
+	DKGModeGenerate DKGMode = iota
+	// DKGModeRefresh re-randomizes the shards of an existing key. Each dealer shares zero,
+	// so the key is unchanged while the new shards are independent of the old ones.
+	DKGModeRefresh
+	// DKGModeReshare hands out shards of an existing key to a new cosigner set with a new threshold.
+	// Each cosigner holding a shard deals it, and the new shards are interpolated from those dealings,
+	// so the key is never reconstructed. Cosigners without a shard only receive.
+	DKGModeReshare
+)
+
+// DKGPackage is the public broadcast of a DKG dealer: Feldman commitments to each coefficient
+// of its secret polynomial, and a proof of knowledge of the constant term.
+type DKGPackage struct {
+	SourceID    int
+	Commitments [][]byte
+	ProofR      []byte
+	ProofS      []byte
+}
+
+func (p *DKGPackage) toProto() *proto.DKGPackage {
+	return &proto.DKGPackage{
+		SourceID:    int32(p.SourceID),
+		Commitments: p.Commitments,
+		ProofR:      p.ProofR,
+		ProofS:      p.ProofS,
+	}
+}
+
+// DKGPackageFromProto converts a proto DKGPackage.
+func DKGPackageFromProto(p *proto.DKGPackage) DKGPackage {
+	return DKGPackage{
+		SourceID:    int(p.SourceID),
+		Commitments: p.Commitments,
+		ProofR:      p.ProofR,
+		ProofS:      p.ProofS,
+	}
+}
+
+type DKGPackages []DKGPackage
+
+func (packages DKGPackages) toProto() (out []*proto.DKGPackage) {
+	for _, p := range packages {
+		out = append(out, p.toProto())
+	}
+	return
+}
+
+// DKGPackagesFromProto converts a list of proto DKGPackages.
+func DKGPackagesFromProto(packages []*proto.DKGPackage) DKGPackages {
+	out := make(DKGPackages, len(packages))
+	for i, p := range packages {
+		out[i] = DKGPackageFromProto(p)
+	}
+	return out
+}
+
+// DKGCommitRequest starts a DKG session on a cosigner.
+type DKGCommitRequest struct {
+	SessionID    []byte
+	ChainID      string
+	Threshold    int
+	Participants []int
+	Mode         DKGMode
+}
+
+// DKGResult is the public outcome of a DKG session on a single cosigner.
+type DKGResult struct {
+	PubKey         []byte
+	TranscriptHash []byte
+}
+
+// DKGParticipant is a cosigner which can take part in distributed key generation.
+type DKGParticipant interface {
+	// GetID returns the shard ID of the participant.
+	GetID() int
+
+	// DKGCommit starts a session and returns the public package of the participant.
+	DKGCommit(ctx context.Context, req DKGCommitRequest) (*DKGPackage, error)
+
+	// DKGDeal verifies the packages of all participants and returns the encrypted
+	// shares dealt by this participant to each of the others.
+	DKGDeal(ctx context.Context, sessionID []byte, packages DKGPackages) ([]CosignerNonce, error)
+
+	// DKGFinalize verifies the shares dealt to this participant, and writes its key shard.
+	DKGFinalize(ctx context.Context, sessionID []byte, shares []CosignerNonce) (*DKGResult, error)
+}
+
+var (
+	_ DKGParticipant = &LocalCosigner{}
+	_ DKGParticipant = &RemoteCosigner{}
+)
+
+// dkgSession is the state held by a LocalCosigner between the rounds of a DKG.
+type dkgSession struct {
+	req        DKGCommitRequest
+	context    []byte
+	polynomial dkgPolynomial
+	pkg        DKGPackage
+
+	// public key of the shard being refreshed or reshared, if this cosigner holds one.
+	pubKey []byte
+
+	// set once the packages of all participants have been verified.
+	commitments map[int][]*edwards25519.Point
+	transcript  []byte
+	// lagrange weights the dealings of each dealer when resharing. nil weights all dealings by one.
+	lagrange map[int]*edwards25519.Scalar
+
+	expiration time.Time
+}
+
+// weight returns the factor applied to the dealing of dealer id.
+func (s *dkgSession) weight(id int) *edwards25519.Scalar {
+	if s.lagrange == nil {
+		return scalarFromInt(1)
+	}
+	return s.lagrange[id]
+}
+
+// pubShares returns the public key share of every participant, derived from the commitments of the dealers
+// and indexed by shard ID - 1. A refresh adds to the current public key shares, and returns nil without them.
+func (s *dkgSession) pubShares(current [][]byte) [][]byte {
+	maxID := 0
+	for _, id := range s.req.Participants {
+		if id > maxID {
+			maxID = id
+		}
+	}
+	out := make([][]byte, maxID)
+	for _, id := range s.req.Participants {
+		share := edwards25519.NewIdentityPoint()
+		if s.req.Mode == DKGModeRefresh {
+			if id > len(current) {
+				return nil
+			}
+			p, err := new(edwards25519.Point).SetBytes(current[id-1])
+			if err != nil {
+				return nil
+			}
+			share.Set(p)
+		}
+		for dealer, c := range s.commitments {
+			share.Add(share, new(edwards25519.Point).ScalarMult(s.weight(dealer), evaluateCommitments(c, id)))
+		}
+		out[id-1] = share.Bytes()
+	}
+	return out
+}
+
+// dkgContext binds the proofs of knowledge to the parameters of the session.
+func dkgContext(req DKGCommitRequest) []byte {
+	h := sha256.New()
+	h.Write([]byte("horcrux-dkg"))
+	h.Write(req.SessionID)
+	h.Write([]byte(req.ChainID))
+	_ = binary.Write(h, binary.BigEndian, int64(req.Threshold))
+	for _, id := range req.Participants {
+		_ = binary.Write(h, binary.BigEndian, int64(id))
+	}
+	_ = binary.Write(h, binary.BigEndian, int64(req.Mode))
+	return h.Sum(nil)
+}
+
+// dkgTranscript hashes everything broadcast during a session. Every dealt share is bound
+// to it so that participants with a different view of the broadcast cannot finish.
+func dkgTranscript(context []byte, packages DKGPackages) []byte {
+	h := sha256.New()
+	h.Write(context)
+	for _, p := range packages {
+		_ = binary.Write(h, binary.BigEndian, int64(p.SourceID))
+		for _, c := range p.Commitments {
+			h.Write(c)
+		}
+		h.Write(p.ProofR)
+		h.Write(p.ProofS)
+	}
+	return h.Sum(nil)
+}
+
+// validateDKGRequest checks that the session generates a key for exactly the configured cluster.
+func (cosigner *LocalCosigner) validateDKGRequest(req DKGCommitRequest) error {
+	if len(req.SessionID) == 0 {
+		return errors.New("dkg session id cannot be empty")
+	}
+	if req.ChainID == "" {
+		return errors.New("chain id cannot be empty")
+	}
+
+	if cosigner.config.Config.ThresholdModeConfig == nil {
+		return errors.New("dkg requires threshold mode")
+	}
+
+	configured := cosigner.cosigners().IDs()
+
+	// resharing changes the threshold, every other mode keeps the configured one.
+	if req.Mode == DKGModeReshare {
+		if req.Threshold <= len(configured)/2 || req.Threshold > len(configured) {
+			return fmt.Errorf("reshare threshold %d must be greater than %d / 2 and at most %d",
+				req.Threshold, len(configured), len(configured))
+		}
+	} else if threshold := cosigner.threshold(); req.Threshold != threshold {
+		return fmt.Errorf("dkg threshold %d does not match configured threshold %d", req.Threshold, threshold)
+	}
+
+	if len(req.Participants) != len(configured) {
+		return fmt.Errorf("dkg participants %v do not match configured cosigners %v", req.Participants, configured)
+	}
+	for i, id := range req.Participants {
+		if id != configured[i] {
+			return fmt.Errorf("dkg participants %v do not match configured cosigners %v", req.Participants, configured)
+		}
+	}
+
+	_, err := os.Stat(cosigner.config.KeyFilePathCosigner(req.ChainID))
+	switch req.Mode {
+	case DKGModeGenerate:
+		if err == nil {
+			return fmt.Errorf("key shard already exists for chain %s", req.ChainID)
+		}
+	case DKGModeRefresh:
+		if err != nil {
+			return fmt.Errorf("no key shard to refresh for chain %s: %w", req.ChainID, err)
+		}
+	case DKGModeReshare:
+		// cosigners which joined the cluster receive their first shard.
+	default:
+		return fmt.Errorf("unknown dkg mode %d", req.Mode)
+	}
+
+	return nil
+}
+
+// pruneDKGSessions removes sessions that have expired. dkgMu must be held.
+func (cosigner *LocalCosigner) pruneDKGSessions() {
+	now := time.Now()
+	for id, s := range cosigner.dkgSessions {
+		if now.After(s.expiration) {
+			delete(cosigner.dkgSessions, id)
+		}
+	}
+}
+
+func (cosigner *LocalCosigner) getDKGSession(sessionID []byte) (*dkgSession, error) {
+	s, ok := cosigner.dkgSessions[string(sessionID)]
+	if !ok || time.Now().After(s.expiration) {
+		return nil, fmt.Errorf("unknown or expired dkg session %x", sessionID)
+	}
+	return s, nil
+}
+
+// DKGCommit implements DKGParticipant.
+func (cosigner *LocalCosigner) DKGCommit(_ context.Context, req DKGCommitRequest) (*DKGPackage, error) {
+	if err := cosigner.validateDKGRequest(req); err != nil {
+		return nil, err
+	}
+
+	id := cosigner.GetID()
+
+	secret, pubKey, err := cosigner.dkgSecret(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionContext := dkgContext(req)
+
+	pkg := DKGPackage{
+		SourceID: id,
+	}
+
+	// a cosigner without a secret only receives shares.
+	var polynomial dkgPolynomial
+	if secret != nil {
+		polynomial, err = newDKGPolynomial(secret, req.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		commitments := polynomial.commitments()
+		pkg.Commitments = encodeDKGCommitments(commitments)
+
+		if req.Mode != DKGModeRefresh {
+			pkg.ProofR, pkg.ProofS, err = proveDKGSecret(sessionContext, id, secret, commitments[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cosigner.dkgMu.Lock()
+	defer cosigner.dkgMu.Unlock()
+
+	cosigner.pruneDKGSessions()
+
+	if _, ok := cosigner.dkgSessions[string(req.SessionID)]; ok {
+		return nil, fmt.Errorf("dkg session %x already exists", req.SessionID)
+	}
+
+	cosigner.dkgSessions[string(req.SessionID)] = &dkgSession{
+		req:        req,
+		context:    sessionContext,
+		polynomial: polynomial,
+		pkg:        pkg,
+		pubKey:     pubKey,
+		expiration: time.Now().Add(dkgSessionExpiration),
+	}
+
+	return &pkg, nil
+}
+
+// dkgSecret returns the secret this cosigner deals in a session, and the public key of its current
+// shard when the session refreshes or reshares one. The secret is nil if the cosigner only receives.
+func (cosigner *LocalCosigner) dkgSecret(req DKGCommitRequest) (*edwards25519.Scalar, []byte, error) {
+	switch req.Mode {
+	case DKGModeGenerate:
+		secret, err := randomScalar()
+		return secret, nil, err
+	case DKGModeRefresh, DKGModeReshare:
+	default:
+		return nil, nil, fmt.Errorf("unknown dkg mode %d", req.Mode)
+	}
+
+	key, err := LoadCosignerEd25519Key(cosigner.config.KeyFilePathCosigner(req.ChainID))
+	if err != nil {
+		if req.Mode == DKGModeReshare && errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if key.ID != cosigner.GetID() {
+		return nil, nil, fmt.Errorf("key shard for chain %s belongs to cosigner %d", req.ChainID, key.ID)
+	}
+
+	if req.Mode == DKGModeRefresh {
+		return edwards25519.NewScalar(), key.PubKey.Bytes(), nil
+	}
+
+	secret, err := scalarFromShard(key.PrivateShard)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secret, key.PubKey.Bytes(), nil
+}
+
+// DKGDeal implements DKGParticipant.
+func (cosigner *LocalCosigner) DKGDeal(
+	_ context.Context,
+	sessionID []byte,
+	packages DKGPackages,
+) ([]CosignerNonce, error) {
+	cosigner.dkgMu.Lock()
+	defer cosigner.dkgMu.Unlock()
+
+	s, err := cosigner.getDKGSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if s.transcript != nil {
+		return nil, fmt.Errorf("dkg session %x has already dealt", sessionID)
+	}
+
+	id := cosigner.GetID()
+
+	byID := make(map[int]DKGPackage, len(packages))
+	for _, p := range packages {
+		if _, ok := byID[p.SourceID]; ok {
+			return nil, fmt.Errorf("duplicate dkg package from cosigner %d", p.SourceID)
+		}
+		byID[p.SourceID] = p
+	}
+
+	ordered := make(DKGPackages, len(s.req.Participants))
+	commitments := make(map[int][]*edwards25519.Point, len(s.req.Participants))
+	var dealers []int
+	for i, pid := range s.req.Participants {
+		p, ok := byID[pid]
+		if !ok {
+			return nil, fmt.Errorf("missing dkg package from cosigner %d", pid)
+		}
+		ordered[i] = p
+		if s.req.Mode == DKGModeReshare && len(p.Commitments) == 0 {
+			// cosigner without a shard, which only receives.
+			continue
+		}
+		if len(p.Commitments) != s.req.Threshold {
+			return nil, fmt.Errorf("cosigner %d committed to %d coefficients, expected %d",
+				pid, len(p.Commitments), s.req.Threshold)
+		}
+		c, err := parseDKGCommitments(p.Commitments)
+		if err != nil {
+			return nil, fmt.Errorf("cosigner %d: %w", pid, err)
+		}
+		switch s.req.Mode {
+		case DKGModeGenerate, DKGModeReshare:
+			if isSmallOrder(c[0]) {
+				return nil, fmt.Errorf("cosigner %d committed to a small order secret", pid)
+			}
+			if err := verifyDKGSecretProof(s.context, pid, c[0], p.ProofR, p.ProofS); err != nil {
+				return nil, fmt.Errorf("cosigner %d: %w", pid, err)
+			}
+		case DKGModeRefresh:
+			if c[0].Equal(edwards25519.NewIdentityPoint()) != 1 {
+				return nil, fmt.Errorf("cosigner %d committed to a non-zero refresh secret", pid)
+			}
+		}
+		commitments[pid] = c
+		dealers = append(dealers, pid)
+	}
+	if len(byID) != len(ordered) {
+		return nil, fmt.Errorf("received %d dkg packages, expected %d", len(byID), len(ordered))
+	}
+
+	if s.req.Mode == DKGModeReshare {
+		lagrange, err := cosigner.verifyReshareDealers(s, dealers, commitments)
+		if err != nil {
+			return nil, err
+		}
+		s.lagrange = lagrange
+	}
+
+	own := byID[id]
+	if !bytes.Equal(dkgTranscript(nil, DKGPackages{own}), dkgTranscript(nil, DKGPackages{s.pkg})) {
+		return nil, errors.New("own dkg package was altered")
+	}
+
+	transcript := dkgTranscript(s.context, ordered)
+
+	shares := make([]CosignerNonce, 0, len(s.req.Participants)-1)
+	for _, pid := range s.req.Participants {
+		if pid == id || s.polynomial == nil {
+			continue
+		}
+		share, err := cosigner.security.EncryptAndSign(pid, transcript, s.polynomial.evaluate(pid).Bytes())
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+
+	s.commitments = commitments
+	s.transcript = transcript
+
+	return shares, nil
+}
+
+// verifyReshareDealers checks that the dealers of a reshare session hold shards of the key being reshared,
+// and returns the lagrange coefficient of each dealer. Cosigners which do not hold a shard yet cannot
+// check this, but they only accept a result which every other cosigner agrees on.
+func (cosigner *LocalCosigner) verifyReshareDealers(
+	s *dkgSession,
+	dealers []int,
+	commitments map[int][]*edwards25519.Point,
+) (map[int]*edwards25519.Scalar, error) {
+	if len(dealers) == 0 {
+		return nil, fmt.Errorf("no cosigner holds a key shard for chain %s", s.req.ChainID)
+	}
+
+	lagrange := make(map[int]*edwards25519.Scalar, len(dealers))
+	groupKey := edwards25519.NewIdentityPoint()
+	for _, pid := range dealers {
+		lagrange[pid] = lagrangeCoefficient(pid, dealers)
+		groupKey.Add(groupKey, new(edwards25519.Point).ScalarMult(lagrange[pid], commitments[pid][0]))
+	}
+
+	if s.pubKey == nil {
+		return lagrange, nil
+	}
+
+	if threshold := cosigner.threshold(); len(dealers) < threshold {
+		return nil, fmt.Errorf("%d cosigners hold a key shard for chain %s, at least %d are required",
+			len(dealers), s.req.ChainID, threshold)
+	}
+	if !bytes.Equal(groupKey.Bytes(), s.pubKey) {
+		return nil, fmt.Errorf("reshare dealers do not hold shards of the key for chain %s", s.req.ChainID)
+	}
+
+	return lagrange, nil
+}
+
+// DKGFinalize implements DKGParticipant.
+func (cosigner *LocalCosigner) DKGFinalize(
+	_ context.Context,
+	sessionID []byte,
+	shares []CosignerNonce,
+) (*DKGResult, error) {
+	cosigner.dkgMu.Lock()
+	defer cosigner.dkgMu.Unlock()
+
+	s, err := cosigner.getDKGSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if s.transcript == nil {
+		return nil, fmt.Errorf("dkg session %x has not dealt yet", sessionID)
+	}
+
+	id := cosigner.GetID()
+
+	privateShard := edwards25519.NewScalar()
+	expected := len(s.commitments)
+	if s.polynomial != nil {
+		privateShard.Multiply(s.weight(id), s.polynomial.evaluate(id))
+		expected--
+	}
+	received := make(map[int]struct{}, len(shares))
+	for _, share := range shares {
+		if share.DestinationID != id {
+			return nil, fmt.Errorf("received dkg share for cosigner %d", share.DestinationID)
+		}
+		commitments, ok := s.commitments[share.SourceID]
+		if !ok || share.SourceID == id {
+			return nil, fmt.Errorf("unexpected dkg share from cosigner %d", share.SourceID)
+		}
+		if _, ok := received[share.SourceID]; ok {
+			return nil, fmt.Errorf("duplicate dkg share from cosigner %d", share.SourceID)
+		}
+		received[share.SourceID] = struct{}{}
+
+		binding, shareBytes, err := cosigner.security.DecryptAndVerify(
+			share.SourceID, share.PubKey, share.Share, share.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt dkg share from cosigner %d: %w", share.SourceID, err)
+		}
+		if !bytes.Equal(binding, s.transcript) {
+			return nil, fmt.Errorf("cosigner %d has a different view of the dkg session", share.SourceID)
+		}
+		v, err := edwards25519.NewScalar().SetCanonicalBytes(shareBytes)
+		if err != nil {
+			return nil, fmt.Errorf("cosigner %d dealt a malformed share: %w", share.SourceID, err)
+		}
+		if !verifyDKGShare(v, id, commitments) {
+			return nil, fmt.Errorf("cosigner %d dealt a share which does not match its commitments", share.SourceID)
+		}
+		privateShard.MultiplyAdd(s.weight(share.SourceID), v, privateShard)
+	}
+	if len(received) != expected {
+		return nil, fmt.Errorf("received %d dkg shares, expected %d", len(received), expected)
+	}
+
+	var result *DKGResult
+	switch s.req.Mode {
+	case DKGModeGenerate:
+		result, err = cosigner.finalizeGenerate(s, privateShard)
+	case DKGModeRefresh:
+		result, err = cosigner.finalizeRefresh(s, privateShard)
+	case DKGModeReshare:
+		result, err = cosigner.finalizeReshare(s, privateShard)
+	default:
+		err = fmt.Errorf("unknown dkg mode %d", s.req.Mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	delete(cosigner.dkgSessions, string(sessionID))
+
+	return result, nil
+}
+
+// finalizeGenerate writes the key shard created by a DKGModeGenerate session.
+func (cosigner *LocalCosigner) finalizeGenerate(s *dkgSession, privateShard *edwards25519.Scalar) (*DKGResult, error) {
+	groupKey := edwards25519.NewIdentityPoint()
+	for _, c := range s.commitments {
+		groupKey.Add(groupKey, c[0])
+	}
+	pubKey := groupKey.Bytes()
+
+	keyFile := cosigner.config.KeyFilePathCosigner(s.req.ChainID)
+	if _, err := os.Stat(keyFile); err == nil {
+		return nil, fmt.Errorf("key shard already exists for chain %s", s.req.ChainID)
+	}
+	if err := WriteCosignerEd25519ShardFile(CosignerEd25519Key{
+		PubKey:       cometcryptoed25519.PubKey(pubKey),
+		PrivateShard: privateShard.Bytes(),
+		ID:           cosigner.GetID(),
+		PubShares:    s.pubShares(nil),
+	}, keyFile); err != nil {
+		return nil, err
+	}
+
+	cosigner.logger.Info(
+		"Completed distributed key generation",
+		"chain_id", s.req.ChainID,
+		"pub_key", fmt.Sprintf("%X", pubKey),
+	)
+
+	return &DKGResult{
+		PubKey:         pubKey,
+		TranscriptHash: s.transcript,
+	}, nil
+}
+
+// finalizeRefresh adds the zero sharing created by a DKGModeRefresh session to the current key shard.
+// The new shard is staged next to the current one until the refresh is committed through raft.
+func (cosigner *LocalCosigner) finalizeRefresh(s *dkgSession, delta *edwards25519.Scalar) (*DKGResult, error) {
+	key, err := LoadCosignerEd25519Key(cosigner.config.KeyFilePathCosigner(s.req.ChainID))
+	if err != nil {
+		return nil, err
+	}
+	if key.ID != cosigner.GetID() {
+		return nil, fmt.Errorf("key shard for chain %s belongs to cosigner %d", s.req.ChainID, key.ID)
+	}
+
+	privateShard, err := scalarFromShard(key.PrivateShard)
+	if err != nil {
+		return nil, err
+	}
+	privateShard.Add(privateShard, delta)
+
+	if err := WriteCosignerEd25519ShardFile(CosignerEd25519Key{
+		PubKey:       key.PubKey,
+		PrivateShard: privateShard.Bytes(),
+		ID:           key.ID,
+		PubShares:    s.pubShares(key.PubShares),
+	}, cosigner.config.KeyFilePathCosignerRefresh(s.req.ChainID, s.req.SessionID)); err != nil {
+		return nil, err
+	}
+
+	cosigner.logger.Info(
+		"Staged refreshed key shard",
+		"chain_id", s.req.ChainID,
+		"session", fmt.Sprintf("%x", s.req.SessionID),
+	)
+
+	return &DKGResult{
+		PubKey:         key.PubKey.Bytes(),
+		TranscriptHash: s.transcript,
+	}, nil
+}
+
+// finalizeReshare stages the key shard created by a DKGModeReshare session next to the current one,
+// until the reshare is committed through raft.
+func (cosigner *LocalCosigner) finalizeReshare(s *dkgSession, privateShard *edwards25519.Scalar) (*DKGResult, error) {
+	groupKey := edwards25519.NewIdentityPoint()
+	for pid, c := range s.commitments {
+		groupKey.Add(groupKey, new(edwards25519.Point).ScalarMult(s.lagrange[pid], c[0]))
+	}
+	pubKey := groupKey.Bytes()
+
+	if err := WriteCosignerEd25519ShardFile(CosignerEd25519Key{
+		PubKey:       cometcryptoed25519.PubKey(pubKey),
+		PrivateShard: privateShard.Bytes(),
+		ID:           cosigner.GetID(),
+		PubShares:    s.pubShares(nil),
+	}, cosigner.config.KeyFilePathCosignerRefresh(s.req.ChainID, s.req.SessionID)); err != nil {
+		return nil, err
+	}
+
+	cosigner.logger.Info(
+		"Staged reshared key shard",
+		"chain_id", s.req.ChainID,
+		"threshold", s.req.Threshold,
+		"session", fmt.Sprintf("%x", s.req.SessionID),
+	)
+
+	return &DKGResult{
+		PubKey:         pubKey,
+		TranscriptHash: s.transcript,
+	}, nil
+}
+
+// RunDKG coordinates distributed key generation for chainID between all participants.
+// The coordinator only relays public packages and encrypted shares, so it never learns any secret.
+// Each participant writes its own key shard; the group public key is returned.
+func RunDKG(
+	ctx context.Context,
+	chainID string,
+	threshold int,
+	participants []DKGParticipant,
+) (cometcrypto.PubKey, error) {
+	_, res, err := runDKGSession(ctx, chainID, threshold, participants, DKGModeGenerate)
+	if err != nil {
+		return nil, err
+	}
+	return cometcryptoed25519.PubKey(res.PubKey), nil
+}
+
+// RunShardRefresh coordinates a proactive refresh of the key shards for chainID between all participants.
+// Each participant stages a new shard for the same public key. The returned session ID must then
+// be committed on every participant, after which the previous shards are useless.
+func RunShardRefresh(
+	ctx context.Context,
+	chainID string,
+	threshold int,
+	participants []DKGParticipant,
+) (sessionID []byte, pubKey cometcrypto.PubKey, err error) {
+	sessionID, res, err := runDKGSession(ctx, chainID, threshold, participants, DKGModeRefresh)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sessionID, cometcryptoed25519.PubKey(res.PubKey), nil
+}
+
+// RunShardReshare coordinates resharing the key for chainID to all participants with a new threshold.
+// Every participant which holds a shard deals it, and every participant stages a new shard for the
+// same public key. The returned session ID must then be committed on every participant.
+func RunShardReshare(
+	ctx context.Context,
+	chainID string,
+	threshold int,
+	participants []DKGParticipant,
+) (sessionID []byte, pubKey cometcrypto.PubKey, err error) {
+	sessionID, res, err := runDKGSession(ctx, chainID, threshold, participants, DKGModeReshare)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sessionID, cometcryptoed25519.PubKey(res.PubKey), nil
+}
+
+func runDKGSession(
+	ctx context.Context,
+	chainID string,
+	threshold int,
+	participants []DKGParticipant,
+	mode DKGMode,
+) ([]byte, *DKGResult, error) {
+	if threshold < 2 || threshold > len(participants) {
+		return nil, nil, fmt.Errorf("invalid threshold %d for %d participants", threshold, len(participants))
+	}
+
+	ids := make([]int, len(participants))
+	for i, p := range participants {
+		ids[i] = p.GetID()
+	}
+	sort.Ints(ids)
+
+	sessionID := make([]byte, 32)
+	if _, err := rand.Read(sessionID); err != nil {
+		return nil, nil, err
+	}
+
+	req := DKGCommitRequest{
+		SessionID:    sessionID,
+		ChainID:      chainID,
+		Threshold:    threshold,
+		Participants: ids,
+		Mode:         mode,
+	}
+
+	packages := make(DKGPackages, len(participants))
+	var eg errgroup.Group
+	for i, p := range participants {
+		i, p := i, p
+		eg.Go(func() error {
+			pkg, err := p.DKGCommit(ctx, req)
+			if err != nil {
+				return fmt.Errorf("cosigner %d failed to commit: %w", p.GetID(), err)
+			}
+			packages[i] = *pkg
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	dealt := make([][]CosignerNonce, len(participants))
+	for i, p := range participants {
+		i, p := i, p
+		eg.Go(func() error {
+			shares, err := p.DKGDeal(ctx, sessionID, packages)
+			if err != nil {
+				return fmt.Errorf("cosigner %d failed to deal: %w", p.GetID(), err)
+			}
+			dealt[i] = shares
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	sharesFor := make(map[int][]CosignerNonce, len(participants))
+	for i, shares := range dealt {
+		for _, share := range shares {
+			if share.SourceID != participants[i].GetID() {
+				return nil, nil, fmt.Errorf("cosigner %d dealt a share as cosigner %d",
+					participants[i].GetID(), share.SourceID)
+			}
+			sharesFor[share.DestinationID] = append(sharesFor[share.DestinationID], share)
+		}
+	}
+
+	results := make([]*DKGResult, len(participants))
+	for i, p := range participants {
+		i, p := i, p
+		eg.Go(func() error {
+			res, err := p.DKGFinalize(ctx, sessionID, sharesFor[p.GetID()])
+			if err != nil {
+				return fmt.Errorf("cosigner %d failed to finalize: %w", p.GetID(), err)
+			}
+			results[i] = res
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	for i, res := range results[1:] {
+		if !bytes.Equal(res.PubKey, results[0].PubKey) || !bytes.Equal(res.TranscriptHash, results[0].TranscriptHash) {
+			return nil, nil, fmt.Errorf("cosigners %d and %d disagree on the dkg result",
+				participants[0].GetID(), participants[i+1].GetID())
+		}
+	}
+
+	return sessionID, results[0], nil
+}
@@ -0,0 +1,185 @@
+package signer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	comet "github.com/cometbft/cometbft/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const testDKGChainID = "chain-dkg"
+
+func TestDKG2of3(t *testing.T) {
+	testDKG(t, 2, 3)
+}
+
+func TestDKG3of5(t *testing.T) {
+	testDKG(t, 3, 5)
+}
+
+func testDKG(t *testing.T, threshold, total uint8) {
+	cosigners, _ := getTestLocalCosigners(t, threshold, total)
+
+	participants := make([]DKGParticipant, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+	}
+
+	pubKey, err := RunDKG(context.Background(), testDKGChainID, int(threshold), participants)
+	require.NoError(t, err)
+
+	for _, c := range cosigners {
+		key, err := LoadCosignerEd25519Key(c.config.KeyFilePathCosigner(testDKGChainID))
+		require.NoError(t, err)
+		require.Equal(t, c.GetID(), key.ID)
+		require.Equal(t, pubKey, key.PubKey)
+	}
+
+	// any threshold subset of the cosigners can sign for the generated key.
+	signBytes, sig := testSignWithLocalCosigners(t, cosigners[:threshold], testDKGChainID, 1)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	signBytes, sig = testSignWithLocalCosigners(t, cosigners[len(cosigners)-int(threshold):], testDKGChainID, 2)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+}
+
+// testSignWithLocalCosigners threshold signs a prevote at height with the given cosigners.
+func testSignWithLocalCosigners(
+	t *testing.T,
+	cosigners []*LocalCosigner,
+	chainID string,
+	height int64,
+) (signBytes []byte, signature []byte) {
+	// the sign states are saved in the background, and must be written before the test removes its directory.
+	for _, cosigner := range cosigners {
+		defer cosigner.waitForSignStatesToFlushToDisk()
+	}
+
+	ctx := context.Background()
+
+	u, err := uuid.NewRandom()
+	require.NoError(t, err)
+
+	nonces := make([][]CosignerNonce, len(cosigners))
+	for i, cosigner := range cosigners {
+		require.NoError(t, cosigner.LoadSignStateIfNecessary(chainID))
+
+		res, err := cosigner.GetNonces(ctx, []uuid.UUID{u})
+		require.NoError(t, err)
+		nonces[i] = res[0].Nonces
+	}
+
+	now := time.Now()
+
+	var vote cometproto.Vote
+	vote.Height = height
+	vote.Round = 0
+	vote.Type = cometproto.PrevoteType
+	vote.Timestamp = now
+
+	signBytes = comet.VoteSignBytes(chainID, &vote)
+
+	sigs := make([]PartialSignature, len(cosigners))
+	for i, cosigner := range cosigners {
+		cosignerNonces := make([]CosignerNonce, 0, len(cosigners)-1)
+		for j, nonce := range nonces {
+			if i == j {
+				continue
+			}
+			for _, n := range nonce {
+				if n.DestinationID == cosigner.GetID() {
+					cosignerNonces = append(cosignerNonces, n)
+				}
+			}
+		}
+
+		sigRes, err := cosigner.SetNoncesAndSign(ctx, CosignerSetNoncesAndSignRequest{
+			Nonces: &CosignerUUIDNonces{
+				UUID:   u,
+				Nonces: cosignerNonces,
+			},
+			ChainID: chainID,
+			HRST: HRSTKey{
+				Height:    height,
+				Round:     0,
+				Step:      2,
+				Timestamp: now.UnixNano(),
+			},
+			SignBytes: signBytes,
+		})
+		require.NoError(t, err)
+
+		sigs[i] = PartialSignature{
+			ID:        cosigner.GetID(),
+			Signature: sigRes.Signature,
+		}
+	}
+
+	signature, err = cosigners[0].CombineSignatures(chainID, sigs)
+	require.NoError(t, err)
+
+	return signBytes, signature
+}
+
+// cheatingDKGParticipant deals a share to the first other participant which does not match its commitments.
+type cheatingDKGParticipant struct {
+	*LocalCosigner
+}
+
+func (c cheatingDKGParticipant) DKGDeal(
+	ctx context.Context,
+	sessionID []byte,
+	packages DKGPackages,
+) ([]CosignerNonce, error) {
+	shares, err := c.LocalCosigner.DKGDeal(ctx, sessionID, packages)
+	if err != nil {
+		return nil, err
+	}
+
+	wrong, err := randomScalar()
+	if err != nil {
+		return nil, err
+	}
+
+	c.dkgMu.Lock()
+	transcript := c.dkgSessions[string(sessionID)].transcript
+	c.dkgMu.Unlock()
+
+	shares[0], err = c.security.EncryptAndSign(shares[0].DestinationID, transcript, wrong.Bytes())
+	return shares, err
+}
+
+func TestDKGDetectsInvalidShare(t *testing.T) {
+	cosigners, _ := getTestLocalCosigners(t, 2, 3)
+
+	participants := []DKGParticipant{
+		cheatingDKGParticipant{cosigners[0]},
+		cosigners[1],
+		cosigners[2],
+	}
+
+	_, err := RunDKG(context.Background(), testDKGChainID, 2, participants)
+	require.ErrorContains(t, err, "cosigner 1 dealt a share which does not match its commitments")
+
+	_, err = cosigners[1].GetPubKey(testDKGChainID)
+	require.Error(t, err)
+}
+
+func TestDKGExistingShard(t *testing.T) {
+	cosigners, _ := getTestLocalCosigners(t, 2, 3)
+
+	participants := make([]DKGParticipant, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+	}
+
+	_, err := RunDKG(context.Background(), testChainID, 2, participants)
+	require.ErrorContains(t, err, "key shard already exists for chain "+testChainID)
+
+	_, err = RunDKG(context.Background(), testDKGChainID, 3, participants)
+	require.ErrorContains(t, err, "does not match configured threshold")
+}
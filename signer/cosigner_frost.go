@@ -0,0 +1,316 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+	"golang.org/x/sync/errgroup"
+)
+
+// SigningProtocol is the threshold signing protocol the cosigners use for a chain.
+type SigningProtocol string
+
+const (
+	// SigningProtocolTSED25519 has every cosigner deal a nonce to every other cosigner for each signature.
+	SigningProtocolTSED25519 SigningProtocol = "tsed25519"
+
+	// SigningProtocolFROST signs with FROST(Ed25519, SHA-512) of RFC 9591. Cosigners preprocess commitments
+	// to their nonces, so the leader only needs a single round trip to the cosigners for each signature.
+	SigningProtocolFROST SigningProtocol = "frost"
+)
+
+// Validate returns an error if p is not a supported signing protocol. An empty protocol is the default.
+func (p SigningProtocol) Validate() error {
+	switch p {
+	case "", SigningProtocolTSED25519, SigningProtocolFROST:
+		return nil
+	}
+	return fmt.Errorf("signingProtocol must be %s or %s, got %q", SigningProtocolTSED25519, SigningProtocolFROST, p)
+}
+
+const (
+	// frostNonceExpiration bounds how long a cosigner keeps FROST nonces which were preprocessed but never used.
+	frostNonceExpiration = 10 * time.Minute
+
+	// frostMaxNonces bounds the unused FROST nonces a cosigner keeps, so a leader can not exhaust its memory.
+	frostMaxNonces = 4096
+
+	// frostMaxCommitBatch bounds the nonces preprocessed for a single FROSTCommit request.
+	frostMaxCommitBatch = 256
+
+	errUnknownFROSTNonce = "unknown FROST nonce"
+)
+
+// FROSTCommitment is the public commitment of a cosigner to a pair of FROST nonces.
+type FROSTCommitment struct {
+	UUID       uuid.UUID
+	CosignerID int
+	Hiding     []byte
+	Binding    []byte
+}
+
+func (c FROSTCommitment) decode() (frostCommitment, error) {
+	hiding, err := frostElement(c.Hiding)
+	if err != nil {
+		return frostCommitment{}, fmt.Errorf("invalid FROST hiding commitment of cosigner %d: %w", c.CosignerID, err)
+	}
+	binding, err := frostElement(c.Binding)
+	if err != nil {
+		return frostCommitment{}, fmt.Errorf("invalid FROST binding commitment of cosigner %d: %w", c.CosignerID, err)
+	}
+	return frostCommitment{hiding: hiding, binding: binding}, nil
+}
+
+func (c FROSTCommitment) toProto() *proto.FROSTCommitment {
+	return &proto.FROSTCommitment{
+		Uuid:       c.UUID[:],
+		CosignerID: int32(c.CosignerID),
+		Hiding:     c.Hiding,
+		Binding:    c.Binding,
+	}
+}
+
+// FROSTCommitmentFromProto converts a proto FROSTCommitment.
+func FROSTCommitmentFromProto(c *proto.FROSTCommitment) FROSTCommitment {
+	return FROSTCommitment{
+		UUID:       uuid.UUID(c.Uuid),
+		CosignerID: int(c.CosignerID),
+		Hiding:     c.Hiding,
+		Binding:    c.Binding,
+	}
+}
+
+type FROSTCommitments []FROSTCommitment
+
+func (commitments FROSTCommitments) toProto() (out []*proto.FROSTCommitment) {
+	for _, c := range commitments {
+		out = append(out, c.toProto())
+	}
+	return
+}
+
+// FROSTCommitmentsFromProto converts a list of proto FROSTCommitments.
+func FROSTCommitmentsFromProto(commitments []*proto.FROSTCommitment) FROSTCommitments {
+	out := make(FROSTCommitments, 0, len(commitments))
+	for _, c := range commitments {
+		if len(c.Uuid) != 16 {
+			continue
+		}
+		out = append(out, FROSTCommitmentFromProto(c))
+	}
+	return out
+}
+
+// FROSTSignRequest asks a signer of a FROST signature for its signature shares.
+type FROSTSignRequest struct {
+	ChainID   string
+	HRST      HRSTKey
+	SignBytes []byte
+
+	// Commitments are the commitments of all signers, including the cosigner which is asked to sign.
+	Commitments FROSTCommitments
+
+	VoteExtensionSignBytes   []byte
+	VoteExtensionCommitments FROSTCommitments
+}
+
+// FROSTParticipant is a cosigner which can sign with the FROST protocol.
+type FROSTParticipant interface {
+	// GetID returns the shard ID of the participant.
+	GetID() int
+
+	// FROSTCommit preprocesses count pairs of nonces and returns the commitments to them.
+	FROSTCommit(ctx context.Context, count int) (FROSTCommitments, error)
+
+	// FROSTSign returns the signature shares of the participant, made with the nonces of its commitments
+	// in the request. The nonces of a commitment are never used for more than one sign request.
+	FROSTSign(ctx context.Context, req FROSTSignRequest) (*CosignerSignResponse, error)
+}
+
+var (
+	_ FROSTParticipant = &LocalCosigner{}
+	_ FROSTParticipant = &RemoteCosigner{}
+)
+
+// FROSTThresholdSigner is implemented by ThresholdSigners which can sign with the FROST protocol.
+type FROSTThresholdSigner interface {
+	// SignFROST returns the signature share of this cosigner over payload, made with its nonces
+	// which it committed to in commitments.
+	SignFROST(nonces *FROSTNonces, commitments FROSTCommitments, payload []byte) ([]byte, error)
+}
+
+type frostNoncesWithExpiration struct {
+	nonces     *FROSTNonces
+	expiration time.Time
+}
+
+// FROSTCommit implements FROSTParticipant.
+func (cosigner *LocalCosigner) FROSTCommit(_ context.Context, count int) (FROSTCommitments, error) {
+	if count < 1 || count > frostMaxCommitBatch {
+		return nil, fmt.Errorf("FROST commitment count must be between 1 and %d, got %d", frostMaxCommitBatch, count)
+	}
+
+	id := cosigner.GetID()
+	commitments := make(FROSTCommitments, count)
+	nonces := make([]*FROSTNonces, count)
+	for i := range commitments {
+		n, err := NewFROSTNonces()
+		if err != nil {
+			return nil, err
+		}
+		hiding, binding := n.Commitments()
+		commitments[i] = FROSTCommitment{
+			UUID:       uuid.New(),
+			CosignerID: id,
+			Hiding:     hiding,
+			Binding:    binding,
+		}
+		nonces[i] = n
+	}
+
+	expiration := time.Now().Add(frostNonceExpiration)
+
+	cosigner.noncesMu.Lock()
+	defer cosigner.noncesMu.Unlock()
+
+	if len(cosigner.frostNonces)+count > frostMaxNonces {
+		return nil, fmt.Errorf("too many unused FROST nonces, at most %d are kept", frostMaxNonces)
+	}
+	for i, c := range commitments {
+		cosigner.frostNonces[c.UUID] = &frostNoncesWithExpiration{
+			nonces:     nonces[i],
+			expiration: expiration,
+		}
+	}
+
+	return commitments, nil
+}
+
+// takeFROSTNonces removes the nonces of the commitment of this cosigner in commitments, and returns them.
+func (cosigner *LocalCosigner) takeFROSTNonces(commitments FROSTCommitments) (*FROSTNonces, error) {
+	id := cosigner.GetID()
+
+	var own *FROSTCommitment
+	for i, c := range commitments {
+		if c.CosignerID != id {
+			continue
+		}
+		if own != nil {
+			return nil, fmt.Errorf("cosigner %d is listed more than once in the FROST commitments", id)
+		}
+		own = &commitments[i]
+	}
+	if own == nil {
+		return nil, fmt.Errorf("no FROST commitment of cosigner %d", id)
+	}
+
+	cosigner.noncesMu.Lock()
+	defer cosigner.noncesMu.Unlock()
+
+	n, ok := cosigner.frostNonces[own.UUID]
+	if !ok || time.Now().After(n.expiration) {
+		return nil, fmt.Errorf("%s %s", errUnknownFROSTNonce, own.UUID)
+	}
+	delete(cosigner.frostNonces, own.UUID)
+
+	return n.nonces, nil
+}
+
+// FROSTSign implements FROSTParticipant.
+func (cosigner *LocalCosigner) FROSTSign(_ context.Context, req FROSTSignRequest) (*CosignerSignResponse, error) {
+	chainID := req.ChainID
+
+	if err := cosigner.LoadSignStateIfNecessary(chainID); err != nil {
+		return nil, err
+	}
+
+	// the nonces are taken before anything else, so they are never used twice, even when signing fails.
+	nonces, err := cosigner.takeFROSTNonces(req.Commitments)
+	if err != nil {
+		return nil, err
+	}
+	var voteExtNonces *FROSTNonces
+	if len(req.VoteExtensionSignBytes) > 0 {
+		if voteExtNonces, err = cosigner.takeFROSTNonces(req.VoteExtensionCommitments); err != nil {
+			return nil, err
+		}
+	}
+
+	ccs, hrst, hasVoteExtensions, err := cosigner.checkSignRequest(chainID, req.SignBytes, req.VoteExtensionSignBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := ccs.signer.(FROSTThresholdSigner)
+	if !ok {
+		return nil, errors.New("the threshold signer backend can not sign with FROST")
+	}
+
+	metricsTimeKeeper.SetPreviousLocalSignStart(time.Now())
+
+	// a block signed before is signed again, rather than answered with the earlier signature share,
+	// which is bound to other commitments. Fresh nonces make that as safe as the first signature.
+	if _, err := ccs.lastSignState.existingSignatureOrErrorIfRegression(hrst, req.SignBytes); err != nil {
+		return nil, err
+	}
+
+	var res CosignerSignResponse
+	var eg errgroup.Group
+	eg.Go(func() error {
+		var err error
+		res.Signature, err = signer.SignFROST(nonces, req.Commitments, req.SignBytes)
+		return err
+	})
+	if hasVoteExtensions {
+		eg.Go(func() error {
+			var err error
+			res.VoteExtensionSignature, err = signer.SignFROST(
+				voteExtNonces, req.VoteExtensionCommitments, req.VoteExtensionSignBytes,
+			)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	err = ccs.lastSignState.Save(SignStateConsensus{
+		Height:                 hrst.Height,
+		Round:                  hrst.Round,
+		Step:                   hrst.Step,
+		Signature:              res.Signature,
+		SignBytes:              req.SignBytes,
+		VoteExtensionSignature: res.VoteExtensionSignature,
+	}, &cosigner.pendingDiskWG)
+	if err != nil {
+		if _, isSameHRSError := err.(*SameHRSError); !isSameHRSError {
+			return nil, err
+		}
+	}
+
+	metricsTimeKeeper.SetPreviousLocalSignFinish(time.Now())
+
+	return &res, nil
+}
+
+// frostSigningPackage returns the signing package of a FROST signature over payload for chainID,
+// and the public key shares to verify the signature shares with, if the key shard has them.
+func (cosigner *LocalCosigner) frostSigningPackage(
+	chainID string,
+	commitments FROSTCommitments,
+	payload []byte,
+) (*frostSigningPackage, [][]byte, error) {
+	ccs, err := cosigner.getChainState(chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := newFROSTSigningPackage(ccs.signer.PubKey(), commitments, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, ccs.pubShares, nil
+}
@@ -58,6 +58,12 @@ func (rpc *CosignerGRPCServer) SetNoncesAndSign(
 			Nonces: CosignerNoncesFromProto(req.Nonces),
 		},
 		SignBytes: req.SignBytes,
+
+		RawBytesUniqueID: req.RawBytesUniqueID,
+		RawBytes:         req.RawBytes,
+	}
+	for _, id := range req.NonceDealers {
+		cosignerReq.NonceDealers = append(cosignerReq.NonceDealers, int(id))
 	}
 
 	if len(req.VoteExtSignBytes) > 0 && len(req.VoteExtUuid) == 16 {
@@ -68,25 +74,23 @@ func (rpc *CosignerGRPCServer) SetNoncesAndSign(
 		cosignerReq.VoteExtensionSignBytes = req.VoteExtSignBytes
 	}
 
+	logFields := []interface{}{"chain_id", req.ChainID}
+	if req.RawBytesUniqueID != "" {
+		logFields = append(logFields, "unique_id", req.RawBytesUniqueID)
+	} else {
+		logFields = append(logFields,
+			"height", req.Hrst.GetHeight(),
+			"round", req.Hrst.GetRound(),
+			"step", req.Hrst.GetStep(),
+		)
+	}
+
 	res, err := rpc.cosigner.SetNoncesAndSign(ctx, cosignerReq)
 	if err != nil {
-		rpc.raftStore.logger.Error(
-			"Failed to sign with shard",
-			"chain_id", req.ChainID,
-			"height", req.Hrst.Height,
-			"round", req.Hrst.Round,
-			"step", req.Hrst.Step,
-			"error", err,
-		)
+		rpc.raftStore.logger.Error("Failed to sign with shard", append(logFields, "error", err)...)
 		return nil, err
 	}
-	rpc.raftStore.logger.Info(
-		"Signed with shard",
-		"chain_id", req.ChainID,
-		"height", req.Hrst.Height,
-		"round", req.Hrst.Round,
-		"step", req.Hrst.Step,
-	)
+	rpc.raftStore.logger.Info("Signed with shard", logFields...)
 	return &proto.SetNoncesAndSignResponse{
 		NoncePublic:        res.NoncePublic,
 		Timestamp:          res.Timestamp.UnixNano(),
@@ -126,7 +130,7 @@ func (rpc *CosignerGRPCServer) TransferLeadership(
 	}
 	leaderID := req.GetLeaderID()
 	if leaderID != "" {
-		for _, c := range rpc.raftStore.Cosigners {
+		for _, c := range rpc.raftStore.getCosigners() {
 			shardID := fmt.Sprint(c.GetID())
 			if shardID == leaderID {
 				raftAddress := p2pURLToRaftAddress(c.GetAddress())
@@ -152,3 +156,168 @@ func (rpc *CosignerGRPCServer) GetLeader(
 func (rpc *CosignerGRPCServer) Ping(context.Context, *proto.PingRequest) (*proto.PingResponse, error) {
 	return &proto.PingResponse{}, nil
 }
+
+func (rpc *CosignerGRPCServer) DKGCommit(
+	ctx context.Context,
+	req *proto.DKGCommitRequest,
+) (*proto.DKGCommitResponse, error) {
+	participants := make([]int, len(req.Participants))
+	for i, id := range req.Participants {
+		participants[i] = int(id)
+	}
+	pkg, err := rpc.cosigner.DKGCommit(ctx, DKGCommitRequest{
+		SessionID:    req.SessionID,
+		ChainID:      req.ChainID,
+		Threshold:    int(req.Threshold),
+		Participants: participants,
+		Mode:         DKGMode(req.Mode),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DKGCommitResponse{
+		Package: pkg.toProto(),
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) DKGDeal(
+	ctx context.Context,
+	req *proto.DKGDealRequest,
+) (*proto.DKGDealResponse, error) {
+	shares, err := rpc.cosigner.DKGDeal(ctx, req.SessionID, DKGPackagesFromProto(req.Packages))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DKGDealResponse{
+		Shares: CosignerNonces(shares).toProto(),
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) DKGFinalize(
+	ctx context.Context,
+	req *proto.DKGFinalizeRequest,
+) (*proto.DKGFinalizeResponse, error) {
+	res, err := rpc.cosigner.DKGFinalize(ctx, req.SessionID, CosignerNoncesFromProto(req.Shares))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DKGFinalizeResponse{
+		PubKey:         res.PubKey,
+		TranscriptHash: res.TranscriptHash,
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) RefreshShards(
+	ctx context.Context,
+	req *proto.RefreshShardsRequest,
+) (*proto.RefreshShardsResponse, error) {
+	pubKey, err := rpc.raftStore.RefreshShards(ctx, req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RefreshShardsResponse{
+		PubKey: pubKey.Bytes(),
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) ChangeMembership(
+	_ context.Context,
+	req *proto.ChangeMembershipRequest,
+) (*proto.ChangeMembershipResponse, error) {
+	shardID, err := rpc.raftStore.ChangeMembership(MembershipOp(req.Op), int(req.ShardID), req.P2PAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ChangeMembershipResponse{
+		ShardID: int32(shardID),
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) ReshareShards(
+	ctx context.Context,
+	req *proto.ReshareShardsRequest,
+) (*proto.ReshareShardsResponse, error) {
+	chainIDs, err := rpc.raftStore.ReshareShards(ctx, int(req.Threshold))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ReshareShardsResponse{
+		ChainIDs:  chainIDs,
+		Threshold: int32(rpc.cosigner.threshold()),
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) SetSignFreeze(
+	_ context.Context,
+	req *proto.SetSignFreezeRequest,
+) (*proto.SetSignFreezeResponse, error) {
+	if req.Freeze == nil {
+		return nil, fmt.Errorf("freeze must not be empty")
+	}
+	if err := rpc.raftStore.SetSignFreeze(SignFreezeFromProto(req.Freeze)); err != nil {
+		return nil, err
+	}
+	return &proto.SetSignFreezeResponse{}, nil
+}
+
+func (rpc *CosignerGRPCServer) GetSignFreezes(
+	context.Context,
+	*proto.GetSignFreezesRequest,
+) (*proto.GetSignFreezesResponse, error) {
+	freezes := rpc.raftStore.SignFreezes()
+	res := &proto.GetSignFreezesResponse{Freezes: make([]*proto.SignFreeze, len(freezes))}
+	for i, freeze := range freezes {
+		res.Freezes[i] = SignFreezeToProto(freeze)
+	}
+	return res, nil
+}
+
+func (rpc *CosignerGRPCServer) FROSTCommit(
+	ctx context.Context,
+	req *proto.FROSTCommitRequest,
+) (*proto.FROSTCommitResponse, error) {
+	commitments, err := rpc.cosigner.FROSTCommit(ctx, int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.FROSTCommitResponse{
+		Commitments: commitments.toProto(),
+	}, nil
+}
+
+func (rpc *CosignerGRPCServer) FROSTSign(
+	ctx context.Context,
+	req *proto.FROSTSignRequest,
+) (*proto.SetNoncesAndSignResponse, error) {
+	res, err := rpc.cosigner.FROSTSign(ctx, FROSTSignRequest{
+		ChainID:                  req.ChainID,
+		HRST:                     HRSTKeyFromProto(req.Hrst),
+		SignBytes:                req.SignBytes,
+		Commitments:              FROSTCommitmentsFromProto(req.Commitments),
+		VoteExtensionSignBytes:   req.VoteExtSignBytes,
+		VoteExtensionCommitments: FROSTCommitmentsFromProto(req.VoteExtCommitments),
+	})
+	if err != nil {
+		rpc.raftStore.logger.Error(
+			"Failed to sign with shard using FROST",
+			"chain_id", req.ChainID,
+			"height", req.Hrst.GetHeight(),
+			"round", req.Hrst.GetRound(),
+			"step", req.Hrst.GetStep(),
+			"error", err,
+		)
+		return nil, err
+	}
+	rpc.raftStore.logger.Info(
+		"Signed with shard using FROST",
+		"chain_id", req.ChainID,
+		"height", req.Hrst.GetHeight(),
+		"round", req.Hrst.GetRound(),
+		"step", req.Hrst.GetStep(),
+	)
+	return &proto.SetNoncesAndSignResponse{
+		Timestamp:        res.Timestamp.UnixNano(),
+		Signature:        res.Signature,
+		VoteExtSignature: res.VoteExtensionSignature,
+	}, nil
+}
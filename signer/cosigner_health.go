@@ -12,6 +12,9 @@ import (
 
 const (
 	pingInterval = 1 * time.Second
+
+	// faultyCooldown is how long a cosigner which sent an invalid partial signature is picked last.
+	faultyCooldown = 5 * time.Minute
 )
 
 type CosignerHealth struct {
@@ -20,6 +23,9 @@ type CosignerHealth struct {
 	rtt       map[int]int64
 	mu        sync.RWMutex
 
+	// faulty holds when cosigners last sent an invalid partial signature.
+	faulty map[int]time.Time
+
 	leader Leader
 }
 
@@ -28,6 +34,7 @@ func NewCosignerHealth(logger cometlog.Logger, cosigners []Cosigner, leader Lead
 		logger:    logger,
 		cosigners: cosigners,
 		rtt:       make(map[int]int64),
+		faulty:    make(map[int]time.Time),
 		leader:    leader,
 	}
 }
@@ -36,16 +43,39 @@ func (ch *CosignerHealth) Reconcile(ctx context.Context) {
 	if !ch.leader.IsLeader() {
 		return
 	}
+	ch.mu.RLock()
+	cosigners := ch.cosigners
+	ch.mu.RUnlock()
+
 	var wg sync.WaitGroup
-	wg.Add(len(ch.cosigners))
-	for _, cosigner := range ch.cosigners {
+	for _, cosigner := range cosigners {
 		if rc, ok := cosigner.(*RemoteCosigner); ok {
+			wg.Add(1)
 			go ch.updateRTT(ctx, rc, &wg)
 		}
 	}
 	wg.Wait()
 }
 
+// SetCosigners replaces the tracked cosigners after a cluster membership change.
+func (ch *CosignerHealth) SetCosigners(cosigners []Cosigner) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	ch.cosigners = cosigners
+
+	// drop round trip times of cosigners which are no longer members
+	for id := range ch.rtt {
+		if Cosigners(cosigners).GetByID(id) == nil {
+			delete(ch.rtt, id)
+		}
+	}
+	for id := range ch.faulty {
+		if Cosigners(cosigners).GetByID(id) == nil {
+			delete(ch.faulty, id)
+		}
+	}
+}
+
 func (ch *CosignerHealth) Start(ctx context.Context) {
 	ticker := time.NewTicker(pingInterval)
 	for {
@@ -65,6 +95,38 @@ func (ch *CosignerHealth) MarkUnhealthy(cosigner Cosigner) {
 	ch.rtt[cosigner.GetID()] = -1
 }
 
+// MarkFaulty records that cosigner sent an invalid partial signature, so it is picked after
+// every other cosigner until faultyCooldown has passed.
+func (ch *CosignerHealth) MarkFaulty(cosigner Cosigner) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	ch.faulty[cosigner.GetID()] = time.Now()
+}
+
+// IsFaulty returns true if the cosigner with id sent an invalid partial signature within faultyCooldown.
+func (ch *CosignerHealth) IsFaulty(id int) bool {
+	ch.mu.RLock()
+	defer ch.mu.RUnlock()
+	return ch.isFaulty(id, time.Now())
+}
+
+func (ch *CosignerHealth) isFaulty(id int, now time.Time) bool {
+	at, ok := ch.faulty[id]
+	return ok && now.Sub(at) < faultyCooldown
+}
+
+// HealthyRTT returns the last round trip time of the cosigner with id, if it answered its last ping
+// and did not send an invalid partial signature within faultyCooldown.
+func (ch *CosignerHealth) HealthyRTT(id int) (int64, bool) {
+	ch.mu.RLock()
+	defer ch.mu.RUnlock()
+	rtt, ok := ch.rtt[id]
+	if !ok || rtt == -1 || ch.isFaulty(id, time.Now()) {
+		return 0, false
+	}
+	return rtt, true
+}
+
 func (ch *CosignerHealth) updateRTT(ctx context.Context, cosigner *RemoteCosigner, wg *sync.WaitGroup) {
 	defer wg.Done()
 
@@ -93,7 +155,12 @@ func (ch *CosignerHealth) GetFastest() []Cosigner {
 	fastest := make([]Cosigner, len(ch.cosigners))
 	copy(fastest, ch.cosigners)
 
+	now := time.Now()
 	sort.Slice(fastest, func(i, j int) bool {
+		faulty1, faulty2 := ch.isFaulty(fastest[i].GetID(), now), ch.isFaulty(fastest[j].GetID(), now)
+		if faulty1 != faulty2 {
+			return faulty2
+		}
 		rtt1, ok1 := ch.rtt[fastest[i].GetID()]
 		rtt2, ok2 := ch.rtt[fastest[j].GetID()]
 		if rtt1 == -1 || !ok1 {
@@ -34,3 +34,31 @@ func TestCosignerHealth(t *testing.T) {
 	require.Equal(t, 4, fastest[0].GetID())
 	require.Equal(t, 2, fastest[1].GetID())
 }
+
+func TestCosignerHealthSetCosigners(t *testing.T) {
+	ch := NewCosignerHealth(
+		cometlog.NewNopLogger(),
+		[]Cosigner{
+			&RemoteCosigner{id: 2},
+			&RemoteCosigner{id: 3},
+		},
+		&MockLeader{id: 1},
+	)
+
+	ch.rtt = map[int]int64{
+		2: 200,
+		3: 100,
+	}
+
+	ch.SetCosigners([]Cosigner{
+		&RemoteCosigner{id: 2},
+		&RemoteCosigner{id: 4},
+	})
+
+	fastest := ch.GetFastest()
+
+	require.Len(t, fastest, 2)
+	require.Equal(t, 2, fastest[0].GetID())
+	require.Equal(t, 4, fastest[1].GetID())
+	require.NotContains(t, ch.rtt, 3)
+}
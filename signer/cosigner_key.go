@@ -2,7 +2,6 @@ package signer
 
 import (
 	"encoding/json"
-	"os"
 
 	cometcrypto "github.com/cometbft/cometbft/crypto"
 	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
@@ -16,6 +15,10 @@ type CosignerEd25519Key struct {
 	PubKey       cometcrypto.PubKey `json:"pubKey"`
 	PrivateShard []byte             `json:"privateShard"`
 	ID           int                `json:"id"`
+
+	// PubShares are the public keys of the shards of every cosigner, indexed by shard ID - 1.
+	// They are used to verify partial signatures, and are missing from shards created by older releases.
+	PubShares [][]byte `json:"pubShares,omitempty"`
 }
 
 func (key *CosignerEd25519Key) MarshalJSON() ([]byte, error) {
@@ -87,7 +90,7 @@ func (key *CosignerEd25519Key) UnmarshalJSON(data []byte) error {
 // LoadCosignerEd25519Key loads a CosignerEd25519Key from file.
 func LoadCosignerEd25519Key(file string) (CosignerEd25519Key, error) {
 	pvKey := CosignerEd25519Key{}
-	keyJSONBytes, err := os.ReadFile(file)
+	keyJSONBytes, err := readKeyFile(file)
 	if err != nil {
 		return pvKey, err
 	}
@@ -4,7 +4,6 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/json"
-	"os"
 
 	cometjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/privval"
@@ -20,18 +19,26 @@ func CreateCosignerEd25519ShardsFromFile(priv string, threshold, shards uint8) (
 	if err != nil {
 		return nil, err
 	}
+	if err := ValidateThresholdKey(pv.PrivKey.PubKey()); err != nil {
+		return nil, err
+	}
 	return CreateCosignerEd25519Shards(pv, threshold, shards), nil
 }
 
 // CreateCosignerEd25519Shards creates CosignerEd25519Key objects from a privval.FilePVKey
 func CreateCosignerEd25519Shards(pv privval.FilePVKey, threshold, shards uint8) []CosignerEd25519Key {
 	privShards := tsed25519.DealShares(tsed25519.ExpandSecret(pv.PrivKey.Bytes()[:32]), threshold, shards)
+	pubShares := make([][]byte, len(privShards))
+	for i, shard := range privShards {
+		pubShares[i] = tsed25519.ScalarMultiplyBase(shard)
+	}
 	out := make([]CosignerEd25519Key, shards)
 	for i, shard := range privShards {
 		out[i] = CosignerEd25519Key{
 			PubKey:       pv.PubKey,
 			PrivateShard: shard,
 			ID:           i + 1,
+			PubShares:    pubShares,
 		}
 	}
 	return out
@@ -57,7 +64,7 @@ func CreateCosignerRSAShards(shards int) ([]CosignerRSAKey, error) {
 // ReadPrivValidatorFile reads in a privval.FilePVKey from a given file.
 func ReadPrivValidatorFile(priv string) (out privval.FilePVKey, err error) {
 	var bz []byte
-	if bz, err = os.ReadFile(priv); err != nil {
+	if bz, err = readKeyFile(priv); err != nil {
 		return
 	}
 	if err = cometjson.Unmarshal(bz, &out); err != nil {
@@ -72,7 +79,7 @@ func WriteCosignerEd25519ShardFile(cosigner CosignerEd25519Key, file string) err
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(file, jsonBytes, 0600)
+	return writeKeyFile(file, jsonBytes)
 }
 
 // WriteCosignerRSAShardFile writes a cosigner RSA key to a given file name.
@@ -81,7 +88,7 @@ func WriteCosignerRSAShardFile(cosigner CosignerRSAKey, file string) error {
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(file, jsonBytes, 0600)
+	return writeKeyFile(file, jsonBytes)
 }
 
 // CreateCosignerECIESShards generates CosignerECIESKey objects.
@@ -107,7 +114,7 @@ func WriteCosignerECIESShardFile(cosigner CosignerECIESKey, file string) error {
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(file, jsonBytes, 0600)
+	return writeKeyFile(file, jsonBytes)
 }
 
 func makeRSAKeys(num int) (rsaKeys []*rsa.PrivateKey, pubKeys []*rsa.PublicKey, err error) {
@@ -16,11 +16,17 @@ const (
 	defaultGetNoncesTimeout  = 4 * time.Second
 	defaultNonceExpiration   = 10 * time.Second // half of the local cosigner cache expiration
 	nonceOverallocation      = 1.5
+
+	// standbyNonces is the number of nonces followers keep cached, so that a newly elected leader signs
+	// its first blocks with cached nonces until its cache has adapted to the demand.
+	standbyNonces = 10
 )
 
 type CosignerNonceCache struct {
 	logger    cometlog.Logger
 	cosigners []Cosigner
+	// protects cosigners and threshold, which change with cluster membership and resharing
+	cosignersMu sync.RWMutex
 
 	leader Leader
 
@@ -212,10 +218,16 @@ func (cnc *CosignerNonceCache) reconcile(ctx context.Context) {
 	pruned := cnc.pruner.PruneNonces()
 
 	if !cnc.leader.IsLeader() {
+		cnc.reconcileStandby(ctx)
 		return
 	}
 	remainingNonces := cnc.cache.Size()
 	timeSinceLastReconcile := time.Since(cnc.lastReconcileTime)
+	if timeSinceLastReconcile < cnc.getNoncesInterval {
+		// reconciles come early when the cache runs empty, or right after an election. The demand is
+		// measured over at least an interval, so a few nonces used in a short time don't inflate it.
+		timeSinceLastReconcile = cnc.getNoncesInterval
+	}
 
 	lastReconcileNonces := cnc.lastReconcileNonces.Load()
 	// calculate nonces per minute
@@ -265,18 +277,52 @@ func (cnc *CosignerNonceCache) reconcile(ctx context.Context) {
 	cnc.LoadN(ctx, additional)
 }
 
+// reconcileStandby keeps standbyNonces cached while this cosigner is a follower. The demand is only measured
+// while leading, so the first reconcile after an election measures the nonces used from the standby cache.
+func (cnc *CosignerNonceCache) reconcileStandby(ctx context.Context) {
+	remainingNonces := cnc.cache.Size()
+	additional := standbyNonces - remainingNonces
+	if additional < 0 {
+		additional = 0
+	}
+
+	defer func() {
+		cnc.lastReconcileNonces.Store(uint64(remainingNonces + additional))
+		cnc.lastReconcileTime = time.Now()
+	}()
+
+	if additional == 0 {
+		return
+	}
+
+	cnc.logger.Debug(
+		"Loading standby nonces",
+		"target", standbyNonces,
+		"remaining", remainingNonces,
+		"additional", additional,
+	)
+
+	cnc.LoadN(ctx, additional)
+}
+
 func (cnc *CosignerNonceCache) LoadN(ctx context.Context, n int) {
 	if n == 0 {
 		return
 	}
 	uuids := cnc.getUuids(n)
-	nonces := make([]*CachedNonceSingle, len(cnc.cosigners))
+
+	cnc.cosignersMu.RLock()
+	cosigners := cnc.cosigners
+	threshold := cnc.threshold
+	cnc.cosignersMu.RUnlock()
+
+	nonces := make([]*CachedNonceSingle, len(cosigners))
 	var wg sync.WaitGroup
-	wg.Add(len(cnc.cosigners))
+	wg.Add(len(cosigners))
 
 	expiration := time.Now().Add(cnc.nonceExpiration)
 
-	for i, p := range cnc.cosigners {
+	for i, p := range cosigners {
 		i := i
 		p := p
 		go func() {
@@ -322,7 +368,7 @@ func (cnc *CosignerNonceCache) LoadN(ctx context.Context, n int) {
 				Nonces:   n.Nonces[i].Nonces,
 			})
 		}
-		if num >= cnc.threshold {
+		if num >= threshold {
 			cnc.cache.Add(&nonce)
 			added++
 		}
@@ -399,6 +445,10 @@ CheckNoncesLoop:
 }
 
 func (cnc *CosignerNonceCache) ClearNonces(cosigner Cosigner) {
+	cnc.cosignersMu.RLock()
+	threshold := cnc.threshold
+	cnc.cosignersMu.RUnlock()
+
 	cnc.cache.mu.Lock()
 	defer cnc.cache.mu.Unlock()
 	for i := 0; i < len(cnc.cache.cache); i++ {
@@ -413,7 +463,7 @@ func (cnc *CosignerNonceCache) ClearNonces(cosigner Cosigner) {
 			}
 		}
 		if deleteID >= 0 {
-			if len(cn.Nonces)-1 < int(cnc.threshold) {
+			if len(cn.Nonces)-1 < int(threshold) {
 				// If cosigners on this nonce drops below threshold, delete it as it's no longer usable
 				cnc.cache.Delete(i)
 				i--
@@ -423,3 +473,30 @@ func (cnc *CosignerNonceCache) ClearNonces(cosigner Cosigner) {
 		}
 	}
 }
+
+// SetCosigners replaces the cosigners which nonces are loaded from after a cluster membership change.
+// Cached nonces from cosigners which are no longer members, or which were replaced, are cleared.
+func (cnc *CosignerNonceCache) SetCosigners(cosigners []Cosigner) {
+	cnc.cosignersMu.Lock()
+	previous := cnc.cosigners
+	cnc.cosigners = cosigners
+	cnc.cosignersMu.Unlock()
+
+	for _, p := range previous {
+		if Cosigners(cosigners).GetByID(p.GetID()) != p {
+			cnc.ClearNonces(p)
+		}
+	}
+}
+
+// SetThreshold changes the number of cosigners required for cached nonces after a reshare.
+// Cached nonces were dealt for the previous threshold, so they are cleared.
+func (cnc *CosignerNonceCache) SetThreshold(threshold uint8) {
+	cnc.cosignersMu.Lock()
+	cnc.threshold = threshold
+	cnc.cosignersMu.Unlock()
+
+	cnc.cache.mu.Lock()
+	defer cnc.cache.mu.Unlock()
+	cnc.cache.cache = nil
+}
@@ -189,6 +189,52 @@ func TestNonceCacheDemand(t *testing.T) {
 	require.Equal(t, 0, pruned, "no nonces should have been pruned")
 }
 
+func TestNonceCacheStandby(t *testing.T) {
+	lcs, _ := getTestLocalCosigners(t, 2, 3)
+	cosigners := make([]Cosigner, len(lcs))
+	for i, lc := range lcs {
+		cosigners[i] = lc
+	}
+
+	// cosigner 2 is the leader, so this cache is a follower's.
+	leader := &MockLeader{id: 2, leader: &ThresholdValidator{myCosigner: lcs[0]}}
+
+	nonceCache := NewCosignerNonceCache(
+		cometlog.NewNopLogger(),
+		cosigners,
+		leader,
+		defaultGetNoncesInterval,
+		defaultGetNoncesTimeout,
+		defaultNonceExpiration,
+		2,
+		nil,
+	)
+
+	ctx := context.Background()
+
+	nonceCache.reconcile(ctx)
+	require.Equal(t, standbyNonces, nonceCache.cache.Size())
+
+	// a follower does not load more while it keeps its standby nonces.
+	nonceCache.reconcile(ctx)
+	require.Equal(t, standbyNonces, nonceCache.cache.Size())
+
+	// after the election, the new leader signs with the standby nonces.
+	leader.SetLeader(&ThresholdValidator{myCosigner: lcs[1]})
+	const used = 6
+	for i := 0; i < used; i++ {
+		_, err := nonceCache.GetNonces([]Cosigner{cosigners[1], cosigners[2]})
+		require.NoError(t, err)
+	}
+
+	// the nonces used from the standby cache are the demand the cache adapts to. The first reconcile
+	// as leader comes right after the election, which must not be taken as a burst of demand.
+	nonceCache.reconcile(ctx)
+	size := nonceCache.cache.Size()
+	require.Greater(t, size, standbyNonces-used)
+	require.LessOrEqual(t, size, nonceCache.target(float64(used)/defaultGetNoncesInterval.Minutes()))
+}
+
 func TestNonceCacheExpiration(t *testing.T) {
 	lcs, _ := getTestLocalCosigners(t, 2, 3)
 	cosigners := make([]Cosigner, len(lcs))
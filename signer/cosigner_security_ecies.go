@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"os"
 
 	cometjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
@@ -100,7 +99,7 @@ func (key *CosignerECIESKey) UnmarshalJSON(data []byte) error {
 // LoadCosignerECIESKey loads a CosignerECIESKey from file.
 func LoadCosignerECIESKey(file string) (CosignerECIESKey, error) {
 	pvKey := CosignerECIESKey{}
-	keyJSONBytes, err := os.ReadFile(file)
+	keyJSONBytes, err := readKeyFile(file)
 	if err != nil {
 		return pvKey, err
 	}
@@ -8,7 +8,6 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
-	"os"
 
 	cometjson "github.com/cometbft/cometbft/libs/json"
 	"golang.org/x/sync/errgroup"
@@ -92,7 +91,7 @@ func (key *CosignerRSAKey) UnmarshalJSON(data []byte) error {
 // LoadCosignerRSAKey loads a CosignerRSAKey from file.
 func LoadCosignerRSAKey(file string) (CosignerRSAKey, error) {
 	pvKey := CosignerRSAKey{}
-	keyJSONBytes, err := os.ReadFile(file)
+	keyJSONBytes, err := readKeyFile(file)
 	if err != nil {
 		return pvKey, err
 	}
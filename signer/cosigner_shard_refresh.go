@@ -0,0 +1,225 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+)
+
+// ShardRefreshCommit is replicated through raft to swap in the key shards staged by a refresh session.
+type ShardRefreshCommit struct {
+	ChainID   string `json:"chainID"`
+	SessionID []byte `json:"sessionID"`
+}
+
+// ShardReshareCommit is replicated through raft to swap in the key shards staged by reshare sessions,
+// together with the new threshold.
+type ShardReshareCommit struct {
+	Threshold int                  `json:"threshold"`
+	Shards    []ShardRefreshCommit `json:"shards"`
+}
+
+// CommitShardRefresh replaces the key shard for chainID with the one staged by the refresh session,
+// and reloads the signer so the previous shard is no longer used.
+func (cosigner *LocalCosigner) CommitShardRefresh(chainID string, sessionID []byte) error {
+	cosigner.membershipMu.RLock()
+	defer cosigner.membershipMu.RUnlock()
+	return cosigner.commitStagedShard(chainID, sessionID)
+}
+
+// CommitShardReshare sets the new threshold and replaces the key shards with the ones staged by
+// the reshare sessions. Sessions which have no staged shard, e.g. on raft log replay, are skipped.
+func (cosigner *LocalCosigner) CommitShardReshare(commit ShardReshareCommit) error {
+	cosigner.membershipMu.Lock()
+	defer cosigner.membershipMu.Unlock()
+
+	cosigner.config.Config.ThresholdModeConfig.Threshold = commit.Threshold
+
+	var errs []error
+	for _, shard := range commit.Shards {
+		if err := cosigner.commitStagedShard(shard.ChainID, shard.SessionID); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("chain %s: %w", shard.ChainID, err))
+			}
+		}
+	}
+
+	if cosigner.config.ConfigFile != "" {
+		if err := cosigner.config.WriteConfigFile(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// commitStagedShard moves the key shard staged by a session over the current one. The caller must hold membershipMu.
+func (cosigner *LocalCosigner) commitStagedShard(chainID string, sessionID []byte) error {
+	keyFile := cosigner.config.KeyFilePathCosigner(chainID)
+	pendingFile := cosigner.config.KeyFilePathCosignerRefresh(chainID, sessionID)
+
+	pending, err := LoadCosignerEd25519Key(pendingFile)
+	if err != nil {
+		return err
+	}
+	// a cosigner which joined the cluster receives its first shard through a reshare.
+	current, err := LoadCosignerEd25519Key(keyFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return err
+	case pending.ID != current.ID || !bytes.Equal(pending.PubKey.Bytes(), current.PubKey.Bytes()):
+		return fmt.Errorf("staged key shard %s does not match %s", pendingFile, keyFile)
+	}
+
+	if err := os.Rename(pendingFile, keyFile); err != nil {
+		return err
+	}
+
+	// shards staged by sessions which never committed are not usable anymore.
+	stale, _ := filepath.Glob(keyFile + ".*.pending")
+	for _, f := range stale {
+		_ = os.Remove(f)
+	}
+
+	if ccs, err := cosigner.getChainState(chainID); err == nil {
+		if err := cosigner.reloadSigner(chainID, ccs); err != nil {
+			return err
+		}
+	}
+
+	cosigner.logger.Info(
+		"Committed staged key shard",
+		"chain_id", chainID,
+		"session", fmt.Sprintf("%x", sessionID),
+	)
+
+	return nil
+}
+
+// RefreshShards proactively refreshes the key shards for chainID on all cosigners.
+// Only the raft leader can coordinate a refresh. Every cosigner must be online, since a cosigner
+// which misses the refresh would be left with a shard that no longer combines with the others.
+func (s *RaftStore) RefreshShards(ctx context.Context, chainID string) (cometcrypto.PubKey, error) {
+	if !s.IsLeader() {
+		return nil, errors.New("not leader")
+	}
+
+	participants, err := s.dkgParticipants()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, pubKey, err := RunShardRefresh(ctx, chainID, s.cosigner.threshold(), participants)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Emit(raftEventShardRefresh, ShardRefreshCommit{
+		ChainID:   chainID,
+		SessionID: sessionID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to commit shard refresh: %w", err)
+	}
+
+	return pubKey, nil
+}
+
+// ReshareShards reshares the key shards of every chain held by the leader to all current cosigners
+// with the given threshold, and returns the chain IDs which were reshared. A threshold of 0 keeps the
+// current threshold, e.g. to hand out shards to cosigners added with horcrux cluster add.
+// Only the raft leader can coordinate a reshare, and every cosigner must be online.
+func (s *RaftStore) ReshareShards(ctx context.Context, threshold int) ([]string, error) {
+	if !s.IsLeader() {
+		return nil, errors.New("not leader")
+	}
+
+	s.membershipMu.Lock()
+	defer s.membershipMu.Unlock()
+
+	if threshold == 0 {
+		threshold = s.cosigner.threshold()
+	}
+	if err := s.cosigner.validateThresholdModeConfig(threshold, s.cosigner.cosigners()); err != nil {
+		return nil, err
+	}
+
+	chainIDs, err := s.cosigner.shardChainIDs()
+	if err != nil {
+		return nil, err
+	}
+	if len(chainIDs) == 0 {
+		return nil, errors.New("the leader holds no key shards to reshare, transfer leadership first with horcrux elect")
+	}
+
+	participants, err := s.dkgParticipants()
+	if err != nil {
+		return nil, err
+	}
+
+	commit := ShardReshareCommit{Threshold: threshold}
+	for _, chainID := range chainIDs {
+		sessionID, _, err := RunShardReshare(ctx, chainID, threshold, participants)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reshare chain %s: %w", chainID, err)
+		}
+		commit.Shards = append(commit.Shards, ShardRefreshCommit{
+			ChainID:   chainID,
+			SessionID: sessionID,
+		})
+	}
+
+	if err := s.Emit(raftEventShardReshare, commit); err != nil {
+		return nil, fmt.Errorf("failed to commit shard reshare: %w", err)
+	}
+
+	return chainIDs, nil
+}
+
+// dkgParticipants returns this cosigner and all peers as DKG participants.
+func (s *RaftStore) dkgParticipants() ([]DKGParticipant, error) {
+	cosigners := s.getCosigners()
+	participants := make([]DKGParticipant, 0, len(cosigners)+1)
+	participants = append(participants, s.cosigner)
+	for _, c := range cosigners {
+		p, ok := c.(DKGParticipant)
+		if !ok {
+			return nil, fmt.Errorf("cosigner %d does not support distributed key generation", c.GetID())
+		}
+		participants = append(participants, p)
+	}
+	return participants, nil
+}
+
+// shardChainIDs returns the chain IDs this cosigner holds a key shard for.
+func (cosigner *LocalCosigner) shardChainIDs() ([]string, error) {
+	pattern := cosigner.config.KeyFilePathCosigner("*")
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	prefix, suffix, _ := strings.Cut(filepath.Base(pattern), "*")
+	chainIDs := make([]string, 0, len(files))
+	seen := make(map[string]bool, len(files))
+	for _, f := range files {
+		chainID := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(f), prefix), suffix)
+		chainIDs = append(chainIDs, chainID)
+		seen[chainID] = true
+	}
+	// shards with a keyFile override in the chains config do not match the pattern.
+	for _, chain := range cosigner.config.Config.Chains {
+		if chain.KeyFile == "" || seen[chain.ChainID] {
+			continue
+		}
+		if _, err := os.Stat(cosigner.config.KeyFilePathCosigner(chain.ChainID)); err == nil {
+			chainIDs = append(chainIDs, chain.ChainID)
+		}
+	}
+	return chainIDs, nil
+}
@@ -0,0 +1,171 @@
+package signer
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tsed25519 "gitlab.com/unit410/threshold-ed25519/pkg"
+)
+
+func TestShardRefresh(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+
+	participants := make([]DKGParticipant, len(cosigners))
+	oldShards := make([]CosignerEd25519Key, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+
+		key, err := LoadCosignerEd25519Key(c.config.KeyFilePathCosigner(testChainID))
+		require.NoError(t, err)
+		oldShards[i] = key
+	}
+
+	signBytes, sig := testSignWithLocalCosigners(t, cosigners[:2], testChainID, 1)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	sessionID, refreshedPubKey, err := RunShardRefresh(context.Background(), testChainID, 2, participants)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, refreshedPubKey)
+
+	// shards are only staged until the refresh commits.
+	for i, c := range cosigners {
+		key, err := LoadCosignerEd25519Key(c.config.KeyFilePathCosigner(testChainID))
+		require.NoError(t, err)
+		require.Equal(t, oldShards[i].PrivateShard, key.PrivateShard)
+	}
+	signBytes, sig = testSignWithLocalCosigners(t, cosigners[1:], testChainID, 2)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	for _, c := range cosigners {
+		require.NoError(t, c.CommitShardRefresh(testChainID, sessionID))
+	}
+
+	for i, c := range cosigners {
+		key, err := LoadCosignerEd25519Key(c.config.KeyFilePathCosigner(testChainID))
+		require.NoError(t, err)
+		require.Equal(t, oldShards[i].PubKey, key.PubKey)
+		require.NotEqual(t, oldShards[i].PrivateShard, key.PrivateShard)
+
+		// the public key shares are refreshed with the shards.
+		require.Equal(t, []byte(tsed25519.ScalarMultiplyBase(key.PrivateShard)), key.PubShares[key.ID-1])
+		require.NotEqual(t, oldShards[i].PubShares, key.PubShares)
+
+		_, err = os.Stat(c.config.KeyFilePathCosignerRefresh(testChainID, sessionID))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+
+	// raft log replay of the same commit is a no-op.
+	require.ErrorIs(t, cosigners[0].CommitShardRefresh(testChainID, sessionID), os.ErrNotExist)
+
+	signBytes, sig = testSignWithLocalCosigners(t, cosigners[:2], testChainID, 3)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	// an old shard no longer combines with the refreshed shards.
+	err = loadKeyForLocalCosigner(cosigners[0], pubKey, testChainID, oldShards[0].PrivateShard, oldShards[0].PubShares)
+	require.NoError(t, err)
+	cosigners[0].chainState.Delete(testChainID)
+
+	signBytes, sig = testSignWithLocalCosigners(t, cosigners[:2], testChainID, 4)
+	require.False(t, pubKey.VerifySignature(signBytes, sig))
+}
+
+func TestShardRefreshKeepsRawBytesSignState(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+	validator := newTestRawBytesValidator(t, cosigners, 2)
+
+	participants := make([]DKGParticipant, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+	}
+
+	ctx := context.Background()
+	_, err := validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("price"))
+	require.NoError(t, err)
+
+	sessionID, _, err := RunShardRefresh(ctx, testChainID, 2, participants)
+	require.NoError(t, err)
+	for _, c := range cosigners {
+		require.NoError(t, c.CommitShardRefresh(testChainID, sessionID))
+	}
+
+	// the cosigners sign raw bytes with the refreshed shards, and still refuse a signed unique ID for other raw bytes.
+	sig, err := validator.SignRawBytes(ctx, testChainID, "oracle/2", []byte("price"))
+	require.NoError(t, err)
+	signBytes, err := RawBytesSignBytes("oracle/2", []byte("price"))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	_, err = validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("other price"))
+	require.ErrorIs(t, err, ErrRawBytesConflict)
+}
+
+func TestShardRefreshRequiresShard(t *testing.T) {
+	cosigners, _ := getTestLocalCosigners(t, 2, 3)
+
+	participants := make([]DKGParticipant, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+	}
+
+	_, _, err := RunShardRefresh(context.Background(), testDKGChainID, 2, participants)
+	require.ErrorContains(t, err, "no key shard to refresh for chain "+testDKGChainID)
+}
+
+func TestShardReshare(t *testing.T) {
+	// cosigners 4 and 5 joined the cluster without shards.
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 5)
+	for _, c := range cosigners[3:] {
+		require.NoError(t, os.Remove(c.config.KeyFilePathCosigner(testChainID)))
+	}
+
+	participants := make([]DKGParticipant, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+	}
+
+	sessionID, resharedPubKey, err := RunShardReshare(context.Background(), testChainID, 3, participants)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, resharedPubKey)
+
+	commit := ShardReshareCommit{
+		Threshold: 3,
+		Shards:    []ShardRefreshCommit{{ChainID: testChainID, SessionID: sessionID}},
+	}
+	for _, c := range cosigners {
+		require.NoError(t, c.CommitShardReshare(commit))
+		require.Equal(t, 3, c.threshold())
+
+		key, err := LoadCosignerEd25519Key(c.config.KeyFilePathCosigner(testChainID))
+		require.NoError(t, err)
+		require.Equal(t, c.GetID(), key.ID)
+		require.Equal(t, pubKey, key.PubKey)
+	}
+
+	// raft log replay of the same commit is a no-op.
+	require.NoError(t, cosigners[0].CommitShardReshare(commit))
+
+	signers := []*LocalCosigner{cosigners[1], cosigners[3], cosigners[4]}
+	signBytes, sig := testSignWithLocalCosigners(t, signers, testChainID, 1)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	// two shards are no longer enough.
+	signBytes, sig = testSignWithLocalCosigners(t, cosigners[:2], testChainID, 2)
+	require.False(t, pubKey.VerifySignature(signBytes, sig))
+}
+
+func TestShardReshareRequiresThresholdDealers(t *testing.T) {
+	cosigners, _ := getTestLocalCosigners(t, 2, 3)
+	for _, c := range cosigners[1:] {
+		require.NoError(t, os.Remove(c.config.KeyFilePathCosigner(testChainID)))
+	}
+
+	participants := make([]DKGParticipant, len(cosigners))
+	for i, c := range cosigners {
+		participants[i] = c
+	}
+
+	_, _, err := RunShardReshare(context.Background(), testChainID, 2, participants)
+	require.ErrorContains(t, err, "1 cosigners hold a key shard for chain "+testChainID+", at least 2 are required")
+}
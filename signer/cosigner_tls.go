@@ -0,0 +1,379 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	cosignerTLSIdentityPrefix = "cosigner-"
+	cosignerTLSValidity       = 10 * 365 * 24 * time.Hour
+
+	CosignerTLSCACertFile = "tls_ca.crt"
+	CosignerTLSCAKeyFile  = "tls_ca.key"
+	CosignerTLSCertFile   = "tls.crt"
+	CosignerTLSKeyFile    = "tls.key"
+)
+
+// CosignerTLSIdentity returns the name a cosigner certificate must carry, as a DNS SAN
+// or as the subject common name, to identify the cosigner with the given shard ID.
+func CosignerTLSIdentity(shardID int) string {
+	return cosignerTLSIdentityPrefix + strconv.Itoa(shardID)
+}
+
+// cosignerTLSShardID extracts the shard ID that a cosigner certificate identifies.
+func cosignerTLSShardID(cert *x509.Certificate) (int, error) {
+	names := append([]string{}, cert.DNSNames...)
+	names = append(names, cert.Subject.CommonName)
+	for _, name := range names {
+		if !strings.HasPrefix(name, cosignerTLSIdentityPrefix) {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(name, cosignerTLSIdentityPrefix))
+		if err != nil || id < 1 {
+			continue
+		}
+		return id, nil
+	}
+	return 0, fmt.Errorf("certificate does not identify a cosigner, expected a name of the form %s<shard ID>",
+		cosignerTLSIdentityPrefix)
+}
+
+// CosignerTLS holds the certificate material used for mutual TLS between cosigners,
+// along with the cluster membership used to verify the identity of peers.
+type CosignerTLS struct {
+	cert  tls.Certificate
+	roots *x509.CertPool
+
+	// protects peers and members, which change with cluster membership
+	mu sync.RWMutex
+	// peers maps the p2p host:port of each configured cosigner to its shard ID.
+	peers   map[string]int
+	members map[int]struct{}
+}
+
+// NewCosignerTLS creates a CosignerTLS from PEM encoded certificates and key.
+func NewCosignerTLS(caCertPEM, certPEM, keyPEM []byte, cosigners CosignersConfig) (*CosignerTLS, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cosigner certificate: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCertPEM) {
+		return nil, fmt.Errorf("no certificates found in cosigner CA certificate")
+	}
+
+	t := &CosignerTLS{
+		cert:  cert,
+		roots: roots,
+	}
+	t.SetCosigners(cosigners)
+
+	return t, nil
+}
+
+// SetCosigners replaces the cluster membership used to verify the identity of peers,
+// so that cosigners which are added or replaced can connect, and removed cosigners can not.
+func (t *CosignerTLS) SetCosigners(cosigners CosignersConfig) {
+	peers := make(map[string]int, len(cosigners))
+	members := make(map[int]struct{}, len(cosigners))
+	for _, c := range cosigners {
+		peers[p2pURLToRaftAddress(c.P2PAddr)] = c.ShardID
+		members[c.ShardID] = struct{}{}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.peers = peers
+	t.members = members
+}
+
+// LoadCosignerTLS reads the mutual TLS files referenced by the threshold mode config.
+func LoadCosignerTLS(caCertFile, certFile, keyFile string, cosigners CosignersConfig) (*CosignerTLS, error) {
+	caCertPEM, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cosigner CA certificate (%s): %w", caCertFile, err)
+	}
+	certPEM, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cosigner certificate (%s): %w", certFile, err)
+	}
+	keyPEM, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cosigner certificate key (%s): %w", keyFile, err)
+	}
+	return NewCosignerTLS(caCertPEM, certPEM, keyPEM, cosigners)
+}
+
+func (t *CosignerTLS) isMember(shardID int) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.members[shardID]
+	return ok
+}
+
+// peerShardID returns the shard ID of the cosigner with the p2p host:port address.
+func (t *CosignerTLS) peerShardID(address string) (int, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	shardID, ok := t.peers[address]
+	return shardID, ok
+}
+
+// verifyPeer returns a certificate verification function which checks the peer chain against
+// the cosigner CA, then checks that the identity of the peer is acceptable.
+func (t *CosignerTLS) verifyPeer(
+	usage x509.ExtKeyUsage,
+	allowed func(shardID int) error,
+) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("peer did not present a certificate")
+		}
+
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return fmt.Errorf("failed to parse peer certificate: %w", err)
+			}
+			certs[i] = cert
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		if _, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         t.roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{usage},
+		}); err != nil {
+			return fmt.Errorf("failed to verify peer certificate: %w", err)
+		}
+
+		shardID, err := cosignerTLSShardID(certs[0])
+		if err != nil {
+			return err
+		}
+
+		return allowed(shardID)
+	}
+}
+
+// ServerConfig returns the TLS config for the p2p listener. Only cosigners that are part
+// of the configured cluster are accepted.
+func (t *CosignerTLS) ServerConfig() *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		MinVersion:   tls.VersionTLS13,
+		ClientAuth:   tls.RequireAnyClientCert,
+		VerifyPeerCertificate: t.verifyPeer(x509.ExtKeyUsageClientAuth, func(shardID int) error {
+			if !t.isMember(shardID) {
+				return fmt.Errorf("peer certificate identifies cosigner %d, which is not in the cluster", shardID)
+			}
+			return nil
+		}),
+	}
+}
+
+// ClientConfig returns the TLS config for dialing the cosigner with the given shard ID.
+// The connection is refused if the peer certificate identifies any other cosigner.
+func (t *CosignerTLS) ClientConfig(shardID int) *tls.Config {
+	return t.clientConfig(func(peerID int) error {
+		if peerID != shardID {
+			return fmt.Errorf("peer certificate identifies cosigner %d, expected cosigner %d", peerID, shardID)
+		}
+		return nil
+	})
+}
+
+// ClusterClientConfig returns the TLS config for dialing any cosigner in the cluster,
+// for use by tooling which does not address a specific cosigner.
+func (t *CosignerTLS) ClusterClientConfig() *tls.Config {
+	return t.clientConfig(func(peerID int) error {
+		if !t.isMember(peerID) {
+			return fmt.Errorf("peer certificate identifies cosigner %d, which is not in the cluster", peerID)
+		}
+		return nil
+	})
+}
+
+func (t *CosignerTLS) clientConfig(allowed func(shardID int) error) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		MinVersion:   tls.VersionTLS13,
+		// The peer chain and identity are verified against the cosigner CA in VerifyPeerCertificate,
+		// since cosigners are identified by shard ID rather than by hostname.
+		InsecureSkipVerify:    true, //nolint:gosec
+		VerifyPeerCertificate: t.verifyPeer(x509.ExtKeyUsageServerAuth, allowed),
+	}
+}
+
+// ServerCredentials returns the gRPC transport credentials for the p2p listener.
+func (t *CosignerTLS) ServerCredentials() credentials.TransportCredentials {
+	return credentials.NewTLS(t.ServerConfig())
+}
+
+// PeerCredentials returns the gRPC transport credentials for dialing the cosigner with the given shard ID.
+func (t *CosignerTLS) PeerCredentials(shardID int) credentials.TransportCredentials {
+	return credentials.NewTLS(t.ClientConfig(shardID))
+}
+
+// ClusterCredentials returns the gRPC transport credentials for dialing any cosigner in the cluster.
+func (t *CosignerTLS) ClusterCredentials() credentials.TransportCredentials {
+	return credentials.NewTLS(t.ClusterClientConfig())
+}
+
+// TransportCredentials returns gRPC transport credentials which resolve the expected peer
+// identity from the dialed address. This is used for the Raft transport, which only knows
+// the addresses of its peers. Dialing an address that is not configured fails closed.
+func (t *CosignerTLS) TransportCredentials() credentials.TransportCredentials {
+	return &cosignerTransportCredentials{
+		TransportCredentials: t.ServerCredentials(),
+		tls:                  t,
+	}
+}
+
+type cosignerTransportCredentials struct {
+	credentials.TransportCredentials
+	tls *CosignerTLS
+}
+
+func (c *cosignerTransportCredentials) ClientHandshake(
+	ctx context.Context,
+	authority string,
+	conn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	shardID, ok := c.tls.peerShardID(authority)
+	if !ok {
+		return nil, nil, fmt.Errorf("refusing to dial %s, address does not belong to a configured cosigner", authority)
+	}
+	return c.tls.PeerCredentials(shardID).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *cosignerTransportCredentials) Clone() credentials.TransportCredentials {
+	return &cosignerTransportCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		tls:                  c.tls,
+	}
+}
+
+// CreateCosignerTLSCA creates a self-signed certificate authority for issuing cosigner certificates.
+// It returns the PEM encoded certificate and private key.
+func CreateCosignerTLSCA() (certPEM []byte, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := newCertificateSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "horcrux cosigner CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(cosignerTLSValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertificateAndKey(der, key)
+}
+
+// CreateCosignerTLSCert issues a certificate identifying the cosigner with the given shard ID,
+// signed by the PEM encoded CA certificate and key. It returns the PEM encoded certificate and private key.
+func CreateCosignerTLSCert(caCertPEM, caKeyPEM []byte, shardID int) (certPEM []byte, keyPEM []byte, err error) {
+	ca, err := tls.X509KeyPair(caCertPEM, caKeyPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load CA certificate: %w", err)
+	}
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := newCertificateSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identity := CosignerTLSIdentity(shardID)
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: identity},
+		DNSNames:     []string{identity},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(cosignerTLSValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, ca.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertificateAndKey(der, key)
+}
+
+// WriteCosignerTLSFiles writes the CA certificate, certificate and private key for a cosigner into dir.
+func WriteCosignerTLSFiles(dir string, caCertPEM, certPEM, keyPEM []byte) error {
+	if err := os.WriteFile(filepath.Join(dir, CosignerTLSCACertFile), caCertPEM, 0600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, CosignerTLSCertFile), certPEM, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, CosignerTLSKeyFile), keyPEM, 0600)
+}
+
+func newCertificateSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func encodeCertificateAndKey(der []byte, key *ecdsa.PrivateKey) ([]byte, []byte, error) {
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
@@ -0,0 +1,108 @@
+package signer
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testTLSCosigners = CosignersConfig{
+	{ShardID: 1, P2PAddr: "tcp://127.0.0.1:2221"},
+	{ShardID: 2, P2PAddr: "tcp://127.0.0.1:2222"},
+	{ShardID: 3, P2PAddr: "tcp://127.0.0.1:2223"},
+}
+
+func newTestCosignerTLS(t *testing.T, caCert, caKey []byte, shardID int) *CosignerTLS {
+	cert, key, err := CreateCosignerTLSCert(caCert, caKey, shardID)
+	require.NoError(t, err)
+	cosignerTLS, err := NewCosignerTLS(caCert, cert, key, testTLSCosigners)
+	require.NoError(t, err)
+	return cosignerTLS
+}
+
+func testTLSHandshake(t *testing.T, client *tls.Config, server *tls.Config) (clientErr error, serverErr error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer conn.Close()
+		tlsConn := tls.Server(conn, server)
+		err = tlsConn.Handshake()
+		if err == nil {
+			// In TLS 1.3 the client certificate is verified after the client considers
+			// the handshake complete, so exchange a byte to surface a server side rejection.
+			_, err = tlsConn.Write([]byte{0})
+		}
+		errCh <- err
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tlsConn := tls.Client(conn, client)
+	clientErr = tlsConn.Handshake()
+	if clientErr == nil {
+		_, clientErr = tlsConn.Read(make([]byte, 1))
+	}
+	return clientErr, <-errCh
+}
+
+func TestCosignerTLSHandshake(t *testing.T) {
+	caCert, caKey, err := CreateCosignerTLSCA()
+	require.NoError(t, err)
+
+	cosigner1 := newTestCosignerTLS(t, caCert, caKey, 1)
+	cosigner2 := newTestCosignerTLS(t, caCert, caKey, 2)
+
+	clientErr, serverErr := testTLSHandshake(t, cosigner1.ClientConfig(2), cosigner2.ServerConfig())
+	require.NoError(t, clientErr)
+	require.NoError(t, serverErr)
+
+	clientErr, serverErr = testTLSHandshake(t, cosigner1.ClusterClientConfig(), cosigner2.ServerConfig())
+	require.NoError(t, clientErr)
+	require.NoError(t, serverErr)
+}
+
+func TestCosignerTLSWrongIdentity(t *testing.T) {
+	caCert, caKey, err := CreateCosignerTLSCA()
+	require.NoError(t, err)
+
+	cosigner1 := newTestCosignerTLS(t, caCert, caKey, 1)
+	cosigner2 := newTestCosignerTLS(t, caCert, caKey, 2)
+
+	// cosigner 1 expects to be talking to cosigner 3, but cosigner 2 answers.
+	clientErr, _ := testTLSHandshake(t, cosigner1.ClientConfig(3), cosigner2.ServerConfig())
+	require.ErrorContains(t, clientErr, "expected cosigner 3")
+
+	// a certificate for a shard ID outside of the cluster is rejected by the server.
+	outsider := newTestCosignerTLS(t, caCert, caKey, 4)
+	_, serverErr := testTLSHandshake(t, outsider.ClientConfig(2), cosigner2.ServerConfig())
+	require.ErrorContains(t, serverErr, "not in the cluster")
+}
+
+func TestCosignerTLSUnknownCA(t *testing.T) {
+	caCert, caKey, err := CreateCosignerTLSCA()
+	require.NoError(t, err)
+	otherCACert, otherCAKey, err := CreateCosignerTLSCA()
+	require.NoError(t, err)
+
+	cosigner1 := newTestCosignerTLS(t, caCert, caKey, 1)
+	cosigner2 := newTestCosignerTLS(t, caCert, caKey, 2)
+	impostor := newTestCosignerTLS(t, otherCACert, otherCAKey, 2)
+
+	clientErr, _ := testTLSHandshake(t, cosigner1.ClientConfig(2), impostor.ServerConfig())
+	require.ErrorContains(t, clientErr, "failed to verify peer certificate")
+
+	_, serverErr := testTLSHandshake(t, impostor.ClientConfig(2), cosigner2.ServerConfig())
+	require.Error(t, serverErr)
+}
@@ -0,0 +1,185 @@
+package signer
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+)
+
+// dkgPolynomial is a secret polynomial f(x) = a_0 + a_1*x + ... + a_{t-1}*x^{t-1}
+// over the Ed25519 scalar field, used by a dealer to share its secret a_0.
+type dkgPolynomial []*edwards25519.Scalar
+
+// newDKGPolynomial returns a random polynomial of degree threshold-1 with the given constant term.
+func newDKGPolynomial(secret *edwards25519.Scalar, threshold int) (dkgPolynomial, error) {
+	if threshold < 1 {
+		return nil, fmt.Errorf("threshold must be at least 1, got %d", threshold)
+	}
+	p := make(dkgPolynomial, threshold)
+	p[0] = edwards25519.NewScalar().Set(secret)
+	for i := 1; i < threshold; i++ {
+		c, err := randomScalar()
+		if err != nil {
+			return nil, err
+		}
+		p[i] = c
+	}
+	return p, nil
+}
+
+// evaluate returns f(x).
+func (p dkgPolynomial) evaluate(x int) *edwards25519.Scalar {
+	xs := scalarFromInt(x)
+	out := edwards25519.NewScalar()
+	for i := len(p) - 1; i >= 0; i-- {
+		out.MultiplyAdd(out, xs, p[i])
+	}
+	return out
+}
+
+// commitments returns the Feldman commitments a_k*B to each coefficient of the polynomial.
+func (p dkgPolynomial) commitments() []*edwards25519.Point {
+	out := make([]*edwards25519.Point, len(p))
+	for i, c := range p {
+		out[i] = new(edwards25519.Point).ScalarBaseMult(c)
+	}
+	return out
+}
+
+// evaluateCommitments returns f(x)*B, computed from the commitments to the coefficients of f.
+func evaluateCommitments(commitments []*edwards25519.Point, x int) *edwards25519.Point {
+	xs := scalarFromInt(x)
+	out := edwards25519.NewIdentityPoint()
+	for i := len(commitments) - 1; i >= 0; i-- {
+		out.ScalarMult(xs, out)
+		out.Add(out, commitments[i])
+	}
+	return out
+}
+
+// verifyDKGShare checks that share is the evaluation at x of the polynomial committed to.
+func verifyDKGShare(share *edwards25519.Scalar, x int, commitments []*edwards25519.Point) bool {
+	expected := evaluateCommitments(commitments, x)
+	return new(edwards25519.Point).ScalarBaseMult(share).Equal(expected) == 1
+}
+
+// proveDKGSecret creates a Schnorr proof of knowledge of the secret behind commitment,
+// bound to the session context and the dealer ID. This prevents a dealer from choosing
+// its commitment as a function of the others to bias the group key.
+func proveDKGSecret(
+	context []byte,
+	id int,
+	secret *edwards25519.Scalar,
+	commitment *edwards25519.Point,
+) (r []byte, s []byte, err error) {
+	k, err := randomScalar()
+	if err != nil {
+		return nil, nil, err
+	}
+	R := new(edwards25519.Point).ScalarBaseMult(k)
+	c := dkgChallenge(context, id, commitment, R)
+	mu := edwards25519.NewScalar().MultiplyAdd(c, secret, k)
+	return R.Bytes(), mu.Bytes(), nil
+}
+
+// verifyDKGSecretProof verifies a proof created by proveDKGSecret.
+func verifyDKGSecretProof(context []byte, id int, commitment *edwards25519.Point, r, s []byte) error {
+	R, err := new(edwards25519.Point).SetBytes(r)
+	if err != nil {
+		return fmt.Errorf("invalid proof commitment: %w", err)
+	}
+	mu, err := edwards25519.NewScalar().SetCanonicalBytes(s)
+	if err != nil {
+		return fmt.Errorf("invalid proof response: %w", err)
+	}
+	c := dkgChallenge(context, id, commitment, R)
+
+	// mu*B == R + c*commitment
+	lhs := new(edwards25519.Point).ScalarBaseMult(mu)
+	rhs := new(edwards25519.Point).ScalarMult(c, commitment)
+	rhs.Add(rhs, R)
+	if lhs.Equal(rhs) != 1 {
+		return errors.New("proof of knowledge of secret is invalid")
+	}
+	return nil
+}
+
+func dkgChallenge(context []byte, id int, commitment, R *edwards25519.Point) *edwards25519.Scalar {
+	h := sha512.New()
+	h.Write([]byte("horcrux-dkg-pok"))
+	h.Write(context)
+	_ = binary.Write(h, binary.BigEndian, int64(id))
+	h.Write(commitment.Bytes())
+	h.Write(R.Bytes())
+	c, _ := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	return c
+}
+
+// parseDKGCommitments decodes the commitments of a dealer.
+func parseDKGCommitments(encoded [][]byte) ([]*edwards25519.Point, error) {
+	out := make([]*edwards25519.Point, len(encoded))
+	for i, bz := range encoded {
+		p, err := new(edwards25519.Point).SetBytes(bz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment %d: %w", i, err)
+		}
+		out[i] = p
+	}
+	return out, nil
+}
+
+func encodeDKGCommitments(commitments []*edwards25519.Point) [][]byte {
+	out := make([][]byte, len(commitments))
+	for i, c := range commitments {
+		out[i] = c.Bytes()
+	}
+	return out
+}
+
+func isSmallOrder(p *edwards25519.Point) bool {
+	return new(edwards25519.Point).MultByCofactor(p).Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+// randomScalar returns a uniformly random scalar.
+func randomScalar() (*edwards25519.Scalar, error) {
+	var bz [64]byte
+	if _, err := rand.Read(bz[:]); err != nil {
+		return nil, err
+	}
+	return edwards25519.NewScalar().SetUniformBytes(bz[:])
+}
+
+// scalarFromShard reduces a little-endian key shard to a scalar.
+func scalarFromShard(shard []byte) (*edwards25519.Scalar, error) {
+	if len(shard) != 32 {
+		return nil, fmt.Errorf("invalid key shard length %d", len(shard))
+	}
+	var wide [64]byte
+	copy(wide[:], shard)
+	return edwards25519.NewScalar().SetUniformBytes(wide[:])
+}
+
+func scalarFromInt(x int) *edwards25519.Scalar {
+	var bz [32]byte
+	binary.LittleEndian.PutUint64(bz[:8], uint64(x))
+	s, _ := edwards25519.NewScalar().SetCanonicalBytes(bz[:])
+	return s
+}
+
+// lagrangeCoefficient returns the coefficient of the share at x when interpolating f(0) from the shares at xs.
+func lagrangeCoefficient(x int, xs []int) *edwards25519.Scalar {
+	num := scalarFromInt(1)
+	den := scalarFromInt(1)
+	for _, xj := range xs {
+		if xj == x {
+			continue
+		}
+		num.Multiply(num, scalarFromInt(xj))
+		den.Multiply(den, edwards25519.NewScalar().Subtract(scalarFromInt(xj), scalarFromInt(x)))
+	}
+	return num.Multiply(num, den.Invert(den))
+}
@@ -0,0 +1,219 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometservice "github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// FenceWatermark is the highest HRS a cluster was granted to sign on a chain.
+type FenceWatermark struct {
+	HRS       HRSKey `json:"hrs"`
+	ClusterID string `json:"clusterID"`
+}
+
+// FenceState is the state of the lock service, persisted to its state file after every change.
+type FenceState struct {
+	Holder          string                    `json:"holder"`
+	LeaseExpiration time.Time                 `json:"leaseExpiration"`
+	Watermarks      map[string]FenceWatermark `json:"watermarks"`
+}
+
+var _ proto.FenceServer = &FenceServer{}
+
+// FenceServer is a lock service shared by an active and a standby horcrux cluster. It grants a signing
+// lease to one cluster at a time, and only once the lease has expired may another cluster take it over.
+// The lease includes the watermark of every chain, so a cluster taking over can not sign at or below
+// an HRS signed by the previous holder.
+type FenceServer struct {
+	cometservice.BaseService
+
+	logger    cometlog.Logger
+	listen    string
+	stateFile string
+	tlsConfig *tls.Config
+
+	mu    sync.Mutex
+	state FenceState
+
+	server *grpc.Server
+
+	proto.UnimplementedFenceServer
+}
+
+// NewFenceServer returns a FenceServer which listens on listen and persists its state to stateFile.
+// Clients must use mutual TLS if tlsConfig is set.
+func NewFenceServer(logger cometlog.Logger, listen, stateFile string, tlsConfig *tls.Config) (*FenceServer, error) {
+	s := &FenceServer{
+		logger:    logger,
+		listen:    listen,
+		stateFile: stateFile,
+		tlsConfig: tlsConfig,
+		state:     FenceState{Watermarks: make(map[string]FenceWatermark)},
+	}
+
+	bz, err := os.ReadFile(stateFile)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(bz, &s.state); err != nil {
+			return nil, fmt.Errorf("failed to read fence state %s: %w", stateFile, err)
+		}
+		if s.state.Watermarks == nil {
+			s.state.Watermarks = make(map[string]FenceWatermark)
+		}
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	s.BaseService = *cometservice.NewBaseService(logger, "FenceServer", s)
+	return s, nil
+}
+
+func (s *FenceServer) OnStart() error {
+	sock, err := net.Listen("tcp", s.listen)
+	if err != nil {
+		return err
+	}
+
+	var opts []grpc.ServerOption
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+
+	s.logger.Info("Fence lock service listening", "address", sock.Addr())
+	s.server = grpc.NewServer(opts...)
+	proto.RegisterFenceServer(s.server, s)
+	go func() {
+		if err := s.server.Serve(sock); err != nil {
+			s.logger.Error("Fence lock service stopped serving", "error", err)
+		}
+	}()
+	return nil
+}
+
+func (s *FenceServer) OnStop() {
+	s.server.GracefulStop()
+}
+
+// grant grants clusterID the lease until now plus lease to sign hrs on chainID.
+// The lease is refused while another cluster holds it, and the HRS must be above the watermark,
+// unless the same cluster signs the same HRS again.
+func (s *FenceServer) grant(
+	clusterID, chainID string,
+	hrs HRSKey,
+	lease time.Duration,
+	now time.Time,
+) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Holder != "" && s.state.Holder != clusterID && now.Before(s.state.LeaseExpiration) {
+		return time.Time{}, fmt.Errorf("lease is held by cluster %s until %s",
+			s.state.Holder, s.state.LeaseExpiration.Format(time.RFC3339))
+	}
+
+	wm, ok := s.state.Watermarks[chainID]
+	if ok && !hrs.GreaterThan(wm.HRS) && (hrs != wm.HRS || wm.ClusterID != clusterID) {
+		return time.Time{}, fmt.Errorf("%s %d/%d/%d is not above the watermark %d/%d/%d signed by cluster %s",
+			chainID, hrs.Height, hrs.Round, hrs.Step, wm.HRS.Height, wm.HRS.Round, wm.HRS.Step, wm.ClusterID)
+	}
+
+	next := s.state
+	next.Holder = clusterID
+	next.LeaseExpiration = now.Add(lease)
+	next.Watermarks = make(map[string]FenceWatermark, len(s.state.Watermarks)+1)
+	for id, w := range s.state.Watermarks {
+		next.Watermarks[id] = w
+	}
+	next.Watermarks[chainID] = FenceWatermark{HRS: hrs, ClusterID: clusterID}
+
+	// the lease is only granted once it is persisted, so it survives a restart of the lock service.
+	bz, err := json.Marshal(next)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := tempfile.WriteFileAtomic(s.stateFile, bz, 0600); err != nil {
+		return time.Time{}, fmt.Errorf("failed to persist fence state: %w", err)
+	}
+
+	if s.state.Holder != clusterID {
+		s.logger.Info("Signing lease taken over", "cluster_id", clusterID, "previous", s.state.Holder)
+	}
+	s.state = next
+
+	return next.LeaseExpiration, nil
+}
+
+// Fence grants the signing lease to the requesting cluster. Refusals are returned as FailedPrecondition.
+func (s *FenceServer) Fence(_ context.Context, req *proto.FenceRequest) (*proto.FenceResponse, error) {
+	if req.ClusterID == "" || req.ChainID == "" {
+		return nil, status.Error(codes.InvalidArgument, "clusterID and chainID must not be empty")
+	}
+	if req.LeaseDuration <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "leaseDuration must be positive")
+	}
+
+	hrs := HRSKey{Height: req.Height, Round: req.Round, Step: int8(req.Step)}
+	expiration, err := s.grant(req.ClusterID, req.ChainID, hrs, time.Duration(req.LeaseDuration), time.Now())
+	if err != nil {
+		s.logger.Info("Refused signing lease", "cluster_id", req.ClusterID, "chain_id", req.ChainID, "reason", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &proto.FenceResponse{LeaseExpiration: expiration.UnixNano()}, nil
+}
+
+// State returns a copy of the current state of the lock service.
+func (s *FenceServer) State() FenceState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.state
+	state.Watermarks = make(map[string]FenceWatermark, len(s.state.Watermarks))
+	for id, w := range s.state.Watermarks {
+		state.Watermarks[id] = w
+	}
+	return state
+}
+
+func (s *FenceServer) FenceStatus(
+	_ context.Context,
+	_ *proto.FenceStatusRequest,
+) (*proto.FenceStatusResponse, error) {
+	return fenceStateToProto(s.State()), nil
+}
+
+// fenceStateToProto returns the proto representation of state, with watermarks sorted by chain ID.
+func fenceStateToProto(state FenceState) *proto.FenceStatusResponse {
+	res := &proto.FenceStatusResponse{
+		Holder:          state.Holder,
+		LeaseExpiration: state.LeaseExpiration.UnixNano(),
+	}
+	for chainID, wm := range state.Watermarks {
+		res.Watermarks = append(res.Watermarks, &proto.FenceWatermark{
+			ChainID:   chainID,
+			Height:    wm.HRS.Height,
+			Round:     wm.HRS.Round,
+			Step:      int32(wm.HRS.Step),
+			ClusterID: wm.ClusterID,
+		})
+	}
+	sort.Slice(res.Watermarks, func(i, j int) bool {
+		return res.Watermarks[i].ChainID < res.Watermarks[j].ChainID
+	})
+	return res
+}
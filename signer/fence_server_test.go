@@ -0,0 +1,59 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFenceServerLease(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "fence_state.json")
+
+	s, err := NewFenceServer(cometlog.NewNopLogger(), "", stateFile, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	lease := 30 * time.Second
+	hrs := func(h int64, step int8) HRSKey { return HRSKey{Height: h, Step: step} }
+
+	expiration, err := s.grant("active", testChainID, hrs(10, stepPrevote), lease, now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(lease), expiration)
+
+	// the active cluster may sign the same HRS again, and above it.
+	_, err = s.grant("active", testChainID, hrs(10, stepPrevote), lease, now)
+	require.NoError(t, err)
+	_, err = s.grant("active", testChainID, hrs(10, stepPrecommit), lease, now.Add(time.Second))
+	require.NoError(t, err)
+
+	// but not below it.
+	_, err = s.grant("active", testChainID, hrs(10, stepPrevote), lease, now.Add(time.Second))
+	require.ErrorContains(t, err, "is not above the watermark")
+
+	// the standby cluster can not take over while the lease is held.
+	_, err = s.grant("standby", testChainID, hrs(11, stepPrevote), lease, now.Add(10*time.Second))
+	require.ErrorContains(t, err, "lease is held by cluster active")
+
+	// the lease survives a restart of the lock service.
+	s, err = NewFenceServer(cometlog.NewNopLogger(), "", stateFile, nil)
+	require.NoError(t, err)
+	require.Equal(t, "active", s.State().Holder)
+
+	// once expired, the standby cluster may take over, but only above the watermark of the active cluster.
+	expired := now.Add(time.Minute)
+	_, err = s.grant("standby", testChainID, hrs(10, stepPrecommit), lease, expired)
+	require.ErrorContains(t, err, "signed by cluster active")
+
+	_, err = s.grant("standby", testChainID, hrs(11, stepPrevote), lease, expired)
+	require.NoError(t, err)
+
+	_, err = s.grant("active", testChainID, hrs(12, stepPrevote), lease, expired)
+	require.ErrorContains(t, err, "lease is held by cluster standby")
+
+	state := s.State()
+	require.Equal(t, "standby", state.Holder)
+	require.Equal(t, FenceWatermark{HRS: hrs(11, stepPrevote), ClusterID: "standby"}, state.Watermarks[testChainID])
+}
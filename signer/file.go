@@ -41,7 +41,7 @@ func (pvKey FilePVKey) Save() {
 		panic(err)
 	}
 
-	if err := tempfile.WriteFileAtomic(outFile, jsonBytes, 0600); err != nil {
+	if err := writeKeyFile(outFile, jsonBytes); err != nil {
 		panic(err)
 	}
 }
@@ -152,7 +152,7 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
 func LoadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, error) {
-	keyJSONBytes, err := os.ReadFile(keyFilePath)
+	keyJSONBytes, err := readKeyFile(keyFilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +164,9 @@ func LoadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, err
 
 	// overwrite pubkey and address for convenience
 	pvKey.PubKey = pvKey.PrivKey.PubKey()
+	if err := ValidateConsensusKey(pvKey.PubKey); err != nil {
+		return nil, fmt.Errorf("error reading PrivValidator key from %s: %w", keyFilePath, err)
+	}
 	pvKey.Address = pvKey.PubKey.Address()
 	pvKey.filePath = keyFilePath
 
@@ -211,7 +214,8 @@ func (pv *FilePV) Sign(chainID string, block Block) ([]byte, []byte, time.Time,
 		return nil, nil, block.Timestamp, err
 	}
 
-	_, hasVoteExtensions, err := verifySignPayload(chainID, signBytes, voteExtensionSignBytes)
+	// the protocol of the chain is checked by SingleSignerValidator, which knows the chain config.
+	_, hasVoteExtensions, err := verifySignPayload(chainID, signBytes, voteExtensionSignBytes, PrivValProtocolCometBFT038)
 	if err != nil {
 		return nil, nil, block.Timestamp, err
 	}
@@ -0,0 +1,221 @@
+package signer
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"sort"
+
+	"filippo.io/edwards25519"
+)
+
+// frostContextString is the context string of the FROST(Ed25519, SHA-512) ciphersuite of RFC 9591.
+const frostContextString = "FROST-ED25519-SHA512-v1"
+
+// frostOrderMinusOne is L-1, where L is the order of the prime order subgroup.
+var frostOrderMinusOne = edwards25519.NewScalar().Negate(scalarFromInt(1))
+
+// frostHash is SHA-512 over the context string, a domain separation tag and msgs,
+// which are the H1, H3, H4 and H5 hash functions of the ciphersuite.
+func frostHash(tag string, msgs ...[]byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(frostContextString))
+	h.Write([]byte(tag))
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+func frostHashToScalar(tag string, msgs ...[]byte) *edwards25519.Scalar {
+	s, _ := edwards25519.NewScalar().SetUniformBytes(frostHash(tag, msgs...))
+	return s
+}
+
+// frostElement decodes a commitment, which must be in the prime order subgroup and not the identity.
+func frostElement(bz []byte) (*edwards25519.Point, error) {
+	p, err := new(edwards25519.Point).SetBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	if p.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, errors.New("commitment is the identity element")
+	}
+	// L*P is the identity for points in the prime order subgroup.
+	lp := new(edwards25519.Point).ScalarMult(frostOrderMinusOne, p)
+	if lp.Add(lp, p).Equal(edwards25519.NewIdentityPoint()) != 1 {
+		return nil, errors.New("commitment is not in the prime order subgroup")
+	}
+	return p, nil
+}
+
+// FROSTNonces are the secret hiding and binding nonces of a cosigner for a single FROST signature.
+// They must never be used for more than one signature.
+type FROSTNonces struct {
+	Hiding  *edwards25519.Scalar
+	Binding *edwards25519.Scalar
+}
+
+// NewFROSTNonces generates a pair of nonces. Preprocessed nonces are not tied to a chain, so unlike
+// the nonce generation of RFC 9591 they are derived from randomness only, not from a key shard.
+func NewFROSTNonces() (*FROSTNonces, error) {
+	var seed [64]byte
+	if _, err := rand.Read(seed[:]); err != nil {
+		return nil, err
+	}
+	return &FROSTNonces{
+		Hiding:  frostHashToScalar("nonce", seed[:32]),
+		Binding: frostHashToScalar("nonce", seed[32:]),
+	}, nil
+}
+
+// Commitments returns the public commitments to the hiding and binding nonces.
+func (n *FROSTNonces) Commitments() (hiding, binding []byte) {
+	return new(edwards25519.Point).ScalarBaseMult(n.Hiding).Bytes(),
+		new(edwards25519.Point).ScalarBaseMult(n.Binding).Bytes()
+}
+
+type frostCommitment struct {
+	hiding  *edwards25519.Point
+	binding *edwards25519.Point
+}
+
+// frostSigningPackage is what every signer of a FROST signature derives from the commitments of
+// all signers and the message: the binding factor of each signer, the group commitment R and the
+// challenge, which is the challenge of Ed25519 so that the signature is a standard Ed25519 signature.
+type frostSigningPackage struct {
+	pubKey          []byte
+	ids             []int
+	commitments     map[int]frostCommitment
+	bindingFactors  map[int]*edwards25519.Scalar
+	groupCommitment *edwards25519.Point
+	challenge       *edwards25519.Scalar
+}
+
+func newFROSTSigningPackage(pubKey []byte, commitments FROSTCommitments, msg []byte) (*frostSigningPackage, error) {
+	if len(commitments) == 0 {
+		return nil, errors.New("no FROST commitments")
+	}
+
+	sorted := make(FROSTCommitments, len(commitments))
+	copy(sorted, commitments)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].CosignerID < sorted[j].CosignerID })
+
+	p := &frostSigningPackage{
+		pubKey:         pubKey,
+		ids:            make([]int, 0, len(sorted)),
+		commitments:    make(map[int]frostCommitment, len(sorted)),
+		bindingFactors: make(map[int]*edwards25519.Scalar, len(sorted)),
+	}
+
+	// the encoded commitment list is the identifier, hiding and binding commitment of each signer.
+	var encoded []byte
+	for _, c := range sorted {
+		if c.CosignerID < 1 {
+			return nil, fmt.Errorf("invalid FROST signer %d", c.CosignerID)
+		}
+		if _, ok := p.commitments[c.CosignerID]; ok {
+			return nil, fmt.Errorf("cosigner %d is listed more than once in the FROST commitments", c.CosignerID)
+		}
+		commitment, err := c.decode()
+		if err != nil {
+			return nil, err
+		}
+		p.ids = append(p.ids, c.CosignerID)
+		p.commitments[c.CosignerID] = commitment
+		encoded = append(encoded, scalarFromInt(c.CosignerID).Bytes()...)
+		encoded = append(encoded, commitment.hiding.Bytes()...)
+		encoded = append(encoded, commitment.binding.Bytes()...)
+	}
+
+	msgHash := frostHash("msg", msg)
+	commitmentHash := frostHash("com", encoded)
+
+	p.groupCommitment = edwards25519.NewIdentityPoint()
+	for _, id := range p.ids {
+		rho := frostHashToScalar("rho", pubKey, msgHash, commitmentHash, scalarFromInt(id).Bytes())
+		p.bindingFactors[id] = rho
+
+		c := p.commitments[id]
+		p.groupCommitment.Add(p.groupCommitment, c.hiding)
+		p.groupCommitment.Add(p.groupCommitment, new(edwards25519.Point).ScalarMult(rho, c.binding))
+	}
+
+	h := sha512.New()
+	h.Write(p.groupCommitment.Bytes())
+	h.Write(pubKey)
+	h.Write(msg)
+	challenge, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	p.challenge = challenge
+
+	return p, nil
+}
+
+// signShare returns the signature share of signer id, z = d + e*rho + lambda*s*c.
+func (p *frostSigningPackage) signShare(id int, secret *edwards25519.Scalar, nonces *FROSTNonces) ([]byte, error) {
+	c, ok := p.commitments[id]
+	if !ok {
+		return nil, fmt.Errorf("cosigner %d is not a signer of the FROST signature", id)
+	}
+	hiding := new(edwards25519.Point).ScalarBaseMult(nonces.Hiding)
+	binding := new(edwards25519.Point).ScalarBaseMult(nonces.Binding)
+	if c.hiding.Equal(hiding) != 1 || c.binding.Equal(binding) != 1 {
+		return nil, fmt.Errorf("FROST commitment of cosigner %d does not match its nonces", id)
+	}
+
+	z := edwards25519.NewScalar().Multiply(lagrangeCoefficient(id, p.ids), secret)
+	z.Multiply(z, p.challenge)
+	z.Add(z, edwards25519.NewScalar().Multiply(nonces.Binding, p.bindingFactors[id]))
+	z.Add(z, nonces.Hiding)
+	return z.Bytes(), nil
+}
+
+// verifyShare checks the signature share of signer id, z*B == D + rho*E + c*lambda*A, where A is the
+// public key share of id. A PartialSignatureError is returned if the signature share is invalid.
+func (p *frostSigningPackage) verifyShare(id int, pubShares [][]byte, share []byte) error {
+	c, ok := p.commitments[id]
+	if !ok {
+		return fmt.Errorf("cosigner %d is not a signer of the FROST signature", id)
+	}
+	if id > len(pubShares) || len(pubShares[id-1]) == 0 {
+		return fmt.Errorf("%w: no public key share for cosigner %d", errPartialSignatureUnverifiable, id)
+	}
+	pubShare, err := new(edwards25519.Point).SetBytes(pubShares[id-1])
+	if err != nil {
+		return fmt.Errorf("invalid public key share of cosigner %d: %w", id, err)
+	}
+	z, err := edwards25519.NewScalar().SetCanonicalBytes(share)
+	if err != nil {
+		return &PartialSignatureError{ID: id, Reason: partialSignatureReasonShare}
+	}
+
+	k := edwards25519.NewScalar().Multiply(p.challenge, lagrangeCoefficient(id, p.ids))
+	rhs := new(edwards25519.Point).ScalarMult(k, pubShare)
+	rhs.Add(rhs, new(edwards25519.Point).ScalarMult(p.bindingFactors[id], c.binding))
+	rhs.Add(rhs, c.hiding)
+	if new(edwards25519.Point).ScalarBaseMult(z).Equal(rhs) != 1 {
+		return &PartialSignatureError{ID: id, Reason: partialSignatureReasonShare}
+	}
+	return nil
+}
+
+// aggregate sums the signature shares of all signers to the Ed25519 signature R || z.
+func (p *frostSigningPackage) aggregate(shares map[int][]byte) ([]byte, error) {
+	z := edwards25519.NewScalar()
+	for _, id := range p.ids {
+		share, ok := shares[id]
+		if !ok {
+			return nil, fmt.Errorf("missing FROST signature share of cosigner %d", id)
+		}
+		s, err := edwards25519.NewScalar().SetCanonicalBytes(share)
+		if err != nil {
+			return nil, fmt.Errorf("invalid FROST signature share of cosigner %d: %w", id, err)
+		}
+		z.Add(z, s)
+	}
+	return append(p.groupCommitment.Bytes(), z.Bytes()...), nil
+}
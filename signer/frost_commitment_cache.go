@@ -0,0 +1,156 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+)
+
+const (
+	// frostCommitmentBatch is the number of commitments the leader requests from a cosigner at once.
+	frostCommitmentBatch = 32
+
+	// frostCommitmentExpiration is half of the FROST nonce expiration of the cosigners, so that sign
+	// requests from the leader never reference nonces which a cosigner has pruned.
+	frostCommitmentExpiration = frostNonceExpiration / 2
+)
+
+// FROSTCommitmentCache holds the commitments which each cosigner preprocessed for FROST signatures,
+// so that the leader only needs the round trip for the signature shares for each block.
+type FROSTCommitmentCache struct {
+	logger      cometlog.Logger
+	grpcTimeout time.Duration
+
+	mu          sync.Mutex
+	commitments map[int][]cachedFROSTCommitment
+	refilling   map[int]bool
+}
+
+type cachedFROSTCommitment struct {
+	commitment FROSTCommitment
+	expiration time.Time
+}
+
+func NewFROSTCommitmentCache(logger cometlog.Logger, grpcTimeout time.Duration) *FROSTCommitmentCache {
+	return &FROSTCommitmentCache{
+		logger:      logger,
+		grpcTimeout: grpcTimeout,
+		commitments: make(map[int][]cachedFROSTCommitment),
+		refilling:   make(map[int]bool),
+	}
+}
+
+// Take returns an unused commitment of participant. Commitments are requested in batches, and a batch is
+// refilled in the background when it runs low, so Take only waits for participant when none are cached.
+func (c *FROSTCommitmentCache) Take(ctx context.Context, participant FROSTParticipant) (FROSTCommitment, error) {
+	id := participant.GetID()
+
+	c.mu.Lock()
+	commitment, ok := c.pop(id)
+	refill := ok && len(c.commitments[id]) < frostCommitmentBatch/2 && !c.refilling[id]
+	if refill {
+		c.refilling[id] = true
+	}
+	c.mu.Unlock()
+
+	if ok {
+		if refill {
+			go c.refill(participant)
+		}
+		return commitment, nil
+	}
+
+	commitments, err := c.commit(ctx, participant)
+	if err != nil {
+		return FROSTCommitment{}, err
+	}
+
+	c.mu.Lock()
+	c.add(id, commitments[1:])
+	c.mu.Unlock()
+
+	return commitments[0], nil
+}
+
+// Clear drops the commitments of cosigner id, e.g. after it restarted and lost the nonces.
+func (c *FROSTCommitmentCache) Clear(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.commitments, id)
+}
+
+// Size returns the number of cached commitments of cosigner id.
+func (c *FROSTCommitmentCache) Size(id int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.commitments[id])
+}
+
+// pop removes the oldest unexpired commitment of cosigner id. c.mu must be held.
+func (c *FROSTCommitmentCache) pop(id int) (FROSTCommitment, bool) {
+	now := time.Now()
+	cached := c.commitments[id]
+	for len(cached) > 0 {
+		next := cached[0]
+		cached = cached[1:]
+		if now.Before(next.expiration) {
+			c.commitments[id] = cached
+			return next.commitment, true
+		}
+	}
+	delete(c.commitments, id)
+	return FROSTCommitment{}, false
+}
+
+// add caches commitments of cosigner id. c.mu must be held.
+func (c *FROSTCommitmentCache) add(id int, commitments FROSTCommitments) {
+	expiration := time.Now().Add(frostCommitmentExpiration)
+	for _, commitment := range commitments {
+		c.commitments[id] = append(c.commitments[id], cachedFROSTCommitment{
+			commitment: commitment,
+			expiration: expiration,
+		})
+	}
+}
+
+// commit requests a batch of commitments from participant, and checks that they are valid commitments of it.
+func (c *FROSTCommitmentCache) commit(ctx context.Context, participant FROSTParticipant) (FROSTCommitments, error) {
+	id := participant.GetID()
+	commitments, err := participant.FROSTCommit(ctx, frostCommitmentBatch)
+	if err != nil {
+		return nil, err
+	}
+	if len(commitments) == 0 {
+		return nil, fmt.Errorf("cosigner %d returned no FROST commitments", id)
+	}
+	for _, commitment := range commitments {
+		if commitment.CosignerID != id {
+			return nil, fmt.Errorf("cosigner %d returned a FROST commitment of cosigner %d", id, commitment.CosignerID)
+		}
+		if _, err := commitment.decode(); err != nil {
+			return nil, err
+		}
+	}
+	return commitments, nil
+}
+
+func (c *FROSTCommitmentCache) refill(participant FROSTParticipant) {
+	id := participant.GetID()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.grpcTimeout)
+	defer cancel()
+
+	commitments, err := c.commit(ctx, participant)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refilling[id] = false
+	if err != nil {
+		c.logger.Error("Failed to preprocess FROST commitments", "cosigner", id, "err", err)
+		return
+	}
+	c.add(id, commitments)
+}
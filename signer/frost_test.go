@@ -0,0 +1,228 @@
+package signer
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSigningProtocolValidator returns a ThresholdValidator led by cosigners[0], which signs testChainID
+// with protocol, and has all other cosigners as peers. The sign states of all cosigners are flushed on cleanup.
+func newTestSigningProtocolValidator(
+	t testing.TB,
+	cosigners []*LocalCosigner,
+	peers []Cosigner,
+	threshold uint8,
+	protocol SigningProtocol,
+) *ThresholdValidator {
+	config := cosigners[0].config
+	config.Config.Chains = ChainsConfig{{ChainID: testChainID, SigningProtocol: protocol}}
+
+	leader := &MockLeader{id: 1}
+	validator := NewThresholdValidator(
+		cometlog.NewNopLogger(),
+		config,
+		int(threshold),
+		time.Second,
+		1,
+		cosigners[0],
+		peers,
+		leader,
+	)
+	t.Cleanup(validator.Stop)
+	// the sign states are saved in the background, and must be written before the test removes its directory.
+	for _, cosigner := range cosigners {
+		t.Cleanup(cosigner.waitForSignStatesToFlushToDisk)
+	}
+
+	leader.leader = validator
+
+	require.NoError(t, validator.LoadSignStateIfNecessary(testChainID))
+	return validator
+}
+
+func TestThresholdValidatorFROST(t *testing.T) {
+	for _, tc := range []struct {
+		threshold, total uint8
+	}{
+		{2, 3},
+		{3, 5},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%dof%d", tc.threshold, tc.total), func(t *testing.T) {
+			cosigners, pubKey := getTestLocalCosigners(t, tc.threshold, tc.total)
+			peers := make([]Cosigner, 0, len(cosigners)-1)
+			for _, cosigner := range cosigners[1:] {
+				peers = append(peers, cosigner)
+			}
+			validator := newTestSigningProtocolValidator(t, cosigners, peers, tc.threshold, SigningProtocolFROST)
+
+			ctx := context.Background()
+
+			block := ProposalToBlock(testChainID, &cometproto.Proposal{
+				Height: 1,
+				Round:  0,
+				Type:   cometproto.ProposalType,
+			})
+			signature, _, _, err := validator.Sign(ctx, testChainID, block)
+			require.NoError(t, err)
+			require.True(t, pubKey.VerifySignature(block.SignBytes, signature))
+
+			blockIDHash := sha256.Sum256([]byte("something"))
+			block = VoteToBlock(testChainID, &cometproto.Vote{
+				Height:    2,
+				Round:     0,
+				BlockID:   cometproto.BlockID{Hash: blockIDHash[:]},
+				Type:      cometproto.PrecommitType,
+				Timestamp: time.Now(),
+				Extension: []byte("test"),
+			})
+			signature, voteExtSig, _, err := validator.Sign(ctx, testChainID, block)
+			require.NoError(t, err)
+			require.True(t, pubKey.VerifySignature(block.SignBytes, signature))
+			require.True(t, pubKey.VerifySignature(block.VoteExtensionSignBytes, voteExtSig))
+
+			// the rest of the first batch of commitments of this cosigner is cached for later blocks.
+			require.NotZero(t, validator.frostCommitments.Size(cosigners[0].GetID()))
+		})
+	}
+}
+
+func TestLocalCosignerFROSTNoncesAreSingleUse(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+	ctx := context.Background()
+
+	var commitments FROSTCommitments
+	for _, cosigner := range cosigners[:2] {
+		require.NoError(t, cosigner.LoadSignStateIfNecessary(testChainID))
+		c, err := cosigner.FROSTCommit(ctx, 1)
+		require.NoError(t, err)
+		commitments = append(commitments, c...)
+	}
+
+	block := ProposalToBlock(testChainID, &cometproto.Proposal{
+		Height: 1,
+		Round:  0,
+		Type:   cometproto.ProposalType,
+	})
+	req := FROSTSignRequest{
+		ChainID:     testChainID,
+		HRST:        block.HRSTKey(),
+		SignBytes:   block.SignBytes,
+		Commitments: commitments,
+	}
+
+	shares := make(map[int][]byte)
+	for _, cosigner := range cosigners[:2] {
+		res, err := cosigner.FROSTSign(ctx, req)
+		require.NoError(t, err)
+		shares[cosigner.GetID()] = res.Signature
+
+		// the nonces of a commitment are gone once they signed.
+		_, err = cosigner.FROSTSign(ctx, req)
+		require.ErrorContains(t, err, errUnknownFROSTNonce)
+	}
+
+	// a cosigner without a commitment in the request can not sign.
+	_, err := cosigners[2].FROSTSign(ctx, req)
+	require.ErrorContains(t, err, "no FROST commitment of cosigner 3")
+
+	p, pubShares, err := cosigners[0].frostSigningPackage(testChainID, commitments, block.SignBytes)
+	require.NoError(t, err)
+	for id, share := range shares {
+		require.NoError(t, p.verifyShare(id, pubShares, share))
+	}
+	signature, err := p.aggregate(shares)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(block.SignBytes, signature))
+
+	_, err = cosigners[0].FROSTCommit(ctx, frostMaxCommitBatch+1)
+	require.Error(t, err)
+}
+
+// faultyFROSTCosigner corrupts its FROST signature shares.
+type faultyFROSTCosigner struct {
+	*LocalCosigner
+}
+
+func (c *faultyFROSTCosigner) FROSTSign(ctx context.Context, req FROSTSignRequest) (*CosignerSignResponse, error) {
+	res, err := c.LocalCosigner.FROSTSign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.Signature[0] ^= 1
+	return res, nil
+}
+
+func TestThresholdValidatorFROSTBlamesFaultyCosigner(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+
+	faulty := &faultyFROSTCosigner{LocalCosigner: cosigners[1]}
+	validator := newTestSigningProtocolValidator(
+		t, cosigners, []Cosigner{faulty, cosigners[2]}, 2, SigningProtocolFROST,
+	)
+
+	// the faulty cosigner is the fastest, so it is picked first.
+	validator.cosignerHealth.rtt = map[int]int64{2: 100, 3: 200}
+
+	block := ProposalToBlock(testChainID, &cometproto.Proposal{
+		Height: 1,
+		Round:  0,
+		Type:   cometproto.ProposalType,
+	})
+
+	// the invalid signature share is excluded, and the block is signed again with cosigner 3.
+	signature, _, _, err := validator.Sign(context.Background(), testChainID, block)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(block.SignBytes, signature))
+
+	require.True(t, validator.cosignerHealth.IsFaulty(2))
+	require.False(t, validator.cosignerHealth.IsFaulty(3))
+}
+
+// BenchmarkThresholdValidatorSign compares the signing protocols. Each signature includes
+// the nonce dealing of tsed25519, or the preprocessing of the commitments of FROST.
+func BenchmarkThresholdValidatorSign(b *testing.B) {
+	for _, protocol := range []SigningProtocol{SigningProtocolTSED25519, SigningProtocolFROST} {
+		for _, tc := range []struct {
+			threshold, total uint8
+		}{
+			{2, 3},
+			{3, 5},
+			{5, 7},
+		} {
+			protocol, tc := protocol, tc
+			b.Run(fmt.Sprintf("%s/%dof%d", protocol, tc.threshold, tc.total), func(b *testing.B) {
+				cosigners, _ := getTestLocalCosigners(b, tc.threshold, tc.total)
+				peers := make([]Cosigner, 0, len(cosigners)-1)
+				for _, cosigner := range cosigners[1:] {
+					peers = append(peers, cosigner)
+				}
+				validator := newTestSigningProtocolValidator(b, cosigners, peers, tc.threshold, protocol)
+
+				ctx := context.Background()
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if protocol == SigningProtocolTSED25519 {
+						validator.nonceCache.LoadN(ctx, 1)
+					}
+					block := ProposalToBlock(testChainID, &cometproto.Proposal{
+						Height: int64(i + 1),
+						Round:  0,
+						Type:   cometproto.ProposalType,
+					})
+					if _, _, _, err := validator.Sign(ctx, testChainID, block); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -1,15 +1,18 @@
 package signer
 
 import (
+	"encoding/binary"
+	"fmt"
 	"io"
 
 	"github.com/cometbft/cometbft/libs/protoio"
 	cometprotoprivval "github.com/cometbft/cometbft/proto/tendermint/privval"
 )
 
+const maxRemoteSignerMsgSize = 1024 * 10
+
 // ReadMsg reads a message from an io.Reader
 func ReadMsg(reader io.Reader) (msg cometprotoprivval.Message, err error) {
-	const maxRemoteSignerMsgSize = 1024 * 10
 	protoReader := protoio.NewDelimitedReader(reader, maxRemoteSignerMsgSize)
 	_, err = protoReader.ReadMsg(&msg)
 	return msg, err
@@ -21,3 +24,39 @@ func WriteMsg(writer io.Writer, msg cometprotoprivval.Message) (err error) {
 	_, err = protoWriter.WriteMsg(&msg)
 	return err
 }
+
+// readMsgBytes reads the bytes of a length delimited message from an io.Reader, without decoding them,
+// so they can be decoded as a message of either version of the privval protocol.
+func readMsgBytes(reader io.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(byteReader{reader})
+	if err != nil {
+		return nil, err
+	}
+	if length > maxRemoteSignerMsgSize {
+		return nil, fmt.Errorf("message of %d bytes exceeds the maximum size of %d bytes", length, maxRemoteSignerMsgSize)
+	}
+	bz := make([]byte, length)
+	if _, err := io.ReadFull(reader, bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// writeMsgBytes writes the bytes of an encoded message to an io.Writer, length delimited.
+func writeMsgBytes(writer io.Writer, bz []byte) error {
+	buf := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(bz)), uint64(len(bz)))
+	_, err := writer.Write(append(buf, bz...))
+	return err
+}
+
+// byteReader reads single bytes from an io.Reader, which is not buffered
+// so no bytes of the following message are consumed.
+type byteReader struct {
+	io.Reader
+}
+
+func (r byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r.Reader, b[:])
+	return b[0], err
+}
@@ -0,0 +1,356 @@
+package signer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keyEnvelopeVersion = 1
+
+	// scrypt parameters for deriving a key encryption key from a passphrase.
+	passphraseScryptN = 1 << 15
+	passphraseScryptR = 8
+	passphraseScryptP = 1
+
+	keySize  = 32
+	saltSize = 16
+)
+
+// KeyUnlockProvider wraps and unwraps the data keys of encrypted key files.
+// The data key of each file encrypts the key material, and only the wrapped data key is
+// stored with it, so a provider can be backed by a passphrase, a local key, or a KMS.
+type KeyUnlockProvider interface {
+	// Name identifies the provider in the key files it encrypts.
+	Name() string
+
+	// WrapKey encrypts a data key.
+	WrapKey(dataKey []byte) ([]byte, error)
+
+	// UnwrapKey decrypts a data key encrypted by WrapKey.
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+var (
+	keyUnlockProvider   KeyUnlockProvider
+	keyUnlockProviderMu sync.RWMutex
+)
+
+// SetKeyUnlockProvider sets the provider used to read encrypted key files, and to encrypt key files
+// written by horcrux, e.g. shards created by distributed key generation. nil disables encryption.
+func SetKeyUnlockProvider(provider KeyUnlockProvider) {
+	keyUnlockProviderMu.Lock()
+	defer keyUnlockProviderMu.Unlock()
+	keyUnlockProvider = provider
+}
+
+func getKeyUnlockProvider() KeyUnlockProvider {
+	keyUnlockProviderMu.RLock()
+	defer keyUnlockProviderMu.RUnlock()
+	return keyUnlockProvider
+}
+
+// KeyEnvelope is the on disk format of an encrypted key file.
+type KeyEnvelope struct {
+	Version    int    `json:"horcruxKeyEnvelope"`
+	Provider   string `json:"provider"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// parseKeyEnvelope returns the envelope of an encrypted key file, or nil if the file is not encrypted.
+func parseKeyEnvelope(bz []byte) *KeyEnvelope {
+	var envelope KeyEnvelope
+	if err := json.Unmarshal(bz, &envelope); err != nil || envelope.Version == 0 {
+		return nil
+	}
+	return &envelope
+}
+
+// IsEncryptedKeyFile returns whether file is an encrypted key file.
+func IsEncryptedKeyFile(file string) (bool, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return false, err
+	}
+	return parseKeyEnvelope(bz) != nil, nil
+}
+
+// EncryptKey seals plaintext key material in an envelope using provider.
+func EncryptKey(provider KeyUnlockProvider, plaintext []byte) ([]byte, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := provider.WrapKey(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap key with %s provider: %w", provider.Name(), err)
+	}
+
+	nonce, ciphertext, err := aeadSeal(dataKey, plaintext, []byte(provider.Name()))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(KeyEnvelope{
+		Version:    keyEnvelopeVersion,
+		Provider:   provider.Name(),
+		WrappedKey: wrappedKey,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	})
+}
+
+// DecryptKey opens an envelope created by EncryptKey using provider.
+func DecryptKey(provider KeyUnlockProvider, bz []byte) ([]byte, error) {
+	envelope := parseKeyEnvelope(bz)
+	if envelope == nil {
+		return nil, errors.New("not an encrypted key")
+	}
+	if envelope.Version != keyEnvelopeVersion {
+		return nil, fmt.Errorf("unsupported key envelope version %d", envelope.Version)
+	}
+	if envelope.Provider != provider.Name() {
+		return nil, fmt.Errorf("key is encrypted with the %s unlock provider, not %s", envelope.Provider, provider.Name())
+	}
+
+	dataKey, err := provider.UnwrapKey(envelope.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap key with %s provider: %w", provider.Name(), err)
+	}
+
+	return aeadOpen(dataKey, envelope.Nonce, envelope.Ciphertext, []byte(envelope.Provider))
+}
+
+// readKeyFile reads key material from file, decrypting it with the configured provider if necessary.
+func readKeyFile(file string) ([]byte, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if parseKeyEnvelope(bz) == nil {
+		return bz, nil
+	}
+
+	provider := getKeyUnlockProvider()
+	if provider == nil {
+		return nil, fmt.Errorf("key file %s is encrypted, but no unlock provider is configured", file)
+	}
+
+	plaintext, err := DecryptKey(provider, bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt key file %s: %w", file, err)
+	}
+	return plaintext, nil
+}
+
+// writeKeyFile writes key material to file, encrypted if an unlock provider is configured.
+func writeKeyFile(file string, plaintext []byte) error {
+	bz := plaintext
+	if provider := getKeyUnlockProvider(); provider != nil {
+		var err error
+		if bz, err = EncryptKey(provider, plaintext); err != nil {
+			return err
+		}
+	}
+	return tempfile.WriteFileAtomic(file, bz, 0600)
+}
+
+// EncryptKeyFile encrypts a plaintext key file in place.
+func EncryptKeyFile(file string, provider KeyUnlockProvider) error {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if parseKeyEnvelope(bz) != nil {
+		return fmt.Errorf("key file %s is already encrypted", file)
+	}
+	encrypted, err := EncryptKey(provider, bz)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(file, encrypted, 0600)
+}
+
+// DecryptKeyFile decrypts an encrypted key file in place.
+func DecryptKeyFile(file string, provider KeyUnlockProvider) error {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if parseKeyEnvelope(bz) == nil {
+		return fmt.Errorf("key file %s is not encrypted", file)
+	}
+	plaintext, err := DecryptKey(provider, bz)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt key file %s: %w", file, err)
+	}
+	return tempfile.WriteFileAtomic(file, plaintext, 0600)
+}
+
+func aeadSeal(key, plaintext, additionalData []byte) (nonce, ciphertext []byte, err error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return nonce, aead.Seal(nil, nonce, plaintext, additionalData), nil
+}
+
+func aeadOpen(key, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce length %d", len(nonce))
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, errors.New("authentication failed, wrong passphrase or key")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// PassphraseUnlockProvider wraps data keys with a key derived from a passphrase using scrypt.
+type PassphraseUnlockProvider struct {
+	passphrase []byte
+}
+
+var _ KeyUnlockProvider = &PassphraseUnlockProvider{}
+
+// NewPassphraseUnlockProvider returns a provider for the given passphrase.
+func NewPassphraseUnlockProvider(passphrase []byte) (*PassphraseUnlockProvider, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("passphrase cannot be empty")
+	}
+	return &PassphraseUnlockProvider{passphrase: passphrase}, nil
+}
+
+func (p *PassphraseUnlockProvider) Name() string {
+	return "passphrase"
+}
+
+// WrapKey implements KeyUnlockProvider. The wrapped key is salt || nonce || ciphertext.
+func (p *PassphraseUnlockProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	kek, err := p.deriveKey(salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce, ciphertext, err := aeadSeal(kek, dataKey, salt)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(salt, nonce...), ciphertext...), nil
+}
+
+// UnwrapKey implements KeyUnlockProvider.
+func (p *PassphraseUnlockProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < saltSize {
+		return nil, errors.New("wrapped key is too short")
+	}
+	salt, rest := wrappedKey[:saltSize], wrappedKey[saltSize:]
+	kek, err := p.deriveKey(salt)
+	if err != nil {
+		return nil, err
+	}
+	return openWrapped(kek, rest, salt)
+}
+
+func (p *PassphraseUnlockProvider) deriveKey(salt []byte) ([]byte, error) {
+	return scrypt.Key(p.passphrase, salt, passphraseScryptN, passphraseScryptR, passphraseScryptP, keySize)
+}
+
+// LocalUnlockProvider wraps data keys with a key encryption key read from a local file.
+// It stands in for a KMS: the key encryption key can live on separate storage from the key files,
+// and implementations of KeyUnlockProvider backed by a KMS follow the same shape.
+type LocalUnlockProvider struct {
+	kek []byte
+}
+
+var _ KeyUnlockProvider = &LocalUnlockProvider{}
+
+// NewLocalUnlockProvider loads a 32 byte key encryption key from file.
+func NewLocalUnlockProvider(file string) (*LocalUnlockProvider, error) {
+	kek, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if len(kek) != keySize {
+		return nil, fmt.Errorf("local unlock key %s must be %d bytes, got %d", file, keySize, len(kek))
+	}
+	return &LocalUnlockProvider{kek: kek}, nil
+}
+
+// CreateLocalUnlockKey writes a new random key encryption key for LocalUnlockProvider to file.
+func CreateLocalUnlockKey(file string) error {
+	kek := make([]byte, keySize)
+	if _, err := rand.Read(kek); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(kek); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (p *LocalUnlockProvider) Name() string {
+	return "local"
+}
+
+// WrapKey implements KeyUnlockProvider. The wrapped key is nonce || ciphertext.
+func (p *LocalUnlockProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	nonce, ciphertext, err := aeadSeal(p.kek, dataKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(nonce, ciphertext...), nil
+}
+
+// UnwrapKey implements KeyUnlockProvider.
+func (p *LocalUnlockProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return openWrapped(p.kek, wrappedKey, nil)
+}
+
+// openWrapped opens a nonce || ciphertext wrapped key.
+func openWrapped(kek, wrapped, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	return aeadOpen(kek, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], additionalData)
+}
@@ -0,0 +1,92 @@
+package signer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyEncryptionRoundTrip(t *testing.T) {
+	passphrase, err := NewPassphraseUnlockProvider([]byte("correct horse battery staple"))
+	require.NoError(t, err)
+
+	kekFile := filepath.Join(t.TempDir(), "unlock.key")
+	require.NoError(t, CreateLocalUnlockKey(kekFile))
+	require.Error(t, CreateLocalUnlockKey(kekFile), "existing unlock key must not be overwritten")
+	local, err := NewLocalUnlockProvider(kekFile)
+	require.NoError(t, err)
+
+	plaintext := []byte(`{"id":1}`)
+
+	for _, provider := range []KeyUnlockProvider{passphrase, local} {
+		t.Run(provider.Name(), func(t *testing.T) {
+			bz, err := EncryptKey(provider, plaintext)
+			require.NoError(t, err)
+			require.NotContains(t, string(bz), string(plaintext))
+
+			decrypted, err := DecryptKey(provider, bz)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, decrypted)
+		})
+	}
+
+	bz, err := EncryptKey(passphrase, plaintext)
+	require.NoError(t, err)
+
+	wrongPassphrase, err := NewPassphraseUnlockProvider([]byte("wrong"))
+	require.NoError(t, err)
+	_, err = DecryptKey(wrongPassphrase, bz)
+	require.ErrorContains(t, err, "authentication failed")
+
+	_, err = DecryptKey(local, bz)
+	require.ErrorContains(t, err, "key is encrypted with the passphrase unlock provider, not local")
+}
+
+func TestLoadEncryptedKeyFile(t *testing.T) {
+	t.Cleanup(func() { SetKeyUnlockProvider(nil) })
+
+	keys, err := CreateCosignerECIESShards(3)
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "ecies_keys.json")
+	require.NoError(t, WriteCosignerECIESShardFile(keys[0], file))
+
+	provider, err := NewPassphraseUnlockProvider([]byte("passphrase"))
+	require.NoError(t, err)
+
+	require.NoError(t, EncryptKeyFile(file, provider))
+	require.ErrorContains(t, EncryptKeyFile(file, provider), "already encrypted")
+
+	encrypted, err := IsEncryptedKeyFile(file)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	_, err = LoadCosignerECIESKey(file)
+	require.ErrorContains(t, err, "no unlock provider is configured")
+
+	SetKeyUnlockProvider(provider)
+	key, err := LoadCosignerECIESKey(file)
+	require.NoError(t, err)
+	require.Equal(t, keys[0].ID, key.ID)
+	require.Equal(t, keys[0].ECIESKey.D, key.ECIESKey.D)
+
+	// key files written while a provider is configured are encrypted.
+	written := filepath.Join(t.TempDir(), "ecies_keys.json")
+	require.NoError(t, WriteCosignerECIESShardFile(keys[1], written))
+	encrypted, err = IsEncryptedKeyFile(written)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	require.NoError(t, DecryptKeyFile(file, provider))
+	SetKeyUnlockProvider(nil)
+
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Nil(t, parseKeyEnvelope(bz))
+
+	key, err = LoadCosignerECIESKey(file)
+	require.NoError(t, err)
+	require.Equal(t, keys[0].ID, key.ID)
+}
@@ -0,0 +1,50 @@
+package signer
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+)
+
+// consensusKeyTypes are the consensus key types which can be used in single signer mode,
+// keyed by crypto.PubKey.Type(). Importing secp256k1 also registers its keys for
+// decoding priv_validator_key.json files.
+var consensusKeyTypes = map[string]bool{
+	ed25519.KeyType:   true,
+	secp256k1.KeyType: true,
+}
+
+// thresholdKeyTypes are the consensus key types which have a threshold signature scheme.
+var thresholdKeyTypes = map[string]bool{
+	ed25519.KeyType: true,
+}
+
+// ValidateConsensusKey returns an error if pubKey cannot be used to sign consensus messages.
+func ValidateConsensusKey(pubKey crypto.PubKey) error {
+	if !consensusKeyTypes[pubKey.Type()] {
+		return fmt.Errorf("unsupported consensus key type %s, must be one of %v",
+			pubKey.Type(), sortedKeys(consensusKeyTypes))
+	}
+	return nil
+}
+
+// ValidateThresholdKey returns an error if pubKey cannot be sharded for threshold signing.
+func ValidateThresholdKey(pubKey crypto.PubKey) error {
+	if !thresholdKeyTypes[pubKey.Type()] {
+		return fmt.Errorf("threshold signing is not supported for %s keys, must be one of %v, "+
+			"use single signer mode instead", pubKey.Type(), sortedKeys(thresholdKeyTypes))
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
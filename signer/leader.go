@@ -8,6 +8,13 @@ type Leader interface {
 	// ShareSigned shares the last signed state with the other cosigners.
 	ShareSigned(lss ChainSignStateConsensus) error
 
+	// FenceSign commits that the leader is starting to sign hrs for chainID, so that no leader
+	// can start signing at or below it afterwards.
+	FenceSign(chainID string, hrs HRSKey) error
+
+	// SignFreeze returns the sign freeze for chainID set at runtime, if any.
+	SignFreeze(chainID string) (SignFreeze, bool)
+
 	// Get current leader
 	GetLeader() int
 }
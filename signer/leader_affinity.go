@@ -0,0 +1,134 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+)
+
+const (
+	leaderAffinityInterval = 5 * time.Second
+
+	// leaderAffinityHoldoff is how long a cosigner which ranks higher than the leader must stay healthy,
+	// and how long the leader must have led, before leadership is transferred, so that it does not flap.
+	leaderAffinityHoldoff = 30 * time.Second
+)
+
+// LeadershipTransferer transfers the leadership of the cluster.
+type LeadershipTransferer interface {
+	// TransferLeadershipTo transfers the leadership to the cosigner with id.
+	TransferLeadershipTo(id int) error
+}
+
+// LeaderAffinity moves the raft leadership to the healthy cosigner which ranks highest by the
+// leaderRegions and leaderPriority of the config. It only acts while this cosigner is the leader.
+type LeaderAffinity struct {
+	logger      cometlog.Logger
+	cosigner    *LocalCosigner
+	regions     []string
+	leader      Leader
+	transferer  LeadershipTransferer
+	health      *CosignerHealth
+	leaderSince time.Time
+
+	// candidate is the cosigner leadership is transferred to once it has ranked highest since candidateSince.
+	candidate      int
+	candidateSince time.Time
+}
+
+func NewLeaderAffinity(
+	logger cometlog.Logger,
+	cosigner *LocalCosigner,
+	leader Leader,
+	transferer LeadershipTransferer,
+	health *CosignerHealth,
+) *LeaderAffinity {
+	return &LeaderAffinity{
+		logger:     logger,
+		cosigner:   cosigner,
+		regions:    cosigner.config.Config.ThresholdModeConfig.LeaderRegions,
+		leader:     leader,
+		transferer: transferer,
+		health:     health,
+	}
+}
+
+func (la *LeaderAffinity) Start(ctx context.Context) {
+	ticker := time.NewTicker(leaderAffinityInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			la.reconcile(time.Now())
+		}
+	}
+}
+
+func (la *LeaderAffinity) reconcile(now time.Time) {
+	if !la.leader.IsLeader() {
+		la.leaderSince = time.Time{}
+		la.candidate = 0
+		return
+	}
+	if la.leaderSince.IsZero() {
+		la.leaderSince = now
+	}
+
+	candidate := la.bestCandidate()
+	if candidate != la.candidate {
+		la.candidate = candidate
+		la.candidateSince = now
+	}
+	if candidate == 0 ||
+		now.Sub(la.candidateSince) < leaderAffinityHoldoff ||
+		now.Sub(la.leaderSince) < leaderAffinityHoldoff {
+		return
+	}
+
+	la.logger.Info("Transferring leadership to preferred cosigner", "cosigner", candidate)
+	if err := la.transferer.TransferLeadershipTo(candidate); err != nil {
+		la.logger.Error("Failed to transfer leadership", "cosigner", candidate, "error", err)
+	} else {
+		totalLeaderAffinityTransfers.WithLabelValues(fmt.Sprint(candidate)).Inc()
+	}
+	// the candidate has to prove itself again if the transfer did not take effect.
+	la.candidate = 0
+}
+
+// bestCandidate returns the healthy cosigner which ranks highest and higher than this cosigner,
+// preferring the lowest round trip time among equals, or 0 if there is none.
+func (la *LeaderAffinity) bestCandidate() int {
+	myID := la.cosigner.GetID()
+	cosigners := la.cosigner.cosigners()
+
+	var myRank leaderRank
+	for _, c := range cosigners {
+		if c.ShardID == myID {
+			myRank = c.leaderRank(la.regions)
+		}
+	}
+
+	best, bestRTT := 0, int64(0)
+	var bestRank leaderRank
+	for _, c := range cosigners {
+		if c.ShardID == myID {
+			continue
+		}
+		rank := c.leaderRank(la.regions)
+		if !rank.above(myRank) {
+			continue
+		}
+		rtt, ok := la.health.HealthyRTT(c.ShardID)
+		if !ok {
+			continue
+		}
+		if best == 0 || rank.above(bestRank) || (rank == bestRank && rtt < bestRTT) {
+			best, bestRTT, bestRank = c.ShardID, rtt, rank
+		}
+	}
+	return best
+}
@@ -0,0 +1,90 @@
+package signer
+
+import (
+	"testing"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLeadershipTransferer struct {
+	transfers []int
+}
+
+func (m *mockLeadershipTransferer) TransferLeadershipTo(id int) error {
+	m.transfers = append(m.transfers, id)
+	return nil
+}
+
+// newTestLeaderAffinity returns the LeaderAffinity of cosigner 1 of a 2of3 cluster, which is the leader,
+// with cosigners configured by configure.
+func newTestLeaderAffinity(
+	t *testing.T,
+	configure func(cfg *ThresholdModeConfig),
+) (*LeaderAffinity, *MockLeader, *CosignerHealth, *mockLeadershipTransferer) {
+	cosigners, _ := getTestLocalCosigners(t, 2, 3)
+	configure(cosigners[0].config.Config.ThresholdModeConfig)
+
+	leader := &MockLeader{id: 1, leader: &ThresholdValidator{myCosigner: cosigners[0]}}
+	health := NewCosignerHealth(cometlog.NewNopLogger(), []Cosigner{cosigners[1], cosigners[2]}, leader)
+	health.rtt = map[int]int64{2: 200, 3: 100}
+	transferer := &mockLeadershipTransferer{}
+
+	la := NewLeaderAffinity(cometlog.NewNopLogger(), cosigners[0], leader, transferer, health)
+	return la, leader, health, transferer
+}
+
+func TestLeaderAffinityPriority(t *testing.T) {
+	la, leader, health, transferer := newTestLeaderAffinity(t, func(cfg *ThresholdModeConfig) {
+		cfg.Cosigners[1].LeaderPriority = 10
+		cfg.Cosigners[2].LeaderPriority = 10
+	})
+
+	// cosigners 2 and 3 rank the same, and 3 is faster.
+	require.Equal(t, 3, la.bestCandidate())
+
+	start := time.Now()
+	la.reconcile(start)
+	la.reconcile(start.Add(leaderAffinityHoldoff / 2))
+	require.Empty(t, transferer.transfers)
+
+	// a change of the candidate restarts the holdoff.
+	health.MarkUnhealthy(&RemoteCosigner{id: 3})
+	la.reconcile(start.Add(leaderAffinityHoldoff / 2))
+	la.reconcile(start.Add(leaderAffinityHoldoff))
+	require.Empty(t, transferer.transfers)
+
+	la.reconcile(start.Add(leaderAffinityHoldoff * 3 / 2))
+	require.Equal(t, []int{2}, transferer.transfers)
+
+	// only the leader transfers the leadership.
+	leader.SetLeader(nil)
+	la.reconcile(start.Add(leaderAffinityHoldoff * 3))
+	require.Equal(t, []int{2}, transferer.transfers)
+}
+
+func TestLeaderAffinityRegions(t *testing.T) {
+	la, _, health, _ := newTestLeaderAffinity(t, func(cfg *ThresholdModeConfig) {
+		cfg.LeaderRegions = []string{"eu-west", "us-east"}
+		cfg.Cosigners[0].Region = "us-east"
+		cfg.Cosigners[0].LeaderPriority = 100
+		cfg.Cosigners[1].Region = "eu-west"
+		cfg.Cosigners[2].LeaderPriority = 1000
+	})
+
+	// the region ranks before the priority, and cosigners in no listed region rank last.
+	require.Equal(t, 2, la.bestCandidate())
+
+	health.MarkFaulty(&RemoteCosigner{id: 2})
+	require.Zero(t, la.bestCandidate())
+}
+
+func TestLeaderAffinityNotConfigured(t *testing.T) {
+	la, _, _, transferer := newTestLeaderAffinity(t, func(*ThresholdModeConfig) {})
+
+	start := time.Now()
+	la.reconcile(start)
+	la.reconcile(start.Add(leaderAffinityHoldoff * 2))
+	require.Empty(t, transferer.transfers)
+}
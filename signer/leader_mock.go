@@ -32,3 +32,11 @@ func (m *MockLeader) GetLeader() int {
 func (m *MockLeader) ShareSigned(_ ChainSignStateConsensus) error {
 	return nil
 }
+
+func (m *MockLeader) FenceSign(_ string, _ HRSKey) error {
+	return nil
+}
+
+func (m *MockLeader) SignFreeze(_ string) (SignFreeze, bool) {
+	return SignFreeze{}, false
+}
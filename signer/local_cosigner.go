@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -32,8 +33,17 @@ type LocalCosigner struct {
 	pendingDiskWG sync.WaitGroup
 
 	nonces map[uuid.UUID]*NoncesWithExpiration
-	// protects the nonces map
+	// nonces preprocessed for FROST signatures, by the UUID of their commitment
+	frostNonces map[uuid.UUID]*frostNoncesWithExpiration
+	// protects the nonces and frostNonces maps
 	noncesMu sync.RWMutex
+
+	dkgSessions map[string]*dkgSession
+	// protects the dkgSessions map
+	dkgMu sync.Mutex
+
+	// protects the cosigner set and threshold in config, which change with cluster membership and resharing
+	membershipMu sync.RWMutex
 }
 
 func NewLocalCosigner(
@@ -48,6 +58,10 @@ func NewLocalCosigner(
 		security: security,
 		address:  address,
 		nonces:   make(map[uuid.UUID]*NoncesWithExpiration),
+
+		frostNonces: make(map[uuid.UUID]*frostNoncesWithExpiration),
+
+		dkgSessions: make(map[string]*dkgSession),
 	}
 }
 
@@ -57,6 +71,10 @@ type ChainState struct {
 	lastSignState *SignState
 	// signer generates nonces, combines nonces, signs, and verifies signatures.
 	signer ThresholdSigner
+	// pubShares are the public key shares of all cosigners, if the key shard has them.
+	pubShares [][]byte
+	// rawBytes records the raw bytes this cosigner signed for each unique ID.
+	rawBytes *RawBytesSignState
 }
 
 // StartNoncePruner periodically prunes nonces that have expired.
@@ -82,9 +100,19 @@ func (cosigner *LocalCosigner) pruneNonces() {
 			delete(cosigner.nonces, uuid)
 		}
 	}
+	for uuid, nonces := range cosigner.frostNonces {
+		if now.After(nonces.expiration) {
+			delete(cosigner.frostNonces, uuid)
+		}
+	}
 }
 
-func (cosigner *LocalCosigner) combinedNonces(myID int, threshold uint8, uuid uuid.UUID) ([]Nonce, error) {
+func (cosigner *LocalCosigner) combinedNonces(
+	myID int,
+	threshold uint8,
+	uuid uuid.UUID,
+	dealers []int,
+) ([]Nonce, error) {
 	cosigner.noncesMu.RLock()
 	defer cosigner.noncesMu.RUnlock()
 
@@ -96,10 +124,13 @@ func (cosigner *LocalCosigner) combinedNonces(myID int, threshold uint8, uuid uu
 	combinedNonces := make([]Nonce, 0, threshold)
 
 	// calculate secret and public keys
-	for _, c := range nonces.Nonces {
+	for i, c := range nonces.Nonces {
 		if len(c.Shares) == 0 || len(c.Shares[myID-1]) == 0 {
 			continue
 		}
+		if len(dealers) > 0 && !slices.Contains(dealers, i+1) {
+			continue
+		}
 
 		combinedNonces = append(combinedNonces, Nonce{
 			Share:  c.Shares[myID-1],
@@ -183,6 +214,40 @@ func (cosigner *LocalCosigner) CombineSignatures(chainID string, signatures []Pa
 	return ccs.signer.CombineSignatures(signatures)
 }
 
+// VerifyPartialSignature verifies the partial signature of a cosigner over payload, made with the nonces
+// dealt for the signature. A PartialSignatureError blames the cosigner if the partial signature is invalid.
+func (cosigner *LocalCosigner) VerifyPartialSignature(
+	chainID string,
+	nonces *CosignerUUIDNonces,
+	payload []byte,
+	signature PartialSignature,
+) error {
+	ccs, err := cosigner.getChainState(chainID)
+	if err != nil {
+		return err
+	}
+	if ccs.pubShares == nil {
+		return fmt.Errorf("%w: key shard has no public key shares", errPartialSignatureUnverifiable)
+	}
+
+	dealings, err := nonceDealings(nonces)
+	if err != nil {
+		return err
+	}
+
+	return verifyPartialSignature(ccs.signer.PubKey(), ccs.pubShares, dealings, signature.ID, payload, signature.Signature)
+}
+
+// loadPubShares returns the public key shares of the key shard for chainID, or nil if they are not known,
+// e.g. for shards created by older releases or held by an external shard signer.
+func (cosigner *LocalCosigner) loadPubShares(chainID string) [][]byte {
+	key, err := LoadCosignerEd25519Key(cosigner.config.KeyFilePathCosigner(chainID))
+	if err != nil {
+		return nil
+	}
+	return key.PubShares
+}
+
 // VerifySignature validates a signed payload against the public key.
 // Implements Cosigner interface
 func (cosigner *LocalCosigner) VerifySignature(chainID string, payload, signature []byte) bool {
@@ -205,16 +270,9 @@ func (cosigner *LocalCosigner) VerifySignature(chainID string, payload, signatur
 // Return the signed bytes or an error
 // Implements Cosigner interface
 func (cosigner *LocalCosigner) sign(req CosignerSignRequest) (CosignerSignResponse, error) {
-	chainID := req.ChainID
-
 	res := CosignerSignResponse{}
 
-	ccs, err := cosigner.getChainState(chainID)
-	if err != nil {
-		return res, err
-	}
-
-	hrst, hasVoteExtensions, err := verifySignPayload(chainID, req.SignBytes, req.VoteExtensionSignBytes)
+	ccs, hrst, hasVoteExtensions, err := cosigner.checkSignRequest(req.ChainID, req.SignBytes, req.VoteExtensionSignBytes)
 	if err != nil {
 		return res, err
 	}
@@ -241,8 +299,9 @@ func (cosigner *LocalCosigner) sign(req CosignerSignRequest) (CosignerSignRespon
 
 	nonces, err := cosigner.combinedNonces(
 		cosigner.GetID(),
-		uint8(cosigner.config.Config.ThresholdModeConfig.Threshold),
+		uint8(cosigner.threshold()),
 		req.UUID,
+		req.NonceDealers,
 	)
 	if err != nil {
 		return res, err
@@ -252,8 +311,9 @@ func (cosigner *LocalCosigner) sign(req CosignerSignRequest) (CosignerSignRespon
 	if hasVoteExtensions {
 		voteExtNonces, err = cosigner.combinedNonces(
 			cosigner.GetID(),
-			uint8(cosigner.config.Config.ThresholdModeConfig.Threshold),
+			uint8(cosigner.threshold()),
 			req.VoteExtUUID,
+			req.NonceDealers,
 		)
 		if err != nil {
 			return res, err
@@ -304,12 +364,100 @@ func (cosigner *LocalCosigner) sign(req CosignerSignRequest) (CosignerSignRespon
 	return res, nil
 }
 
+// signRawBytes signs the domain separated rawBytes for uniqueID with the nonces of uuid, if the chain policy
+// allows it and this cosigner did not sign uniqueID for other raw bytes.
+func (cosigner *LocalCosigner) signRawBytes(
+	chainID, uniqueID string,
+	rawBytes []byte,
+	uuid uuid.UUID,
+	nonceDealers []int,
+) (CosignerSignResponse, error) {
+	res := CosignerSignResponse{}
+
+	ccs, err := cosigner.getChainState(chainID)
+	if err != nil {
+		return res, err
+	}
+	if err := cosigner.config.Config.CheckRawBytesPolicy(chainID, uniqueID); err != nil {
+		return res, err
+	}
+	signBytes, err := RawBytesSignBytes(uniqueID, rawBytes)
+	if err != nil {
+		return res, err
+	}
+
+	defer func() {
+		cosigner.noncesMu.Lock()
+		delete(cosigner.nonces, uuid)
+		cosigner.noncesMu.Unlock()
+	}()
+
+	nonces, err := cosigner.combinedNonces(
+		cosigner.GetID(),
+		uint8(cosigner.threshold()),
+		uuid,
+		nonceDealers,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	if err := ccs.rawBytes.Record(uniqueID, rawBytes); err != nil {
+		return res, err
+	}
+
+	res.Signature, err = ccs.signer.Sign(nonces, signBytes)
+	return res, err
+}
+
+// checkSignRequest returns the chain state and HRST of a sign request, and whether it signs a vote extension.
+// An error is returned if the sign bytes are invalid or the chain policy does not allow signing them.
+func (cosigner *LocalCosigner) checkSignRequest(
+	chainID string,
+	signBytes, voteExtensionSignBytes []byte,
+) (*ChainState, HRSTKey, bool, error) {
+	ccs, err := cosigner.getChainState(chainID)
+	if err != nil {
+		return nil, HRSTKey{}, false, err
+	}
+
+	hrst, hasVoteExtensions, err := verifySignPayload(
+		chainID,
+		signBytes,
+		voteExtensionSignBytes,
+		cosigner.config.Config.ChainPrivValProtocol(chainID),
+	)
+	if err != nil {
+		return nil, HRSTKey{}, false, err
+	}
+
+	if err := cosigner.config.Config.CheckSignPolicy(chainID, hrst.Height); err != nil {
+		return nil, HRSTKey{}, false, err
+	}
+
+	return ccs, hrst, hasVoteExtensions, nil
+}
+
+// cosigners returns the current cosigner set from config.
+func (cosigner *LocalCosigner) cosigners() CosignersConfig {
+	cosigner.membershipMu.RLock()
+	defer cosigner.membershipMu.RUnlock()
+	return cosigner.config.Config.ThresholdModeConfig.Cosigners
+}
+
+// threshold returns the current threshold from config.
+func (cosigner *LocalCosigner) threshold() int {
+	cosigner.membershipMu.RLock()
+	defer cosigner.membershipMu.RUnlock()
+	return cosigner.config.Config.ThresholdModeConfig.Threshold
+}
+
 func (cosigner *LocalCosigner) generateNonces() ([]Nonces, error) {
-	total := len(cosigner.config.Config.ThresholdModeConfig.Cosigners)
+	total := cosigner.cosigners().MaxShardID()
 	meta := make([]Nonces, total)
 
 	nonces, err := GenerateNonces(
-		uint8(cosigner.config.Config.ThresholdModeConfig.Threshold),
+		uint8(cosigner.threshold()),
 		uint8(total),
 	)
 	if err != nil {
@@ -326,18 +474,29 @@ func (cosigner *LocalCosigner) LoadSignStateIfNecessary(chainID string) error {
 		return fmt.Errorf("chain id cannot be empty")
 	}
 
+	if err := cosigner.config.Config.CheckChainID(chainID); err != nil {
+		return err
+	}
+
 	if _, ok := cosigner.chainState.Load(chainID); ok {
 		return nil
 	}
 
-	signState, err := LoadOrCreateSignState(cosigner.config.CosignerStateFile(chainID))
+	signState, err := cosigner.config.LoadOrCreateSignState(cosigner.config.CosignerStateFile(chainID))
+	if err != nil {
+		return err
+	}
+
+	rawBytes, err := LoadOrCreateRawBytesSignState(cosigner.config.RawBytesStateFile(chainID))
 	if err != nil {
 		return err
 	}
 
 	var signer ThresholdSigner
 
-	signer, err = NewThresholdSignerSoft(cosigner.config, cosigner.GetID(), chainID)
+	cosigner.membershipMu.RLock()
+	signer, err = NewThresholdSigner(cosigner.config, cosigner.GetID(), chainID)
+	cosigner.membershipMu.RUnlock()
 	if err != nil {
 		return err
 	}
@@ -345,6 +504,8 @@ func (cosigner *LocalCosigner) LoadSignStateIfNecessary(chainID string) error {
 	cosigner.chainState.Store(chainID, &ChainState{
 		lastSignState: signState,
 		signer:        signer,
+		pubShares:     cosigner.loadPubShares(chainID),
+		rawBytes:      rawBytes,
 	})
 
 	return nil
@@ -357,11 +518,16 @@ func (cosigner *LocalCosigner) GetNonces(
 ) (CosignerUUIDNoncesMultiple, error) {
 	metricsTimeKeeper.SetPreviousLocalNonce(time.Now())
 
-	total := len(cosigner.config.Config.ThresholdModeConfig.Cosigners)
+	id := cosigner.GetID()
 
-	res := make(CosignerUUIDNoncesMultiple, len(uuids))
+	var peerIDs []int
+	for _, peerID := range cosigner.cosigners().IDs() {
+		if peerID != id {
+			peerIDs = append(peerIDs, peerID)
+		}
+	}
 
-	id := cosigner.GetID()
+	res := make(CosignerUUIDNoncesMultiple, len(uuids))
 
 	var outerEg errgroup.Group
 	// getting nonces requires encrypting and signing for each cosigner,
@@ -379,25 +545,15 @@ func (cosigner *LocalCosigner) GetNonces(
 
 			var eg errgroup.Group
 
-			nonces := make([]CosignerNonce, total-1)
-
-			for i := 0; i < total; i++ {
-				peerID := i + 1
-				if peerID == id {
-					continue
-				}
+			nonces := make([]CosignerNonce, len(peerIDs))
 
+			for i, peerID := range peerIDs {
 				i := i
+				peerID := peerID
 
 				eg.Go(func() error {
 					secretPart, err := cosigner.getNonce(meta, peerID)
-
-					if i >= id {
-						nonces[i-1] = secretPart
-					} else {
-						nonces[i] = secretPart
-					}
-
+					nonces[i] = secretPart
 					return err
 				})
 			}
@@ -463,6 +619,7 @@ func (cosigner *LocalCosigner) getNonce(
 	if err != nil {
 		return zero, err
 	}
+	nonce.ShareCommitments = ourCosignerMeta.Commitments
 
 	return nonce, nil
 }
@@ -482,6 +639,14 @@ func (cosigner *LocalCosigner) setNonce(uuid uuid.UUID, nonce CosignerNonce) err
 		return err
 	}
 
+	// the leader blames this cosigner for a partial signature which does not match the commitments,
+	// so a share which does not match its commitment is refused.
+	if len(nonce.ShareCommitments) > 0 {
+		if err := verifyNonceShare(nonceShare, nonce.ShareCommitments, cosigner.GetID()); err != nil {
+			return fmt.Errorf("nonce from cosigner %d: %w", nonce.SourceID, err)
+		}
+	}
+
 	// protects the meta map
 	cosigner.noncesMu.Lock()
 	defer cosigner.noncesMu.Unlock()
@@ -496,9 +661,14 @@ func (cosigner *LocalCosigner) setNonce(uuid uuid.UUID, nonce CosignerNonce) err
 		)
 	}
 
+	// nonces generated before a cosigner joined have no slot for it
+	if nonce.SourceID > len(n.Nonces) {
+		return fmt.Errorf("unexpected nonce from cosigner %d for %s", nonce.SourceID, uuid)
+	}
+
 	// set slot
 	if n.Nonces[nonce.SourceID-1].Shares == nil {
-		n.Nonces[nonce.SourceID-1].Shares = make([][]byte, len(cosigner.config.Config.ThresholdModeConfig.Cosigners))
+		n.Nonces[nonce.SourceID-1].Shares = make([][]byte, len(n.Nonces))
 	}
 	n.Nonces[nonce.SourceID-1].Shares[cosigner.GetID()-1] = nonceShare
 	n.Nonces[nonce.SourceID-1].PubKey = noncePub
@@ -542,10 +712,16 @@ func (cosigner *LocalCosigner) SetNoncesAndSign(
 		return nil, err
 	}
 
+	if req.RawBytesUniqueID != "" {
+		res, err := cosigner.signRawBytes(chainID, req.RawBytesUniqueID, req.RawBytes, req.Nonces.UUID, req.NonceDealers)
+		return &res, err
+	}
+
 	cosignerReq := CosignerSignRequest{
-		UUID:      req.Nonces.UUID,
-		ChainID:   chainID,
-		SignBytes: req.SignBytes,
+		UUID:         req.Nonces.UUID,
+		ChainID:      chainID,
+		SignBytes:    req.SignBytes,
+		NonceDealers: req.NonceDealers,
 	}
 
 	if len(req.VoteExtensionSignBytes) > 0 {
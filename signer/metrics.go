@@ -241,6 +241,48 @@ var (
 		},
 		[]string{"node"},
 	)
+	sentryChainInfo = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "signer_sentry_chain_info",
+			Help: "Chain ID each sentry is allowed to make requests for, * if any chain ID is allowed",
+		},
+		[]string{"node", "chain_id"},
+	)
+	totalSentryRejectedRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_sentry_rejected_requests",
+			Help: "Total Times a Sentry Requested a Chain ID it is not Allowed",
+		},
+		[]string{"node", "chain_id"},
+	)
+	totalSentryPubKeyMismatch = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_sentry_pubkey_mismatch",
+			Help: "Total Connections to a Sentry Closed Because it Authenticated With an Unexpected Public Key",
+		},
+		[]string{"node"},
+	)
+	totalRawBytesSigns = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_raw_bytes_signs",
+			Help: "Total SignRawBytes Requests by Result (success, error or unsupported)",
+		},
+		[]string{"chain_id", "result"},
+	)
+	privValListenerConnections = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "signer_privval_listener_connections",
+			Help: "Number of Chain Node Connections Served by a PrivVal Listener",
+		},
+		[]string{"listen_addr"},
+	)
+	totalPrivValListenerRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_privval_listener_rejected",
+			Help: "Total Chain Node Connections Rejected by a PrivVal Listener",
+		},
+		[]string{"listen_addr", "reason"},
+	)
 
 	beyondBlockErrors = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -274,11 +316,64 @@ var (
 		Help: "Total Times Combined Signature is Invalid",
 	})
 
+	totalFaultyPartialSignatures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_error_total_faulty_partial_signatures",
+			Help: "Total Times a Cosigner was Blamed for an Invalid Partial Signature",
+		},
+		[]string{"peerid", "reason"},
+	)
+
 	totalInsufficientCosigners = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "signer_error_total_insufficient_cosigners",
 		Help: "Total Times Cosigners doesn't reach threshold",
 	})
 
+	totalSignFenced = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_sign_fenced",
+			Help: "Total Times the Leader Refused to Sign at or Below the Replicated Watermark",
+		},
+		[]string{"chain_id"},
+	)
+
+	totalSignLeaseRefused = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_sign_lease_refused",
+			Help: "Total Times the Leader Refused to Sign Without the Signing Lease of the Fence",
+		},
+		[]string{"chain_id"},
+	)
+
+	totalLeaderAffinityTransfers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_leader_affinity_transfers",
+			Help: "Total Times the Leader Transferred Leadership to a Cosigner Ranking Higher",
+		},
+		[]string{"peerid"},
+	)
+
+	signFrozen = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "signer_sign_frozen",
+			Help: "Whether Signing is Stopped by a Halt Height or Freeze Window (1 if frozen)",
+		},
+		[]string{"chain_id"},
+	)
+
+	totalShadowSigns = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_shadow_signs",
+			Help: "Total Sign Requests Handled in Shadow Mode, by Result",
+		},
+		[]string{"chain_id", "result"},
+	)
+
+	totalAuditJournalErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_error_total_audit_journal",
+		Help: "Total Times a Signature could not be Written to the Audit Journal",
+	})
+
 	timedSignBlockThresholdLag = promauto.NewSummary(prometheus.SummaryOpts{
 		Name:       "signer_sign_block_threshold_lag_seconds",
 		Help:       "Seconds taken to get threshold of cosigners available",
@@ -297,6 +392,15 @@ var (
 		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
 	})
 
+	timedShadowSignLag = promauto.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name:       "signer_shadow_sign_lag_seconds",
+			Help:       "Seconds taken to sign in shadow mode",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		},
+		[]string{"chain_id", "type"},
+	)
+
 	timedCosignerNonceLag = promauto.NewSummaryVec(
 		prometheus.SummaryOpts{
 			Name:       "signer_cosigner_ephemeral_share_lag_seconds",
@@ -0,0 +1,181 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"sort"
+
+	"filippo.io/edwards25519"
+)
+
+const (
+	// partialSignatureReasonNonce blames a partial signature made with other nonces than the ones dealt for it.
+	partialSignatureReasonNonce = "nonce"
+	// partialSignatureReasonShare blames a partial signature which does not match the public key share.
+	partialSignatureReasonShare = "share"
+	// partialSignatureReasonDealer blames a cosigner which dealt nonce shares that do not combine.
+	partialSignatureReasonDealer = "dealer"
+)
+
+// errPartialSignatureUnverifiable is returned when a partial signature cannot be verified, because the
+// key shard has no public key shares or a dealer did not commit to its nonce shares, e.g. on older releases.
+// Only the combined signature is verified then.
+var errPartialSignatureUnverifiable = errors.New("partial signature cannot be verified")
+
+// PartialSignatureError blames cosigner ID for an invalid partial signature.
+type PartialSignatureError struct {
+	ID     int
+	Reason string
+}
+
+func (e *PartialSignatureError) Error() string {
+	switch e.Reason {
+	case partialSignatureReasonNonce:
+		return fmt.Sprintf("partial signature of cosigner %d was not made with the nonces dealt for it", e.ID)
+	case partialSignatureReasonDealer:
+		return fmt.Sprintf("cosigner %d dealt nonce shares which do not match its nonce", e.ID)
+	default:
+		return fmt.Sprintf("partial signature of cosigner %d does not match its public key share", e.ID)
+	}
+}
+
+// verifyNonceShare checks that the nonce share dealt to cosigner id matches the commitment of the dealer to it.
+func verifyNonceShare(share []byte, commitments [][]byte, id int) error {
+	if id < 1 || id > len(commitments) {
+		return fmt.Errorf("no nonce share commitment for cosigner %d", id)
+	}
+	commitment, err := new(edwards25519.Point).SetBytes(commitments[id-1])
+	if err != nil {
+		return fmt.Errorf("invalid nonce share commitment: %w", err)
+	}
+	s, err := scalarFromShard(share)
+	if err != nil {
+		return err
+	}
+	if new(edwards25519.Point).ScalarBaseMult(s).Equal(commitment) != 1 {
+		return errors.New("nonce share does not match its commitment")
+	}
+	return nil
+}
+
+// nonceDealing is the public part of the nonce dealt by one cosigner.
+type nonceDealing struct {
+	pubKey      *edwards25519.Point
+	commitments [][]byte
+}
+
+// nonceDealings returns the public part of the nonce of every source cosigner of nonces.
+func nonceDealings(nonces *CosignerUUIDNonces) (map[int]nonceDealing, error) {
+	dealings := make(map[int]nonceDealing)
+	for _, n := range nonces.Nonces {
+		if _, ok := dealings[n.SourceID]; ok {
+			continue
+		}
+		if len(n.ShareCommitments) == 0 {
+			return nil, fmt.Errorf("%w: cosigner %d did not commit to its nonce shares",
+				errPartialSignatureUnverifiable, n.SourceID)
+		}
+		pubKey, err := new(edwards25519.Point).SetBytes(n.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nonce public key from cosigner %d: %w", n.SourceID, err)
+		}
+		dealings[n.SourceID] = nonceDealing{
+			pubKey:      pubKey,
+			commitments: n.ShareCommitments,
+		}
+	}
+	return dealings, nil
+}
+
+// verifyPartialSignature checks the partial signature of cosigner id over payload, s*B == R_id + k*A_id,
+// where R_id is the sum of the commitments of the dealers to the nonce shares of id, and A_id is the
+// public key share of id. A PartialSignatureError is returned if the partial signature is invalid.
+func verifyPartialSignature(
+	pubKey []byte,
+	pubShares [][]byte,
+	dealings map[int]nonceDealing,
+	id int,
+	payload []byte,
+	signature []byte,
+) error {
+	if id < 1 || id > len(pubShares) || len(pubShares[id-1]) == 0 {
+		return fmt.Errorf("%w: no public key share for cosigner %d", errPartialSignatureUnverifiable, id)
+	}
+	pubShare, err := new(edwards25519.Point).SetBytes(pubShares[id-1])
+	if err != nil {
+		return fmt.Errorf("invalid public key share of cosigner %d: %w", id, err)
+	}
+
+	noncePub := edwards25519.NewIdentityPoint()
+	nonceShare := edwards25519.NewIdentityPoint()
+	for source, d := range dealings {
+		if id > len(d.commitments) {
+			return fmt.Errorf("%w: cosigner %d did not commit to a nonce share for cosigner %d",
+				errPartialSignatureUnverifiable, source, id)
+		}
+		commitment, err := new(edwards25519.Point).SetBytes(d.commitments[id-1])
+		if err != nil {
+			return fmt.Errorf("invalid nonce share commitment from cosigner %d: %w", source, err)
+		}
+		noncePub.Add(noncePub, d.pubKey)
+		nonceShare.Add(nonceShare, commitment)
+	}
+
+	if len(signature) != 64 || !bytes.Equal(signature[:32], noncePub.Bytes()) {
+		return &PartialSignatureError{ID: id, Reason: partialSignatureReasonNonce}
+	}
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(signature[32:])
+	if err != nil {
+		return &PartialSignatureError{ID: id, Reason: partialSignatureReasonShare}
+	}
+
+	h := sha512.New()
+	h.Write(signature[:32])
+	h.Write(pubKey)
+	h.Write(payload)
+	k, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return err
+	}
+
+	// s*B == R_id + k*A_id
+	lhs := new(edwards25519.Point).ScalarBaseMult(s)
+	rhs := new(edwards25519.Point).ScalarMult(k, pubShare)
+	rhs.Add(rhs, nonceShare)
+	if lhs.Equal(rhs) != 1 {
+		return &PartialSignatureError{ID: id, Reason: partialSignatureReasonShare}
+	}
+	return nil
+}
+
+// faultyNonceDealers returns the dealers whose commitments to the nonce shares of the signers ids do not
+// interpolate to their nonce public key. Valid partial signatures only combine to a valid signature if
+// every dealer dealt its nonce consistently.
+func faultyNonceDealers(dealings map[int]nonceDealing, ids []int) []int {
+	var faulty []int
+	for source, d := range dealings {
+		if !d.interpolates(ids) {
+			faulty = append(faulty, source)
+		}
+	}
+	sort.Ints(faulty)
+	return faulty
+}
+
+// interpolates returns true if the commitments to the nonce shares of ids interpolate to the nonce public key.
+func (d nonceDealing) interpolates(ids []int) bool {
+	sum := edwards25519.NewIdentityPoint()
+	for _, id := range ids {
+		if id < 1 || id > len(d.commitments) {
+			return false
+		}
+		commitment, err := new(edwards25519.Point).SetBytes(d.commitments[id-1])
+		if err != nil {
+			return false
+		}
+		sum.Add(sum, new(edwards25519.Point).ScalarMult(lagrangeCoefficient(id, ids), commitment))
+	}
+	return sum.Equal(d.pubKey) == 1
+}
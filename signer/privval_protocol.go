@@ -0,0 +1,54 @@
+package signer
+
+import (
+	"fmt"
+
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// PrivValProtocol is the version of the privval protocol spoken by the chain nodes of a chain.
+type PrivValProtocol string
+
+const (
+	// PrivValProtocolCometBFT038 is the protocol of CometBFT v0.38 and later, with vote extensions.
+	PrivValProtocolCometBFT038 PrivValProtocol = "v0.38"
+
+	// PrivValProtocolCometBFT037 is the protocol of CometBFT v0.37, without vote extensions.
+	PrivValProtocolCometBFT037 PrivValProtocol = "v0.37"
+
+	// PrivValProtocolTendermint034 is the protocol of Tendermint v0.34, without vote extensions.
+	PrivValProtocolTendermint034 PrivValProtocol = "v0.34"
+)
+
+// Validate returns an error if p is not a supported privval protocol. An empty protocol is the default.
+func (p PrivValProtocol) Validate() error {
+	switch p {
+	case "", PrivValProtocolCometBFT038, PrivValProtocolCometBFT037, PrivValProtocolTendermint034:
+		return nil
+	}
+	return fmt.Errorf("privValProtocol must be %s, %s or %s, got %q",
+		PrivValProtocolCometBFT038, PrivValProtocolCometBFT037, PrivValProtocolTendermint034, p)
+}
+
+// Legacy returns true for the protocols before CometBFT v0.38, which have no vote extensions
+// and replace the whole vote or proposal they sent with the one in the response.
+func (p PrivValProtocol) Legacy() bool {
+	return p == PrivValProtocolCometBFT037 || p == PrivValProtocolTendermint034
+}
+
+// VoteToBlock returns the block to sign for vote. Legacy protocols never sign vote extensions.
+func (p PrivValProtocol) VoteToBlock(chainID string, vote *cometproto.Vote) Block {
+	block := VoteToBlock(chainID, vote)
+	if p.Legacy() {
+		block.VoteExtensionSignBytes = nil
+	}
+	return block
+}
+
+// checkVoteExtension returns an error if vote extension sign bytes are given for a legacy protocol.
+func (p PrivValProtocol) checkVoteExtension(chainID string, voteExtensionSignBytes []byte) error {
+	if p.Legacy() && len(voteExtensionSignBytes) > 0 {
+		return fmt.Errorf("vote extensions are not signed for chain %s on privval protocol %s", chainID, p)
+	}
+	return nil
+}
@@ -27,6 +27,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type DKGMode int32
+
+const (
+	DKGMode_DKG_MODE_GENERATE DKGMode = 0
+	DKGMode_DKG_MODE_REFRESH  DKGMode = 1
+	DKGMode_DKG_MODE_RESHARE  DKGMode = 2
+)
+
+var DKGMode_name = map[int32]string{
+	0: "DKG_MODE_GENERATE",
+	1: "DKG_MODE_REFRESH",
+	2: "DKG_MODE_RESHARE",
+}
+
+var DKGMode_value = map[string]int32{
+	"DKG_MODE_GENERATE": 0,
+	"DKG_MODE_REFRESH":  1,
+	"DKG_MODE_RESHARE":  2,
+}
+
+func (x DKGMode) String() string {
+	return proto.EnumName(DKGMode_name, int32(x))
+}
+
+func (DKGMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{0}
+}
+
+type MembershipOp int32
+
+const (
+	MembershipOp_MEMBERSHIP_OP_ADD     MembershipOp = 0
+	MembershipOp_MEMBERSHIP_OP_REMOVE  MembershipOp = 1
+	MembershipOp_MEMBERSHIP_OP_REPLACE MembershipOp = 2
+)
+
+var MembershipOp_name = map[int32]string{
+	0: "MEMBERSHIP_OP_ADD",
+	1: "MEMBERSHIP_OP_REMOVE",
+	2: "MEMBERSHIP_OP_REPLACE",
+}
+
+var MembershipOp_value = map[string]int32{
+	"MEMBERSHIP_OP_ADD":     0,
+	"MEMBERSHIP_OP_REMOVE":  1,
+	"MEMBERSHIP_OP_REPLACE": 2,
+}
+
+func (x MembershipOp) String() string {
+	return proto.EnumName(MembershipOp_name, int32(x))
+}
+
+func (MembershipOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{1}
+}
+
 type Block struct {
 	Height           int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Round            int64  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
@@ -229,6 +285,9 @@ type Nonce struct {
 	PubKey        []byte `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 	Share         []byte `protobuf:"bytes,4,opt,name=share,proto3" json:"share,omitempty"`
 	Signature     []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// commitments to the nonce shares of the source cosigner for every shard ID, which let the leader
+	// verify partial signatures.
+	ShareCommitments [][]byte `protobuf:"bytes,6,rep,name=shareCommitments,proto3" json:"shareCommitments,omitempty"`
 }
 
 func (m *Nonce) Reset()         { *m = Nonce{} }
@@ -299,6 +358,13 @@ func (m *Nonce) GetSignature() []byte {
 	return nil
 }
 
+func (m *Nonce) GetShareCommitments() [][]byte {
+	if m != nil {
+		return m.ShareCommitments
+	}
+	return nil
+}
+
 type UUIDNonce struct {
 	Uuid   []byte   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Nonces []*Nonce `protobuf:"bytes,2,rep,name=nonces,proto3" json:"nonces,omitempty"`
@@ -428,6 +494,11 @@ type SetNoncesAndSignRequest struct {
 	VoteExtNonces    []*Nonce `protobuf:"bytes,6,rep,name=voteExtNonces,proto3" json:"voteExtNonces,omitempty"`
 	VoteExtSignBytes []byte   `protobuf:"bytes,7,opt,name=voteExtSignBytes,proto3" json:"voteExtSignBytes,omitempty"`
 	ChainID          string   `protobuf:"bytes,8,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// shard IDs of the cosigners whose nonces are combined, all nonces of the uuid are combined when empty.
+	NonceDealers []int32 `protobuf:"varint,9,rep,packed,name=nonceDealers,proto3" json:"nonceDealers,omitempty"`
+	// unique ID of the raw bytes which are signed instead of a vote or proposal, the sign bytes are empty when set.
+	RawBytesUniqueID string `protobuf:"bytes,10,opt,name=rawBytesUniqueID,proto3" json:"rawBytesUniqueID,omitempty"`
+	RawBytes         []byte `protobuf:"bytes,11,opt,name=rawBytes,proto3" json:"rawBytes,omitempty"`
 }
 
 func (m *SetNoncesAndSignRequest) Reset()         { *m = SetNoncesAndSignRequest{} }
@@ -519,6 +590,27 @@ func (m *SetNoncesAndSignRequest) GetChainID() string {
 	return ""
 }
 
+func (m *SetNoncesAndSignRequest) GetNonceDealers() []int32 {
+	if m != nil {
+		return m.NonceDealers
+	}
+	return nil
+}
+
+func (m *SetNoncesAndSignRequest) GetRawBytesUniqueID() string {
+	if m != nil {
+		return m.RawBytesUniqueID
+	}
+	return ""
+}
+
+func (m *SetNoncesAndSignRequest) GetRawBytes() []byte {
+	if m != nil {
+		return m.RawBytes
+	}
+	return nil
+}
+
 type SetNoncesAndSignResponse struct {
 	Timestamp          int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	NoncePublic        []byte `protobuf:"bytes,2,opt,name=noncePublic,proto3" json:"noncePublic,omitempty"`
@@ -595,6 +687,248 @@ func (m *SetNoncesAndSignResponse) GetVoteExtSignature() []byte {
 	return nil
 }
 
+// commitment of a cosigner to a pair of FROST nonces, which it preprocessed before signing.
+type FROSTCommitment struct {
+	Uuid       []byte `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CosignerID int32  `protobuf:"varint,2,opt,name=cosignerID,proto3" json:"cosignerID,omitempty"`
+	Hiding     []byte `protobuf:"bytes,3,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	Binding    []byte `protobuf:"bytes,4,opt,name=binding,proto3" json:"binding,omitempty"`
+}
+
+func (m *FROSTCommitment) Reset()         { *m = FROSTCommitment{} }
+func (m *FROSTCommitment) String() string { return proto.CompactTextString(m) }
+func (*FROSTCommitment) ProtoMessage()    {}
+func (*FROSTCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{8}
+}
+func (m *FROSTCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FROSTCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FROSTCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FROSTCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FROSTCommitment.Merge(m, src)
+}
+func (m *FROSTCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *FROSTCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FROSTCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FROSTCommitment proto.InternalMessageInfo
+
+func (m *FROSTCommitment) GetUuid() []byte {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
+}
+
+func (m *FROSTCommitment) GetCosignerID() int32 {
+	if m != nil {
+		return m.CosignerID
+	}
+	return 0
+}
+
+func (m *FROSTCommitment) GetHiding() []byte {
+	if m != nil {
+		return m.Hiding
+	}
+	return nil
+}
+
+func (m *FROSTCommitment) GetBinding() []byte {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+type FROSTCommitRequest struct {
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *FROSTCommitRequest) Reset()         { *m = FROSTCommitRequest{} }
+func (m *FROSTCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*FROSTCommitRequest) ProtoMessage()    {}
+func (*FROSTCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{9}
+}
+func (m *FROSTCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FROSTCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FROSTCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FROSTCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FROSTCommitRequest.Merge(m, src)
+}
+func (m *FROSTCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FROSTCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FROSTCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FROSTCommitRequest proto.InternalMessageInfo
+
+func (m *FROSTCommitRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type FROSTCommitResponse struct {
+	Commitments []*FROSTCommitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (m *FROSTCommitResponse) Reset()         { *m = FROSTCommitResponse{} }
+func (m *FROSTCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*FROSTCommitResponse) ProtoMessage()    {}
+func (*FROSTCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{10}
+}
+func (m *FROSTCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FROSTCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FROSTCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FROSTCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FROSTCommitResponse.Merge(m, src)
+}
+func (m *FROSTCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FROSTCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FROSTCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FROSTCommitResponse proto.InternalMessageInfo
+
+func (m *FROSTCommitResponse) GetCommitments() []*FROSTCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+type FROSTSignRequest struct {
+	ChainID   string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Hrst      *HRST  `protobuf:"bytes,2,opt,name=hrst,proto3" json:"hrst,omitempty"`
+	SignBytes []byte `protobuf:"bytes,3,opt,name=signBytes,proto3" json:"signBytes,omitempty"`
+	// commitments of all signers of the signature, including the cosigner which is asked to sign.
+	Commitments        []*FROSTCommitment `protobuf:"bytes,4,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	VoteExtSignBytes   []byte             `protobuf:"bytes,5,opt,name=voteExtSignBytes,proto3" json:"voteExtSignBytes,omitempty"`
+	VoteExtCommitments []*FROSTCommitment `protobuf:"bytes,6,rep,name=voteExtCommitments,proto3" json:"voteExtCommitments,omitempty"`
+}
+
+func (m *FROSTSignRequest) Reset()         { *m = FROSTSignRequest{} }
+func (m *FROSTSignRequest) String() string { return proto.CompactTextString(m) }
+func (*FROSTSignRequest) ProtoMessage()    {}
+func (*FROSTSignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{11}
+}
+func (m *FROSTSignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FROSTSignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FROSTSignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FROSTSignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FROSTSignRequest.Merge(m, src)
+}
+func (m *FROSTSignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FROSTSignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FROSTSignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FROSTSignRequest proto.InternalMessageInfo
+
+func (m *FROSTSignRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *FROSTSignRequest) GetHrst() *HRST {
+	if m != nil {
+		return m.Hrst
+	}
+	return nil
+}
+
+func (m *FROSTSignRequest) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (m *FROSTSignRequest) GetCommitments() []*FROSTCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *FROSTSignRequest) GetVoteExtSignBytes() []byte {
+	if m != nil {
+		return m.VoteExtSignBytes
+	}
+	return nil
+}
+
+func (m *FROSTSignRequest) GetVoteExtCommitments() []*FROSTCommitment {
+	if m != nil {
+		return m.VoteExtCommitments
+	}
+	return nil
+}
+
 type GetNoncesRequest struct {
 	Uuids [][]byte `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
 }
@@ -603,7 +937,7 @@ func (m *GetNoncesRequest) Reset()         { *m = GetNoncesRequest{} }
 func (m *GetNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNoncesRequest) ProtoMessage()    {}
 func (*GetNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{8}
+	return fileDescriptor_b7a1f695b94b848a, []int{12}
 }
 func (m *GetNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +981,7 @@ func (m *GetNoncesResponse) Reset()         { *m = GetNoncesResponse{} }
 func (m *GetNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNoncesResponse) ProtoMessage()    {}
 func (*GetNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{9}
+	return fileDescriptor_b7a1f695b94b848a, []int{13}
 }
 func (m *GetNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +1025,7 @@ func (m *TransferLeadershipRequest) Reset()         { *m = TransferLeadershipReq
 func (m *TransferLeadershipRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferLeadershipRequest) ProtoMessage()    {}
 func (*TransferLeadershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{10}
+	return fileDescriptor_b7a1f695b94b848a, []int{14}
 }
 func (m *TransferLeadershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,7 +1070,7 @@ func (m *TransferLeadershipResponse) Reset()         { *m = TransferLeadershipRe
 func (m *TransferLeadershipResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferLeadershipResponse) ProtoMessage()    {}
 func (*TransferLeadershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{11}
+	return fileDescriptor_b7a1f695b94b848a, []int{15}
 }
 func (m *TransferLeadershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +1120,7 @@ func (m *GetLeaderRequest) Reset()         { *m = GetLeaderRequest{} }
 func (m *GetLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLeaderRequest) ProtoMessage()    {}
 func (*GetLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{12}
+	return fileDescriptor_b7a1f695b94b848a, []int{16}
 }
 func (m *GetLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1157,7 @@ func (m *GetLeaderResponse) Reset()         { *m = GetLeaderResponse{} }
 func (m *GetLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLeaderResponse) ProtoMessage()    {}
 func (*GetLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{13}
+	return fileDescriptor_b7a1f695b94b848a, []int{17}
 }
 func (m *GetLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,7 +1200,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{14}
+	return fileDescriptor_b7a1f695b94b848a, []int{18}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +1236,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b7a1f695b94b848a, []int{15}
+	return fileDescriptor_b7a1f695b94b848a, []int{19}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,1338 +1265,6530 @@ func (m *PingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Block)(nil), "strangelove.horcrux.Block")
-	proto.RegisterType((*SignBlockRequest)(nil), "strangelove.horcrux.SignBlockRequest")
-	proto.RegisterType((*SignBlockResponse)(nil), "strangelove.horcrux.SignBlockResponse")
-	proto.RegisterType((*Nonce)(nil), "strangelove.horcrux.Nonce")
-	proto.RegisterType((*UUIDNonce)(nil), "strangelove.horcrux.UUIDNonce")
-	proto.RegisterType((*HRST)(nil), "strangelove.horcrux.HRST")
-	proto.RegisterType((*SetNoncesAndSignRequest)(nil), "strangelove.horcrux.SetNoncesAndSignRequest")
-	proto.RegisterType((*SetNoncesAndSignResponse)(nil), "strangelove.horcrux.SetNoncesAndSignResponse")
-	proto.RegisterType((*GetNoncesRequest)(nil), "strangelove.horcrux.GetNoncesRequest")
-	proto.RegisterType((*GetNoncesResponse)(nil), "strangelove.horcrux.GetNoncesResponse")
-	proto.RegisterType((*TransferLeadershipRequest)(nil), "strangelove.horcrux.TransferLeadershipRequest")
-	proto.RegisterType((*TransferLeadershipResponse)(nil), "strangelove.horcrux.TransferLeadershipResponse")
-	proto.RegisterType((*GetLeaderRequest)(nil), "strangelove.horcrux.GetLeaderRequest")
-	proto.RegisterType((*GetLeaderResponse)(nil), "strangelove.horcrux.GetLeaderResponse")
-	proto.RegisterType((*PingRequest)(nil), "strangelove.horcrux.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "strangelove.horcrux.PingResponse")
+type DKGPackage struct {
+	SourceID    int32    `protobuf:"varint,1,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
+	Commitments [][]byte `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	ProofR      []byte   `protobuf:"bytes,3,opt,name=proofR,proto3" json:"proofR,omitempty"`
+	ProofS      []byte   `protobuf:"bytes,4,opt,name=proofS,proto3" json:"proofS,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("strangelove/horcrux/cosigner.proto", fileDescriptor_b7a1f695b94b848a)
+func (m *DKGPackage) Reset()         { *m = DKGPackage{} }
+func (m *DKGPackage) String() string { return proto.CompactTextString(m) }
+func (*DKGPackage) ProtoMessage()    {}
+func (*DKGPackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{20}
 }
-
-var fileDescriptor_b7a1f695b94b848a = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x54,
-	0x10, 0x8f, 0x13, 0x3b, 0x9b, 0x4c, 0x5a, 0x94, 0x7d, 0xac, 0x16, 0xaf, 0x85, 0xa2, 0xf0, 0x04,
-	0x28, 0x82, 0x6d, 0x82, 0xb2, 0x12, 0xbd, 0xd2, 0x52, 0x04, 0x55, 0x01, 0x15, 0xa7, 0xbd, 0xa0,
-	0xaa, 0x95, 0xe3, 0xbc, 0xc6, 0x16, 0xa9, 0x9d, 0xfa, 0x3d, 0x87, 0xf6, 0xc0, 0x77, 0xe0, 0xc2,
-	0x07, 0xe1, 0x5b, 0x70, 0xec, 0x81, 0x43, 0x6f, 0xa0, 0xf6, 0x8b, 0xac, 0xde, 0x1f, 0x3b, 0xb6,
-	0xe3, 0xb4, 0x3d, 0xf4, 0x14, 0xcf, 0xf8, 0x37, 0x6f, 0xe6, 0x37, 0xf3, 0x9b, 0x17, 0x03, 0xa6,
-	0x2c, 0x72, 0x82, 0x29, 0x99, 0x85, 0x0b, 0x32, 0xf0, 0xc2, 0xc8, 0x8d, 0xe2, 0xab, 0x81, 0x1b,
-	0x52, 0x7f, 0x1a, 0x90, 0xa8, 0x3f, 0x8f, 0x42, 0x16, 0xa2, 0x0f, 0x33, 0x98, 0xbe, 0xc2, 0xe0,
-	0xbf, 0x35, 0x30, 0x76, 0x67, 0xa1, 0xfb, 0x1b, 0x7a, 0x0d, 0x75, 0x8f, 0xf8, 0x53, 0x8f, 0x99,
-	0x5a, 0x57, 0xeb, 0xd5, 0x6c, 0x65, 0xa1, 0x57, 0x60, 0x44, 0x61, 0x1c, 0x4c, 0xcc, 0xaa, 0x70,
-	0x4b, 0x03, 0x21, 0xd0, 0x29, 0x23, 0x73, 0xb3, 0xd6, 0xd5, 0x7a, 0x86, 0x2d, 0x9e, 0xd1, 0xc7,
-	0xd0, 0xe4, 0x09, 0x77, 0xaf, 0x19, 0xa1, 0xa6, 0xde, 0xd5, 0x7a, 0x1b, 0xf6, 0xd2, 0x81, 0xbe,
-	0x80, 0xf6, 0x22, 0x64, 0xe4, 0xbb, 0x2b, 0x36, 0x4a, 0x41, 0x86, 0x00, 0xad, 0xf8, 0xf9, 0x49,
-	0xcc, 0xbf, 0x20, 0x94, 0x39, 0x17, 0x73, 0xb3, 0x2e, 0xf2, 0x2e, 0x1d, 0xf8, 0x14, 0xda, 0x02,
-	0xca, 0xcb, 0xb6, 0xc9, 0x65, 0x4c, 0x28, 0x43, 0x26, 0xbc, 0x70, 0x3d, 0xc7, 0x0f, 0xf6, 0xf7,
-	0x44, 0xf9, 0x4d, 0x3b, 0x31, 0xd1, 0x57, 0x60, 0x8c, 0x39, 0x52, 0xd4, 0xdf, 0x1a, 0x5a, 0xfd,
-	0x92, 0x36, 0xf4, 0xe5, 0x59, 0x12, 0x88, 0xff, 0x80, 0x97, 0x99, 0xf3, 0xe9, 0x3c, 0x0c, 0x28,
-	0x49, 0xc8, 0x39, 0x2c, 0x8e, 0x88, 0x48, 0xa1, 0xc8, 0x09, 0x07, 0x7a, 0x0b, 0x88, 0x93, 0x38,
-	0x23, 0x57, 0xec, 0x6c, 0x09, 0xab, 0xae, 0xd0, 0x93, 0xe8, 0x1c, 0xbd, 0x5a, 0x91, 0xde, 0x5f,
-	0x1a, 0x18, 0x3f, 0x87, 0x81, 0x4b, 0x90, 0x05, 0x0d, 0x1a, 0xc6, 0x91, 0x4b, 0x14, 0x2b, 0xc3,
-	0x4e, 0x6d, 0xf4, 0x29, 0x6c, 0x4e, 0x08, 0x65, 0x7e, 0xe0, 0x30, 0x3f, 0xe4, 0xb4, 0xab, 0x02,
-	0x90, 0x77, 0xf2, 0xa1, 0xce, 0xe3, 0xf1, 0x01, 0xb9, 0x16, 0x69, 0x36, 0x6c, 0x65, 0xf1, 0xa1,
-	0x52, 0xcf, 0x89, 0x88, 0x1a, 0x93, 0x34, 0xf2, 0x1c, 0x8d, 0x02, 0x47, 0x3c, 0x82, 0xe6, 0xf1,
-	0xf1, 0xfe, 0x9e, 0x2c, 0x0d, 0x81, 0x1e, 0xc7, 0xfe, 0x44, 0x75, 0x42, 0x3c, 0xa3, 0x21, 0xd4,
-	0x03, 0xfe, 0x92, 0x9a, 0xd5, 0x6e, 0x6d, 0x6d, 0xab, 0x45, 0xbc, 0xad, 0x90, 0xf8, 0x1c, 0xf4,
-	0x1f, 0xec, 0xd1, 0xd1, 0xf3, 0xa8, 0x6f, 0xd9, 0x54, 0xbd, 0xd8, 0xd4, 0xdb, 0x2a, 0x7c, 0x34,
-	0x22, 0x4c, 0x24, 0xa7, 0x3b, 0xc1, 0x84, 0x0f, 0x23, 0xd1, 0xce, 0x33, 0x71, 0x41, 0x5b, 0xa0,
-	0x7b, 0x11, 0x65, 0xa2, 0xaa, 0xd6, 0xf0, 0x4d, 0x69, 0x04, 0x27, 0x6b, 0x0b, 0xd8, 0x23, 0xeb,
-	0xd2, 0x85, 0x96, 0xd2, 0xcd, 0x31, 0xaf, 0x4d, 0x4e, 0x23, 0xeb, 0x42, 0xdf, 0xc0, 0xa6, 0x32,
-	0x25, 0x2b, 0xb3, 0xfe, 0x68, 0xa5, 0xf9, 0x80, 0xd2, 0x95, 0x7c, 0xb1, 0x66, 0x25, 0x33, 0x0b,
-	0xd6, 0xc8, 0x2d, 0x18, 0xfe, 0x57, 0x03, 0x73, 0xb5, 0xb5, 0xcb, 0xb5, 0x59, 0x4e, 0x45, 0x2b,
-	0x4c, 0x85, 0x93, 0x14, 0xbd, 0x3b, 0x8c, 0xc7, 0x33, 0xdf, 0x55, 0xfb, 0x92, 0x75, 0xe5, 0x25,
-	0x59, 0x2b, 0xae, 0x5d, 0x5f, 0xae, 0x5d, 0xc2, 0x48, 0x1d, 0x23, 0x7b, 0x59, 0xf2, 0xa6, 0x40,
-	0x38, 0xab, 0xf3, 0x15, 0x3f, 0xee, 0x41, 0xfb, 0xfb, 0x84, 0x55, 0xa2, 0x94, 0x57, 0x60, 0x70,
-	0x75, 0x50, 0x53, 0xeb, 0xd6, 0xf8, 0xda, 0x08, 0x03, 0x1f, 0xc0, 0xcb, 0x0c, 0x52, 0x11, 0xff,
-	0x3a, 0x15, 0x90, 0x26, 0xc6, 0xd2, 0x29, 0x1d, 0x4b, 0xba, 0x50, 0xe9, 0x42, 0x6c, 0xc3, 0x9b,
-	0xa3, 0xc8, 0x09, 0xe8, 0x39, 0x89, 0x7e, 0x24, 0xce, 0x84, 0x44, 0xd4, 0xf3, 0xe7, 0x49, 0x7e,
-	0x0b, 0x1a, 0x33, 0xe1, 0x4c, 0xaf, 0xb9, 0xd4, 0xc6, 0xa7, 0x60, 0x95, 0x05, 0xaa, 0x72, 0x1e,
-	0x88, 0xe4, 0x57, 0x89, 0x7c, 0xde, 0x99, 0x4c, 0x22, 0x42, 0xa9, 0x98, 0x43, 0xd3, 0xce, 0x3b,
-	0x31, 0x12, 0xfd, 0x90, 0x47, 0xab, 0x7a, 0xf0, 0x97, 0x82, 0x79, 0xe2, 0x53, 0xa9, 0x5e, 0x43,
-	0x5d, 0x46, 0xaa, 0x3b, 0x4b, 0x59, 0x78, 0x13, 0x5a, 0x87, 0x7e, 0x30, 0x4d, 0x62, 0x3f, 0x80,
-	0x0d, 0x69, 0xca, 0xb0, 0xe1, 0x7f, 0x3a, 0x34, 0xbe, 0x55, 0xff, 0x58, 0xe8, 0x04, 0x9a, 0xe9,
-	0x15, 0x8c, 0x3e, 0x2b, 0x6d, 0x5d, 0xf1, 0x2f, 0xc0, 0xfa, 0xfc, 0x31, 0x98, 0x4c, 0x84, 0x2b,
-	0xe8, 0x12, 0xda, 0x45, 0xc1, 0xa2, 0xb7, 0xe5, 0xd1, 0xe5, 0x57, 0x86, 0xb5, 0xf5, 0x44, 0x74,
-	0x9a, 0xf2, 0x04, 0x9a, 0xa9, 0x46, 0xd6, 0x10, 0x2a, 0xaa, 0x6d, 0x0d, 0xa1, 0x15, 0xa9, 0xe1,
-	0x0a, 0xfa, 0x1d, 0xd0, 0xea, 0xec, 0x51, 0xbf, 0x34, 0x7e, 0xad, 0xba, 0xac, 0xc1, 0x93, 0xf1,
-	0x05, 0x5a, 0xf2, 0xd5, 0x7a, 0x5a, 0x39, 0xd1, 0xac, 0xa7, 0x95, 0xd7, 0x11, 0xae, 0xa0, 0x9f,
-	0x40, 0xe7, 0x12, 0x41, 0xdd, 0xd2, 0x88, 0x8c, 0x98, 0xac, 0x4f, 0x1e, 0x40, 0x24, 0xc7, 0xed,
-	0xfe, 0xf2, 0xcf, 0x5d, 0x47, 0xbb, 0xb9, 0xeb, 0x68, 0xff, 0xdf, 0x75, 0xb4, 0x3f, 0xef, 0x3b,
-	0x95, 0x9b, 0xfb, 0x4e, 0xe5, 0xf6, 0xbe, 0x53, 0xf9, 0x75, 0x7b, 0xea, 0x33, 0x2f, 0x1e, 0xf7,
-	0xdd, 0xf0, 0x62, 0x90, 0x39, 0x68, 0x6b, 0x41, 0x02, 0x7e, 0x17, 0xd0, 0xf4, 0x93, 0x6a, 0xf1,
-	0x6e, 0x20, 0x15, 0x3a, 0x10, 0xdf, 0x54, 0xe3, 0xba, 0xf8, 0x79, 0xf7, 0x3e, 0x00, 0x00, 0xff,
-	0xff, 0x61, 0xe1, 0x3e, 0xbf, 0x80, 0x09, 0x00, 0x00,
+func (m *DKGPackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGPackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGPackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGPackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGPackage.Merge(m, src)
+}
+func (m *DKGPackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGPackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGPackage.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_DKGPackage proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DKGPackage) GetSourceID() int32 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
 
-// CosignerClient is the client API for Cosigner service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CosignerClient interface {
-	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error)
-	SetNoncesAndSign(ctx context.Context, in *SetNoncesAndSignRequest, opts ...grpc.CallOption) (*SetNoncesAndSignResponse, error)
-	GetNonces(ctx context.Context, in *GetNoncesRequest, opts ...grpc.CallOption) (*GetNoncesResponse, error)
-	TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*TransferLeadershipResponse, error)
-	GetLeader(ctx context.Context, in *GetLeaderRequest, opts ...grpc.CallOption) (*GetLeaderResponse, error)
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+func (m *DKGPackage) GetCommitments() [][]byte {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
 }
 
-type cosignerClient struct {
-	cc grpc1.ClientConn
+func (m *DKGPackage) GetProofR() []byte {
+	if m != nil {
+		return m.ProofR
+	}
+	return nil
 }
 
-func NewCosignerClient(cc grpc1.ClientConn) CosignerClient {
-	return &cosignerClient{cc}
+func (m *DKGPackage) GetProofS() []byte {
+	if m != nil {
+		return m.ProofS
+	}
+	return nil
 }
 
-func (c *cosignerClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error) {
-	out := new(SignBlockResponse)
-	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/SignBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DKGCommitRequest struct {
+	SessionID    []byte  `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChainID      string  `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Threshold    int32   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Participants []int32 `protobuf:"varint,4,rep,packed,name=participants,proto3" json:"participants,omitempty"`
+	Mode         DKGMode `protobuf:"varint,5,opt,name=mode,proto3,enum=strangelove.horcrux.DKGMode" json:"mode,omitempty"`
 }
 
-func (c *cosignerClient) SetNoncesAndSign(ctx context.Context, in *SetNoncesAndSignRequest, opts ...grpc.CallOption) (*SetNoncesAndSignResponse, error) {
-	out := new(SetNoncesAndSignResponse)
-	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/SetNoncesAndSign", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DKGCommitRequest) Reset()         { *m = DKGCommitRequest{} }
+func (m *DKGCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*DKGCommitRequest) ProtoMessage()    {}
+func (*DKGCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{21}
+}
+func (m *DKGCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DKGCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGCommitRequest.Merge(m, src)
+}
+func (m *DKGCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGCommitRequest.DiscardUnknown(m)
 }
 
-func (c *cosignerClient) GetNonces(ctx context.Context, in *GetNoncesRequest, opts ...grpc.CallOption) (*GetNoncesResponse, error) {
-	out := new(GetNoncesResponse)
-	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/GetNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DKGCommitRequest proto.InternalMessageInfo
+
+func (m *DKGCommitRequest) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
 	}
-	return out, nil
+	return nil
 }
 
-func (c *cosignerClient) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*TransferLeadershipResponse, error) {
-	out := new(TransferLeadershipResponse)
-	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/TransferLeadership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DKGCommitRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *cosignerClient) GetLeader(ctx context.Context, in *GetLeaderRequest, opts ...grpc.CallOption) (*GetLeaderResponse, error) {
-	out := new(GetLeaderResponse)
-	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/GetLeader", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DKGCommitRequest) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return 0
 }
 
-func (c *cosignerClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DKGCommitRequest) GetParticipants() []int32 {
+	if m != nil {
+		return m.Participants
 	}
-	return out, nil
+	return nil
 }
 
-// CosignerServer is the server API for Cosigner service.
-type CosignerServer interface {
-	SignBlock(context.Context, *SignBlockRequest) (*SignBlockResponse, error)
-	SetNoncesAndSign(context.Context, *SetNoncesAndSignRequest) (*SetNoncesAndSignResponse, error)
-	GetNonces(context.Context, *GetNoncesRequest) (*GetNoncesResponse, error)
-	TransferLeadership(context.Context, *TransferLeadershipRequest) (*TransferLeadershipResponse, error)
-	GetLeader(context.Context, *GetLeaderRequest) (*GetLeaderResponse, error)
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
+func (m *DKGCommitRequest) GetMode() DKGMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DKGMode_DKG_MODE_GENERATE
 }
 
-// UnimplementedCosignerServer can be embedded to have forward compatible implementations.
-type UnimplementedCosignerServer struct {
+type DKGCommitResponse struct {
+	Package *DKGPackage `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
 }
 
-func (*UnimplementedCosignerServer) SignBlock(ctx context.Context, req *SignBlockRequest) (*SignBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignBlock not implemented")
+func (m *DKGCommitResponse) Reset()         { *m = DKGCommitResponse{} }
+func (m *DKGCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*DKGCommitResponse) ProtoMessage()    {}
+func (*DKGCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{22}
 }
-func (*UnimplementedCosignerServer) SetNoncesAndSign(ctx context.Context, req *SetNoncesAndSignRequest) (*SetNoncesAndSignResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetNoncesAndSign not implemented")
+func (m *DKGCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedCosignerServer) GetNonces(ctx context.Context, req *GetNoncesRequest) (*GetNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNonces not implemented")
+func (m *DKGCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedCosignerServer) TransferLeadership(ctx context.Context, req *TransferLeadershipRequest) (*TransferLeadershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+func (m *DKGCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGCommitResponse.Merge(m, src)
 }
-func (*UnimplementedCosignerServer) GetLeader(ctx context.Context, req *GetLeaderRequest) (*GetLeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLeader not implemented")
+func (m *DKGCommitResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedCosignerServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (m *DKGCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGCommitResponse.DiscardUnknown(m)
 }
 
-func RegisterCosignerServer(s grpc1.Server, srv CosignerServer) {
-	s.RegisterService(&_Cosigner_serviceDesc, srv)
-}
+var xxx_messageInfo_DKGCommitResponse proto.InternalMessageInfo
 
-func _Cosigner_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CosignerServer).SignBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove.horcrux.Cosigner/SignBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CosignerServer).SignBlock(ctx, req.(*SignBlockRequest))
+func (m *DKGCommitResponse) GetPackage() *DKGPackage {
+	if m != nil {
+		return m.Package
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Cosigner_SetNoncesAndSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetNoncesAndSignRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CosignerServer).SetNoncesAndSign(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove.horcrux.Cosigner/SetNoncesAndSign",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CosignerServer).SetNoncesAndSign(ctx, req.(*SetNoncesAndSignRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DKGDealRequest struct {
+	SessionID []byte        `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Packages  []*DKGPackage `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
 }
 
-func _Cosigner_GetNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNoncesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CosignerServer).GetNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove.horcrux.Cosigner/GetNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CosignerServer).GetNonces(ctx, req.(*GetNoncesRequest))
+func (m *DKGDealRequest) Reset()         { *m = DKGDealRequest{} }
+func (m *DKGDealRequest) String() string { return proto.CompactTextString(m) }
+func (*DKGDealRequest) ProtoMessage()    {}
+func (*DKGDealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{23}
+}
+func (m *DKGDealRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGDealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGDealRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DKGDealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGDealRequest.Merge(m, src)
+}
+func (m *DKGDealRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGDealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGDealRequest.DiscardUnknown(m)
 }
 
-func _Cosigner_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferLeadershipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CosignerServer).TransferLeadership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove.horcrux.Cosigner/TransferLeadership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CosignerServer).TransferLeadership(ctx, req.(*TransferLeadershipRequest))
+var xxx_messageInfo_DKGDealRequest proto.InternalMessageInfo
+
+func (m *DKGDealRequest) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Cosigner_GetLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CosignerServer).GetLeader(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove.horcrux.Cosigner/GetLeader",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CosignerServer).GetLeader(ctx, req.(*GetLeaderRequest))
+func (m *DKGDealRequest) GetPackages() []*DKGPackage {
+	if m != nil {
+		return m.Packages
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Cosigner_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CosignerServer).Ping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove.horcrux.Cosigner/Ping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CosignerServer).Ping(ctx, req.(*PingRequest))
+type DKGDealResponse struct {
+	Shares []*Nonce `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *DKGDealResponse) Reset()         { *m = DKGDealResponse{} }
+func (m *DKGDealResponse) String() string { return proto.CompactTextString(m) }
+func (*DKGDealResponse) ProtoMessage()    {}
+func (*DKGDealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{24}
+}
+func (m *DKGDealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGDealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGDealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DKGDealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGDealResponse.Merge(m, src)
+}
+func (m *DKGDealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGDealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGDealResponse.DiscardUnknown(m)
 }
 
-var _Cosigner_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "strangelove.horcrux.Cosigner",
-	HandlerType: (*CosignerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SignBlock",
-			Handler:    _Cosigner_SignBlock_Handler,
-		},
-		{
-			MethodName: "SetNoncesAndSign",
-			Handler:    _Cosigner_SetNoncesAndSign_Handler,
-		},
-		{
-			MethodName: "GetNonces",
-			Handler:    _Cosigner_GetNonces_Handler,
-		},
-		{
-			MethodName: "TransferLeadership",
-			Handler:    _Cosigner_TransferLeadership_Handler,
-		},
-		{
-			MethodName: "GetLeader",
-			Handler:    _Cosigner_GetLeader_Handler,
-		},
-		{
-			MethodName: "Ping",
-			Handler:    _Cosigner_Ping_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "strangelove/horcrux/cosigner.proto",
-}
+var xxx_messageInfo_DKGDealResponse proto.InternalMessageInfo
 
-func (m *Block) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DKGDealResponse) GetShares() []*Nonce {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Block) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DKGFinalizeRequest struct {
+	SessionID []byte   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Shares    []*Nonce `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.VoteExtSignBytes) > 0 {
-		i -= len(m.VoteExtSignBytes)
-		copy(dAtA[i:], m.VoteExtSignBytes)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignBytes)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SignBytes) > 0 {
-		i -= len(m.SignBytes)
-		copy(dAtA[i:], m.SignBytes)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SignBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Step != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Step))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Round != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Round))
-		i--
-		dAtA[i] = 0x10
+func (m *DKGFinalizeRequest) Reset()         { *m = DKGFinalizeRequest{} }
+func (m *DKGFinalizeRequest) String() string { return proto.CompactTextString(m) }
+func (*DKGFinalizeRequest) ProtoMessage()    {}
+func (*DKGFinalizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{25}
+}
+func (m *DKGFinalizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGFinalizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGFinalizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DKGFinalizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGFinalizeRequest.Merge(m, src)
+}
+func (m *DKGFinalizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGFinalizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGFinalizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGFinalizeRequest proto.InternalMessageInfo
+
+func (m *DKGFinalizeRequest) GetSessionID() []byte {
+	if m != nil {
+		return m.SessionID
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DKGFinalizeRequest) GetShares() []*Nonce {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DKGFinalizeResponse struct {
+	PubKey         []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	TranscriptHash []byte `protobuf:"bytes,2,opt,name=transcriptHash,proto3" json:"transcriptHash,omitempty"`
 }
 
-func (m *SignBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCosigner(dAtA, i, uint64(size))
+func (m *DKGFinalizeResponse) Reset()         { *m = DKGFinalizeResponse{} }
+func (m *DKGFinalizeResponse) String() string { return proto.CompactTextString(m) }
+func (*DKGFinalizeResponse) ProtoMessage()    {}
+func (*DKGFinalizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{26}
+}
+func (m *DKGFinalizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGFinalizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGFinalizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DKGFinalizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGFinalizeResponse.Merge(m, src)
+}
+func (m *DKGFinalizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGFinalizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGFinalizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGFinalizeResponse proto.InternalMessageInfo
+
+func (m *DKGFinalizeResponse) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DKGFinalizeResponse) GetTranscriptHash() []byte {
+	if m != nil {
+		return m.TranscriptHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RefreshShardsRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func (m *SignBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VoteExtSignature) > 0 {
-		i -= len(m.VoteExtSignature)
-		copy(dAtA[i:], m.VoteExtSignature)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignature)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0xa
+func (m *RefreshShardsRequest) Reset()         { *m = RefreshShardsRequest{} }
+func (m *RefreshShardsRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshShardsRequest) ProtoMessage()    {}
+func (*RefreshShardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{27}
+}
+func (m *RefreshShardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshShardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshShardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RefreshShardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshShardsRequest.Merge(m, src)
+}
+func (m *RefreshShardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshShardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshShardsRequest.DiscardUnknown(m)
 }
 
-func (m *Nonce) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RefreshShardsRequest proto.InternalMessageInfo
+
+func (m *RefreshShardsRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Nonce) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RefreshShardsResponse struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 }
 
-func (m *Nonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
+func (m *RefreshShardsResponse) Reset()         { *m = RefreshShardsResponse{} }
+func (m *RefreshShardsResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshShardsResponse) ProtoMessage()    {}
+func (*RefreshShardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{28}
+}
+func (m *RefreshShardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshShardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshShardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Share) > 0 {
-		i -= len(m.Share)
-		copy(dAtA[i:], m.Share)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Share)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *RefreshShardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshShardsResponse.Merge(m, src)
+}
+func (m *RefreshShardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshShardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshShardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshShardsResponse proto.InternalMessageInfo
+
+func (m *RefreshShardsResponse) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+type ChangeMembershipRequest struct {
+	Op      MembershipOp `protobuf:"varint,1,opt,name=op,proto3,enum=strangelove.horcrux.MembershipOp" json:"op,omitempty"`
+	ShardID int32        `protobuf:"varint,2,opt,name=shardID,proto3" json:"shardID,omitempty"`
+	P2PAddr string       `protobuf:"bytes,3,opt,name=p2pAddr,proto3" json:"p2pAddr,omitempty"`
+}
+
+func (m *ChangeMembershipRequest) Reset()         { *m = ChangeMembershipRequest{} }
+func (m *ChangeMembershipRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeMembershipRequest) ProtoMessage()    {}
+func (*ChangeMembershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{29}
+}
+func (m *ChangeMembershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeMembershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeMembershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.DestinationID != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.DestinationID))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ChangeMembershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMembershipRequest.Merge(m, src)
+}
+func (m *ChangeMembershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeMembershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeMembershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeMembershipRequest proto.InternalMessageInfo
+
+func (m *ChangeMembershipRequest) GetOp() MembershipOp {
+	if m != nil {
+		return m.Op
 	}
-	if m.SourceID != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.SourceID))
-		i--
-		dAtA[i] = 0x8
+	return MembershipOp_MEMBERSHIP_OP_ADD
+}
+
+func (m *ChangeMembershipRequest) GetShardID() int32 {
+	if m != nil {
+		return m.ShardID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UUIDNonce) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChangeMembershipRequest) GetP2PAddr() string {
+	if m != nil {
+		return m.P2PAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UUIDNonce) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ChangeMembershipResponse struct {
+	ShardID int32 `protobuf:"varint,1,opt,name=shardID,proto3" json:"shardID,omitempty"`
 }
 
-func (m *UUIDNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nonces) > 0 {
-		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCosigner(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *ChangeMembershipResponse) Reset()         { *m = ChangeMembershipResponse{} }
+func (m *ChangeMembershipResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeMembershipResponse) ProtoMessage()    {}
+func (*ChangeMembershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{30}
+}
+func (m *ChangeMembershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeMembershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeMembershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Uuid) > 0 {
-		i -= len(m.Uuid)
-		copy(dAtA[i:], m.Uuid)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ChangeMembershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMembershipResponse.Merge(m, src)
+}
+func (m *ChangeMembershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeMembershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeMembershipResponse.DiscardUnknown(m)
 }
 
-func (m *HRST) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChangeMembershipResponse proto.InternalMessageInfo
+
+func (m *ChangeMembershipResponse) GetShardID() int32 {
+	if m != nil {
+		return m.ShardID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HRST) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReshareShardsRequest struct {
+	Threshold int32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *HRST) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
+func (m *ReshareShardsRequest) Reset()         { *m = ReshareShardsRequest{} }
+func (m *ReshareShardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReshareShardsRequest) ProtoMessage()    {}
+func (*ReshareShardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{31}
+}
+func (m *ReshareShardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReshareShardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReshareShardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Step != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Step))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *ReshareShardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshareShardsRequest.Merge(m, src)
+}
+func (m *ReshareShardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReshareShardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshareShardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshareShardsRequest proto.InternalMessageInfo
+
+func (m *ReshareShardsRequest) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
 	}
-	if m.Round != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Round))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+type ReshareShardsResponse struct {
+	ChainIDs  []string `protobuf:"bytes,1,rep,name=chainIDs,proto3" json:"chainIDs,omitempty"`
+	Threshold int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *ReshareShardsResponse) Reset()         { *m = ReshareShardsResponse{} }
+func (m *ReshareShardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReshareShardsResponse) ProtoMessage()    {}
+func (*ReshareShardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{32}
+}
+func (m *ReshareShardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReshareShardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReshareShardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ReshareShardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReshareShardsResponse.Merge(m, src)
+}
+func (m *ReshareShardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReshareShardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReshareShardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReshareShardsResponse proto.InternalMessageInfo
+
+func (m *ReshareShardsResponse) GetChainIDs() []string {
+	if m != nil {
+		return m.ChainIDs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SetNoncesAndSignRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReshareShardsResponse) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SetNoncesAndSignRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type FreezeWindow struct {
+	// start and end in RFC 3339
+	Start  string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End    string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *SetNoncesAndSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.VoteExtSignBytes) > 0 {
-		i -= len(m.VoteExtSignBytes)
-		copy(dAtA[i:], m.VoteExtSignBytes)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignBytes)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.VoteExtNonces) > 0 {
-		for iNdEx := len(m.VoteExtNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VoteExtNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCosigner(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+func (m *FreezeWindow) Reset()         { *m = FreezeWindow{} }
+func (m *FreezeWindow) String() string { return proto.CompactTextString(m) }
+func (*FreezeWindow) ProtoMessage()    {}
+func (*FreezeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{33}
+}
+func (m *FreezeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreezeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreezeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.VoteExtUuid) > 0 {
-		i -= len(m.VoteExtUuid)
-		copy(dAtA[i:], m.VoteExtUuid)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtUuid)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *FreezeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeWindow.Merge(m, src)
+}
+func (m *FreezeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreezeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeWindow proto.InternalMessageInfo
+
+func (m *FreezeWindow) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	if len(m.SignBytes) > 0 {
-		i -= len(m.SignBytes)
-		copy(dAtA[i:], m.SignBytes)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SignBytes)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *FreezeWindow) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	if m.Hrst != nil {
-		{
-			size, err := m.Hrst.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCosigner(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Nonces) > 0 {
-		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCosigner(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Uuid) > 0 {
-		i -= len(m.Uuid)
-		copy(dAtA[i:], m.Uuid)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SetNoncesAndSignResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FreezeWindow) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SetNoncesAndSignResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SignFreeze struct {
+	ChainID    string          `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	HaltHeight int64           `protobuf:"varint,2,opt,name=haltHeight,proto3" json:"haltHeight,omitempty"`
+	Windows    []*FreezeWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
 }
 
-func (m *SetNoncesAndSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoteExtSignature) > 0 {
-		i -= len(m.VoteExtSignature)
-		copy(dAtA[i:], m.VoteExtSignature)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.VoteExtNoncePublic) > 0 {
-		i -= len(m.VoteExtNoncePublic)
-		copy(dAtA[i:], m.VoteExtNoncePublic)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtNoncePublic)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
+func (m *SignFreeze) Reset()         { *m = SignFreeze{} }
+func (m *SignFreeze) String() string { return proto.CompactTextString(m) }
+func (*SignFreeze) ProtoMessage()    {}
+func (*SignFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{34}
+}
+func (m *SignFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.NoncePublic) > 0 {
-		i -= len(m.NoncePublic)
-		copy(dAtA[i:], m.NoncePublic)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.NoncePublic)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SignFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignFreeze.Merge(m, src)
+}
+func (m *SignFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignFreeze proto.InternalMessageInfo
+
+func (m *SignFreeze) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *SignFreeze) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignFreeze) GetWindows() []*FreezeWindow {
+	if m != nil {
+		return m.Windows
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetSignFreezeRequest struct {
+	// freeze replaces the runtime freeze of its chain, or clears it if it has no halt height or windows.
+	Freeze *SignFreeze `protobuf:"bytes,1,opt,name=freeze,proto3" json:"freeze,omitempty"`
 }
 
-func (m *GetNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uuids) > 0 {
-		for iNdEx := len(m.Uuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Uuids[iNdEx])
-			copy(dAtA[i:], m.Uuids[iNdEx])
-			i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *SetSignFreezeRequest) Reset()         { *m = SetSignFreezeRequest{} }
+func (m *SetSignFreezeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSignFreezeRequest) ProtoMessage()    {}
+func (*SetSignFreezeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{35}
+}
+func (m *SetSignFreezeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSignFreezeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSignFreezeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SetSignFreezeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSignFreezeRequest.Merge(m, src)
+}
+func (m *SetSignFreezeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetSignFreezeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSignFreezeRequest.DiscardUnknown(m)
 }
 
-func (m *GetNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetSignFreezeRequest proto.InternalMessageInfo
+
+func (m *SetSignFreezeRequest) GetFreeze() *SignFreeze {
+	if m != nil {
+		return m.Freeze
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetSignFreezeResponse struct {
 }
 
-func (m *GetNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nonces) > 0 {
-		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCosigner(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *SetSignFreezeResponse) Reset()         { *m = SetSignFreezeResponse{} }
+func (m *SetSignFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*SetSignFreezeResponse) ProtoMessage()    {}
+func (*SetSignFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{36}
+}
+func (m *SetSignFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSignFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSignFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SetSignFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSignFreezeResponse.Merge(m, src)
+}
+func (m *SetSignFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetSignFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSignFreezeResponse.DiscardUnknown(m)
 }
 
-func (m *TransferLeadershipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetSignFreezeResponse proto.InternalMessageInfo
+
+type GetSignFreezesRequest struct {
+}
+
+func (m *GetSignFreezesRequest) Reset()         { *m = GetSignFreezesRequest{} }
+func (m *GetSignFreezesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSignFreezesRequest) ProtoMessage()    {}
+func (*GetSignFreezesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{37}
+}
+func (m *GetSignFreezesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSignFreezesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSignFreezesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GetSignFreezesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSignFreezesRequest.Merge(m, src)
+}
+func (m *GetSignFreezesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSignFreezesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSignFreezesRequest.DiscardUnknown(m)
 }
 
-func (m *TransferLeadershipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetSignFreezesRequest proto.InternalMessageInfo
+
+type GetSignFreezesResponse struct {
+	Freezes []*SignFreeze `protobuf:"bytes,1,rep,name=freezes,proto3" json:"freezes,omitempty"`
 }
 
-func (m *TransferLeadershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaderID) > 0 {
-		i -= len(m.LeaderID)
-		copy(dAtA[i:], m.LeaderID)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.LeaderID)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetSignFreezesResponse) Reset()         { *m = GetSignFreezesResponse{} }
+func (m *GetSignFreezesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSignFreezesResponse) ProtoMessage()    {}
+func (*GetSignFreezesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7a1f695b94b848a, []int{38}
+}
+func (m *GetSignFreezesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSignFreezesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSignFreezesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetSignFreezesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSignFreezesResponse.Merge(m, src)
+}
+func (m *GetSignFreezesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSignFreezesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSignFreezesResponse.DiscardUnknown(m)
 }
 
-func (m *TransferLeadershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSignFreezesResponse proto.InternalMessageInfo
+
+func (m *GetSignFreezesResponse) GetFreezes() []*SignFreeze {
+	if m != nil {
+		return m.Freezes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TransferLeadershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() {
+	proto.RegisterEnum("strangelove.horcrux.DKGMode", DKGMode_name, DKGMode_value)
+	proto.RegisterEnum("strangelove.horcrux.MembershipOp", MembershipOp_name, MembershipOp_value)
+	proto.RegisterType((*Block)(nil), "strangelove.horcrux.Block")
+	proto.RegisterType((*SignBlockRequest)(nil), "strangelove.horcrux.SignBlockRequest")
+	proto.RegisterType((*SignBlockResponse)(nil), "strangelove.horcrux.SignBlockResponse")
+	proto.RegisterType((*Nonce)(nil), "strangelove.horcrux.Nonce")
+	proto.RegisterType((*UUIDNonce)(nil), "strangelove.horcrux.UUIDNonce")
+	proto.RegisterType((*HRST)(nil), "strangelove.horcrux.HRST")
+	proto.RegisterType((*SetNoncesAndSignRequest)(nil), "strangelove.horcrux.SetNoncesAndSignRequest")
+	proto.RegisterType((*SetNoncesAndSignResponse)(nil), "strangelove.horcrux.SetNoncesAndSignResponse")
+	proto.RegisterType((*FROSTCommitment)(nil), "strangelove.horcrux.FROSTCommitment")
+	proto.RegisterType((*FROSTCommitRequest)(nil), "strangelove.horcrux.FROSTCommitRequest")
+	proto.RegisterType((*FROSTCommitResponse)(nil), "strangelove.horcrux.FROSTCommitResponse")
+	proto.RegisterType((*FROSTSignRequest)(nil), "strangelove.horcrux.FROSTSignRequest")
+	proto.RegisterType((*GetNoncesRequest)(nil), "strangelove.horcrux.GetNoncesRequest")
+	proto.RegisterType((*GetNoncesResponse)(nil), "strangelove.horcrux.GetNoncesResponse")
+	proto.RegisterType((*TransferLeadershipRequest)(nil), "strangelove.horcrux.TransferLeadershipRequest")
+	proto.RegisterType((*TransferLeadershipResponse)(nil), "strangelove.horcrux.TransferLeadershipResponse")
+	proto.RegisterType((*GetLeaderRequest)(nil), "strangelove.horcrux.GetLeaderRequest")
+	proto.RegisterType((*GetLeaderResponse)(nil), "strangelove.horcrux.GetLeaderResponse")
+	proto.RegisterType((*PingRequest)(nil), "strangelove.horcrux.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "strangelove.horcrux.PingResponse")
+	proto.RegisterType((*DKGPackage)(nil), "strangelove.horcrux.DKGPackage")
+	proto.RegisterType((*DKGCommitRequest)(nil), "strangelove.horcrux.DKGCommitRequest")
+	proto.RegisterType((*DKGCommitResponse)(nil), "strangelove.horcrux.DKGCommitResponse")
+	proto.RegisterType((*DKGDealRequest)(nil), "strangelove.horcrux.DKGDealRequest")
+	proto.RegisterType((*DKGDealResponse)(nil), "strangelove.horcrux.DKGDealResponse")
+	proto.RegisterType((*DKGFinalizeRequest)(nil), "strangelove.horcrux.DKGFinalizeRequest")
+	proto.RegisterType((*DKGFinalizeResponse)(nil), "strangelove.horcrux.DKGFinalizeResponse")
+	proto.RegisterType((*RefreshShardsRequest)(nil), "strangelove.horcrux.RefreshShardsRequest")
+	proto.RegisterType((*RefreshShardsResponse)(nil), "strangelove.horcrux.RefreshShardsResponse")
+	proto.RegisterType((*ChangeMembershipRequest)(nil), "strangelove.horcrux.ChangeMembershipRequest")
+	proto.RegisterType((*ChangeMembershipResponse)(nil), "strangelove.horcrux.ChangeMembershipResponse")
+	proto.RegisterType((*ReshareShardsRequest)(nil), "strangelove.horcrux.ReshareShardsRequest")
+	proto.RegisterType((*ReshareShardsResponse)(nil), "strangelove.horcrux.ReshareShardsResponse")
+	proto.RegisterType((*FreezeWindow)(nil), "strangelove.horcrux.FreezeWindow")
+	proto.RegisterType((*SignFreeze)(nil), "strangelove.horcrux.SignFreeze")
+	proto.RegisterType((*SetSignFreezeRequest)(nil), "strangelove.horcrux.SetSignFreezeRequest")
+	proto.RegisterType((*SetSignFreezeResponse)(nil), "strangelove.horcrux.SetSignFreezeResponse")
+	proto.RegisterType((*GetSignFreezesRequest)(nil), "strangelove.horcrux.GetSignFreezesRequest")
+	proto.RegisterType((*GetSignFreezesResponse)(nil), "strangelove.horcrux.GetSignFreezesResponse")
+}
 
-func (m *TransferLeadershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaderAddress) > 0 {
-		i -= len(m.LeaderAddress)
-		copy(dAtA[i:], m.LeaderAddress)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.LeaderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LeaderID) > 0 {
-		i -= len(m.LeaderID)
-		copy(dAtA[i:], m.LeaderID)
-		i = encodeVarintCosigner(dAtA, i, uint64(len(m.LeaderID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("strangelove/horcrux/cosigner.proto", fileDescriptor_b7a1f695b94b848a)
 }
 
-func (m *GetLeaderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_b7a1f695b94b848a = []byte{
+	// 1760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x18, 0x5d, 0x4f, 0xdb, 0xdc,
+	0x19, 0xe7, 0x0b, 0xf2, 0x24, 0xf0, 0x86, 0x53, 0x28, 0xa9, 0xf5, 0x2a, 0xcb, 0x7b, 0xd6, 0x31,
+	0x96, 0xb7, 0x40, 0x97, 0x56, 0xab, 0xa6, 0xde, 0x0c, 0x48, 0x08, 0x15, 0xe5, 0xa3, 0x0e, 0x74,
+	0x52, 0xd5, 0x15, 0x39, 0xc9, 0x81, 0x58, 0x24, 0x76, 0x6a, 0x3b, 0xd0, 0x56, 0x9a, 0x34, 0x69,
+	0x7f, 0x60, 0x7f, 0x65, 0x7f, 0x60, 0xd2, 0xb4, 0x5d, 0xec, 0xb2, 0x17, 0xbb, 0xd8, 0x65, 0xd5,
+	0xfe, 0x91, 0xe9, 0x7c, 0xd8, 0x39, 0x76, 0xec, 0x24, 0xad, 0x7a, 0x45, 0x9e, 0xc7, 0xcf, 0xf7,
+	0xf7, 0x01, 0xb0, 0xe3, 0xda, 0xba, 0x79, 0x45, 0x7a, 0xd6, 0x0d, 0xd9, 0xee, 0x5a, 0x76, 0xdb,
+	0x1e, 0xbe, 0xdb, 0x6e, 0x5b, 0x8e, 0x71, 0x65, 0x12, 0x7b, 0x6b, 0x60, 0x5b, 0xae, 0x85, 0xee,
+	0x48, 0x34, 0x5b, 0x82, 0x06, 0xff, 0x5d, 0x81, 0xf4, 0x6e, 0xcf, 0x6a, 0x5f, 0xa3, 0xbb, 0x90,
+	0xe9, 0x12, 0xe3, 0xaa, 0xeb, 0x16, 0x95, 0xb2, 0xb2, 0x91, 0xd4, 0x04, 0x84, 0x56, 0x20, 0x6d,
+	0x5b, 0x43, 0xb3, 0x53, 0x4c, 0x30, 0x34, 0x07, 0x10, 0x82, 0x94, 0xe3, 0x92, 0x41, 0x31, 0x59,
+	0x56, 0x36, 0xd2, 0x1a, 0xfb, 0x8d, 0x7e, 0x84, 0x2c, 0x55, 0xb8, 0xfb, 0xde, 0x25, 0x4e, 0x31,
+	0x55, 0x56, 0x36, 0xf2, 0xda, 0x08, 0x81, 0x2a, 0x50, 0xb8, 0xb1, 0x5c, 0x52, 0x7f, 0xe7, 0x36,
+	0x7d, 0xa2, 0x34, 0x23, 0x1a, 0xc3, 0x53, 0x49, 0xae, 0xd1, 0x27, 0x8e, 0xab, 0xf7, 0x07, 0xc5,
+	0x0c, 0xd3, 0x3b, 0x42, 0xe0, 0x37, 0x50, 0x60, 0xa4, 0xd4, 0x6c, 0x8d, 0xbc, 0x1d, 0x12, 0xc7,
+	0x45, 0x45, 0x98, 0x6f, 0x77, 0x75, 0xc3, 0x7c, 0x56, 0x63, 0xe6, 0x67, 0x35, 0x0f, 0x44, 0x0f,
+	0x21, 0xdd, 0xa2, 0x94, 0xcc, 0xfe, 0x5c, 0x55, 0xdd, 0x8a, 0x08, 0xc3, 0x16, 0x97, 0xc5, 0x09,
+	0xf1, 0x9f, 0x61, 0x59, 0x92, 0xef, 0x0c, 0x2c, 0xd3, 0x21, 0x9e, 0x73, 0xba, 0x3b, 0xb4, 0x49,
+	0x51, 0x19, 0x39, 0xc7, 0x10, 0xe8, 0x01, 0x20, 0xea, 0xc4, 0x05, 0x79, 0xe7, 0x5e, 0x8c, 0xc8,
+	0x12, 0x63, 0xee, 0x71, 0xea, 0x80, 0x7b, 0xc9, 0xb0, 0x7b, 0xff, 0x54, 0x20, 0x7d, 0x6c, 0x99,
+	0x6d, 0x82, 0x54, 0x58, 0x70, 0xac, 0xa1, 0xdd, 0x26, 0xc2, 0xab, 0xb4, 0xe6, 0xc3, 0xe8, 0x3e,
+	0x2c, 0x76, 0x88, 0xe3, 0x1a, 0xa6, 0xee, 0x1a, 0x16, 0x75, 0x3b, 0xc1, 0x08, 0x82, 0x48, 0x9a,
+	0xd4, 0xc1, 0xb0, 0x75, 0x48, 0xde, 0x33, 0x35, 0x79, 0x4d, 0x40, 0x34, 0xa9, 0x4e, 0x57, 0xb7,
+	0x89, 0x48, 0x13, 0x07, 0x82, 0x3e, 0xa6, 0xc3, 0x3e, 0x56, 0xa0, 0xc0, 0xc8, 0xf6, 0xac, 0x7e,
+	0xdf, 0x70, 0xfb, 0xc4, 0x74, 0x9d, 0x62, 0xa6, 0x9c, 0xa4, 0x1e, 0x86, 0xf1, 0xb8, 0x09, 0xd9,
+	0xf3, 0xf3, 0x67, 0x35, 0xee, 0x06, 0x82, 0xd4, 0x70, 0x68, 0x74, 0x44, 0xd4, 0xd8, 0x6f, 0x54,
+	0x85, 0x8c, 0x49, 0x3f, 0x3a, 0xc5, 0x44, 0x39, 0x19, 0x9b, 0x16, 0xc6, 0xaf, 0x09, 0x4a, 0x7c,
+	0x09, 0xa9, 0x03, 0xad, 0x79, 0xf6, 0x7d, 0x2a, 0x75, 0x94, 0x80, 0x54, 0x38, 0x01, 0xff, 0x4e,
+	0xc2, 0x5a, 0x93, 0xb8, 0x4c, 0xb9, 0xb3, 0x63, 0x76, 0x68, 0xe2, 0xbc, 0x3a, 0xfb, 0x4e, 0xbe,
+	0xa0, 0x4d, 0x48, 0x75, 0x6d, 0xc7, 0x65, 0x56, 0xe5, 0xaa, 0xf7, 0x22, 0x39, 0xa8, 0xb3, 0x1a,
+	0x23, 0x9b, 0xd2, 0x5a, 0x65, 0xc8, 0x89, 0x1a, 0x3b, 0xa7, 0xb6, 0xf1, 0xcc, 0xc9, 0x28, 0xf4,
+	0x07, 0x58, 0x14, 0x20, 0xf7, 0xaa, 0x98, 0x99, 0x6a, 0x69, 0x90, 0x21, 0xb2, 0x7d, 0xe7, 0x63,
+	0xda, 0x57, 0x6a, 0xc6, 0x85, 0x60, 0x33, 0x62, 0xc8, 0xb3, 0x00, 0xd4, 0x88, 0xde, 0x23, 0xb6,
+	0x53, 0xcc, 0x96, 0x93, 0x1b, 0x69, 0x2d, 0x80, 0xa3, 0x9a, 0x6c, 0xfd, 0x96, 0x49, 0x3a, 0x37,
+	0x8d, 0xb7, 0x43, 0x5a, 0xfd, 0xc0, 0xc4, 0x8c, 0xe1, 0x69, 0x87, 0x78, 0xb8, 0x62, 0x8e, 0x59,
+	0xe3, 0xc3, 0xf8, 0xbf, 0x0a, 0x14, 0xc7, 0xd3, 0x38, 0x6a, 0xe7, 0x51, 0x05, 0x28, 0xa1, 0x0a,
+	0xa0, 0x01, 0x65, 0x26, 0x9d, 0x0e, 0x5b, 0x3d, 0xa3, 0x2d, 0xfa, 0x58, 0x46, 0x05, 0x5b, 0x25,
+	0x19, 0x6e, 0x95, 0x2d, 0x40, 0x72, 0xf4, 0x84, 0x18, 0x9e, 0xb7, 0x88, 0x2f, 0xa1, 0xe0, 0xca,
+	0xfd, 0x37, 0x86, 0xc7, 0xb7, 0xf0, 0xc3, 0xbe, 0x76, 0xd2, 0x3c, 0x1b, 0xb5, 0x5b, 0x64, 0x51,
+	0x96, 0x00, 0xbc, 0xf9, 0xef, 0x0f, 0x07, 0x09, 0xc3, 0x9a, 0xc8, 0xe8, 0x18, 0xe6, 0x95, 0x37,
+	0x19, 0x38, 0x44, 0x73, 0xd7, 0x32, 0x4c, 0xf6, 0x81, 0xdb, 0xeb, 0x81, 0xb8, 0x02, 0x48, 0x52,
+	0xec, 0x35, 0xc4, 0x0a, 0xa4, 0xdb, 0xd6, 0xd0, 0x74, 0xc5, 0x80, 0xe2, 0x00, 0xfe, 0x13, 0xdc,
+	0x09, 0xd0, 0x8a, 0xa8, 0xef, 0x43, 0xae, 0x2d, 0x4d, 0x0f, 0x85, 0x15, 0xe1, 0xfd, 0xc8, 0x22,
+	0x0c, 0xf9, 0xa8, 0xc9, 0x8c, 0xf8, 0x5f, 0x09, 0x28, 0x30, 0x02, 0xb9, 0x35, 0xe3, 0x57, 0x80,
+	0xd7, 0x6c, 0x89, 0x6f, 0x68, 0xb6, 0x64, 0xb8, 0xd9, 0x42, 0x3e, 0xa4, 0xbe, 0xd1, 0x87, 0xaf,
+	0xda, 0x87, 0x67, 0x7e, 0x3d, 0x85, 0x87, 0xef, 0xac, 0xaa, 0x23, 0xf8, 0xf1, 0x06, 0x14, 0x1a,
+	0x5e, 0x7f, 0x48, 0xe9, 0xa4, 0xe5, 0xc3, 0x73, 0x93, 0xd7, 0x38, 0x80, 0x0f, 0x61, 0x59, 0xa2,
+	0x14, 0xc9, 0xfc, 0x9d, 0x3f, 0xf6, 0x78, 0x1e, 0x4b, 0x91, 0x86, 0xf8, 0x6b, 0xc0, 0x1f, 0xe3,
+	0x4f, 0xe0, 0xde, 0x99, 0xad, 0x9b, 0xce, 0x25, 0xb1, 0x9f, 0x13, 0xbd, 0x43, 0x6c, 0xa7, 0x6b,
+	0x0c, 0x3c, 0xfd, 0x2a, 0x2c, 0xf4, 0x18, 0xd2, 0xcf, 0xa2, 0x0f, 0xe3, 0x37, 0xa0, 0x46, 0x31,
+	0x0a, 0x73, 0x26, 0x70, 0xd2, 0x65, 0xc9, 0x7f, 0xef, 0x74, 0x3a, 0x36, 0x71, 0x1c, 0x56, 0x09,
+	0x59, 0x2d, 0x88, 0xc4, 0x88, 0xc5, 0x83, 0x8b, 0x16, 0xf6, 0xe0, 0x9f, 0x61, 0x59, 0xc2, 0x09,
+	0x55, 0x77, 0x21, 0xc3, 0x39, 0x45, 0xd1, 0x0b, 0x08, 0x2f, 0x42, 0xee, 0xd4, 0x30, 0xaf, 0x3c,
+	0xde, 0x25, 0xc8, 0x73, 0x90, 0xb3, 0xe1, 0x0f, 0x00, 0xb5, 0xc3, 0xc6, 0xa9, 0xde, 0xbe, 0xd6,
+	0xaf, 0x26, 0x2f, 0xf7, 0x72, 0xb0, 0xc6, 0x12, 0x2c, 0x17, 0x32, 0x8a, 0x2d, 0x76, 0xdb, 0xb2,
+	0x2e, 0x35, 0x7f, 0xb1, 0x33, 0xc8, 0xc7, 0x37, 0x45, 0xf7, 0x0a, 0x08, 0xff, 0x43, 0x81, 0x42,
+	0xed, 0xb0, 0x11, 0xec, 0x5d, 0x5a, 0xe8, 0xc4, 0x71, 0xf8, 0xfd, 0xe0, 0xdd, 0x34, 0x1e, 0x42,
+	0xee, 0xa7, 0x44, 0xb0, 0x9f, 0xe8, 0xf0, 0xec, 0xda, 0xc4, 0xe9, 0x5a, 0xbd, 0x8e, 0xd8, 0xab,
+	0x23, 0x04, 0x9d, 0xf1, 0x03, 0xdd, 0x76, 0x8d, 0xb6, 0x31, 0xd0, 0xbd, 0x0e, 0x49, 0x6b, 0x01,
+	0x1c, 0x7a, 0x08, 0xa9, 0xbe, 0xd5, 0xe1, 0x43, 0x6e, 0xa9, 0xfa, 0x63, 0x64, 0xe5, 0xd4, 0x0e,
+	0x1b, 0x47, 0x56, 0x87, 0x68, 0x8c, 0x12, 0x1f, 0xc3, 0xb2, 0x64, 0xbf, 0x48, 0xc4, 0xef, 0x61,
+	0x7e, 0xc0, 0xc3, 0xc9, 0xcc, 0xcf, 0x55, 0x7f, 0x11, 0x27, 0x49, 0x44, 0x5d, 0xf3, 0xe8, 0xf1,
+	0x35, 0x2c, 0xd5, 0x0e, 0x1b, 0x74, 0xe7, 0xcc, 0x16, 0x8d, 0xa7, 0xb0, 0x20, 0x58, 0xbd, 0x35,
+	0x3f, 0x55, 0x97, 0xcf, 0x80, 0xeb, 0xf0, 0x83, 0xaf, 0x4c, 0x98, 0x5e, 0x85, 0x0c, 0xbb, 0x9a,
+	0xbc, 0xee, 0x99, 0x78, 0x34, 0x70, 0x4a, 0x7c, 0x09, 0xa8, 0x76, 0xd8, 0xd8, 0x37, 0x4c, 0xbd,
+	0x67, 0x7c, 0x20, 0xb3, 0xd9, 0x3d, 0xd2, 0x93, 0x98, 0x59, 0xcf, 0x39, 0xdc, 0x09, 0xe8, 0x19,
+	0x95, 0xbd, 0x38, 0x26, 0x95, 0xc0, 0x31, 0xb9, 0x0e, 0x4b, 0x54, 0xa4, 0xd3, 0xb6, 0x8d, 0x81,
+	0x7b, 0xa0, 0x3b, 0x5d, 0xb1, 0x30, 0x43, 0x58, 0xfc, 0x10, 0x56, 0x34, 0x72, 0x49, 0xcb, 0xa4,
+	0xd9, 0xd5, 0xed, 0x8e, 0x33, 0x75, 0x70, 0xe3, 0x6d, 0x58, 0x0d, 0x71, 0x4c, 0x36, 0x05, 0xff,
+	0x45, 0x81, 0xb5, 0xbd, 0x2e, 0x75, 0xef, 0x88, 0xf4, 0x5b, 0xc1, 0xd1, 0xf2, 0x5b, 0x48, 0x58,
+	0x7c, 0xd7, 0x2f, 0x55, 0x7f, 0x8a, 0x8c, 0xc2, 0x88, 0xe7, 0x64, 0xa0, 0x25, 0xac, 0x01, 0xb5,
+	0x8c, 0x86, 0xa4, 0xe3, 0x6f, 0x50, 0x0f, 0xa4, 0x5f, 0x06, 0xd5, 0x01, 0x9d, 0x1c, 0xac, 0x01,
+	0xb2, 0x9a, 0x07, 0xe2, 0xc7, 0x50, 0x1c, 0xb7, 0x40, 0x98, 0x2d, 0xc9, 0x53, 0x02, 0xf2, 0xf0,
+	0x63, 0x1a, 0x1b, 0x0a, 0x90, 0x60, 0x6c, 0x02, 0xad, 0xa6, 0x84, 0x5a, 0x0d, 0xbf, 0x80, 0xd5,
+	0x10, 0xd7, 0x68, 0x18, 0x8a, 0x18, 0xf2, 0xfa, 0xca, 0x6a, 0x3e, 0x1c, 0x14, 0x99, 0x08, 0x8b,
+	0x3c, 0x86, 0xfc, 0xbe, 0x4d, 0xc8, 0x07, 0xf2, 0x47, 0xc3, 0xec, 0x58, 0xb7, 0xec, 0xa5, 0xe0,
+	0xea, 0xb6, 0x2b, 0x52, 0xc3, 0x01, 0x54, 0x80, 0x24, 0x11, 0x87, 0x76, 0x56, 0xa3, 0x3f, 0x69,
+	0x46, 0x6c, 0xa2, 0x3b, 0x96, 0x29, 0xe2, 0x21, 0x20, 0xfc, 0x57, 0x05, 0x80, 0x2e, 0x32, 0x2e,
+	0x74, 0xc2, 0x92, 0x2e, 0x01, 0x74, 0xf5, 0x9e, 0x7b, 0xc0, 0x2f, 0x7b, 0x7e, 0xc2, 0x4b, 0x18,
+	0xf4, 0x14, 0xe6, 0x6f, 0x99, 0x49, 0x74, 0x27, 0xd3, 0x4a, 0x8e, 0xce, 0xa1, 0x6c, 0xbc, 0xe6,
+	0x71, 0xe0, 0x13, 0x58, 0x69, 0x12, 0x77, 0x64, 0x87, 0x17, 0xde, 0x27, 0x90, 0xb9, 0x64, 0x88,
+	0x89, 0xf3, 0x43, 0xe2, 0x13, 0xe4, 0x78, 0x0d, 0x56, 0x43, 0x02, 0xc5, 0x90, 0x5f, 0x83, 0xd5,
+	0x86, 0xfc, 0xc1, 0xcb, 0x24, 0x6e, 0xc2, 0xdd, 0xf0, 0x87, 0xd1, 0x14, 0xe3, 0x52, 0xbd, 0x59,
+	0x30, 0xd5, 0x0a, 0x8f, 0xbe, 0xf2, 0x1c, 0xe6, 0xc5, 0x98, 0x44, 0xab, 0x6c, 0x40, 0x5e, 0x1c,
+	0x9d, 0xd4, 0xea, 0x17, 0x8d, 0xfa, 0x71, 0x5d, 0xdb, 0x39, 0xab, 0x17, 0xe6, 0xd0, 0x0a, 0x14,
+	0x7c, 0xb4, 0x56, 0xdf, 0xd7, 0xea, 0xcd, 0x83, 0x82, 0x12, 0xc2, 0x36, 0x0f, 0x76, 0xb4, 0x7a,
+	0x21, 0x51, 0x79, 0x05, 0x79, 0xb9, 0x05, 0xa8, 0xc8, 0xa3, 0xfa, 0xd1, 0x6e, 0x5d, 0x6b, 0x1e,
+	0x3c, 0x3b, 0xbd, 0x38, 0x39, 0xbd, 0xd8, 0xa9, 0xd5, 0x0a, 0x73, 0xa8, 0x08, 0x2b, 0x41, 0xb4,
+	0x56, 0x3f, 0x3a, 0x79, 0x59, 0x2f, 0x28, 0xe8, 0x1e, 0xac, 0x86, 0xbf, 0x9c, 0x3e, 0xdf, 0xd9,
+	0xab, 0x17, 0x12, 0xd5, 0x4f, 0x79, 0x58, 0xd8, 0x13, 0xe7, 0x27, 0x7a, 0x0d, 0x59, 0xff, 0x85,
+	0x8d, 0x7e, 0x15, 0xeb, 0xad, 0xfc, 0xc2, 0x57, 0xd7, 0xa7, 0x91, 0x89, 0x04, 0xcc, 0xa1, 0xb7,
+	0x50, 0x08, 0xdf, 0xfd, 0xe8, 0x41, 0x34, 0x77, 0xf4, 0x2b, 0x4f, 0xdd, 0x9c, 0x91, 0xda, 0x57,
+	0xf9, 0x1a, 0xb2, 0xfe, 0x81, 0x14, 0xe3, 0x50, 0xf8, 0xd4, 0x52, 0xd7, 0xa7, 0x91, 0xf9, 0xd2,
+	0x6f, 0x01, 0x8d, 0x1f, 0x3e, 0x68, 0x2b, 0x92, 0x3f, 0xf6, 0xb4, 0x52, 0xb7, 0x67, 0xa6, 0x0f,
+	0xb9, 0xc5, 0x3f, 0xc5, 0xbb, 0x15, 0xb8, 0x98, 0xd4, 0xf5, 0x69, 0x64, 0xbe, 0xf4, 0x23, 0x48,
+	0xd1, 0xfb, 0x08, 0x95, 0x23, 0x39, 0xa4, 0x4b, 0x4a, 0xfd, 0x69, 0x02, 0x85, 0x6c, 0xac, 0x7f,
+	0x21, 0xc4, 0x18, 0x1b, 0xbe, 0x80, 0xd4, 0xf5, 0x69, 0x64, 0xbe, 0xf4, 0x97, 0x30, 0x2f, 0x56,
+	0x38, 0xfa, 0x65, 0x1c, 0x93, 0x74, 0x4d, 0xa8, 0xf7, 0x27, 0x13, 0xf9, 0x72, 0x5b, 0x90, 0x93,
+	0x76, 0x2d, 0xfa, 0x75, 0x1c, 0x5b, 0x68, 0xeb, 0xab, 0x1b, 0xd3, 0x09, 0x7d, 0x1d, 0x5d, 0x58,
+	0x0c, 0xac, 0x51, 0xf4, 0x9b, 0x48, 0xe6, 0xa8, 0xe5, 0xac, 0x56, 0x66, 0x21, 0x95, 0x5b, 0x2f,
+	0xbc, 0xfc, 0x62, 0x5a, 0x2f, 0x66, 0x4b, 0xab, 0x9b, 0x33, 0x52, 0x07, 0x9d, 0x93, 0x76, 0x60,
+	0xac, 0x73, 0xe3, 0xdb, 0x55, 0xad, 0xcc, 0x42, 0x2a, 0x6b, 0x0a, 0xcc, 0xfc, 0x18, 0x4d, 0x51,
+	0x8b, 0x46, 0xad, 0xcc, 0x42, 0xea, 0x6b, 0xba, 0x86, 0xa5, 0xe0, 0xae, 0x40, 0x95, 0xb8, 0xae,
+	0x1a, 0xdf, 0x34, 0xea, 0xcf, 0x33, 0xd1, 0xca, 0x15, 0x28, 0xbd, 0x16, 0x63, 0x2a, 0x70, 0xfc,
+	0xe5, 0xaf, 0x6e, 0x4c, 0x27, 0xf4, 0x75, 0xb4, 0x21, 0xeb, 0xbf, 0xd7, 0x63, 0x7a, 0x33, 0xfc,
+	0x9e, 0xff, 0xea, 0x21, 0xbc, 0xfb, 0xe2, 0x3f, 0x9f, 0x4b, 0xca, 0xc7, 0xcf, 0x25, 0xe5, 0xd3,
+	0xe7, 0x92, 0xf2, 0xb7, 0x2f, 0xa5, 0xb9, 0x8f, 0x5f, 0x4a, 0x73, 0xff, 0xfb, 0x52, 0x9a, 0x7b,
+	0xf5, 0xe4, 0xca, 0x70, 0xbb, 0xc3, 0xd6, 0x56, 0xdb, 0xea, 0x6f, 0x4b, 0x42, 0x37, 0x6f, 0x88,
+	0xe9, 0x0e, 0x6d, 0xe2, 0xf8, 0xff, 0x32, 0xbf, 0x79, 0xb4, 0xcd, 0x57, 0xd4, 0x36, 0xfb, 0x9f,
+	0x79, 0x2b, 0xc3, 0xfe, 0x3c, 0xfa, 0xff, 0x00, 0x71, 0x92, 0x60, 0xf6, 0x60, 0x17, 0x00, 0x00,
 }
 
-func (m *GetLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CosignerClient is the client API for Cosigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CosignerClient interface {
+	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error)
+	SetNoncesAndSign(ctx context.Context, in *SetNoncesAndSignRequest, opts ...grpc.CallOption) (*SetNoncesAndSignResponse, error)
+	GetNonces(ctx context.Context, in *GetNoncesRequest, opts ...grpc.CallOption) (*GetNoncesResponse, error)
+	TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*TransferLeadershipResponse, error)
+	GetLeader(ctx context.Context, in *GetLeaderRequest, opts ...grpc.CallOption) (*GetLeaderResponse, error)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	DKGCommit(ctx context.Context, in *DKGCommitRequest, opts ...grpc.CallOption) (*DKGCommitResponse, error)
+	DKGDeal(ctx context.Context, in *DKGDealRequest, opts ...grpc.CallOption) (*DKGDealResponse, error)
+	DKGFinalize(ctx context.Context, in *DKGFinalizeRequest, opts ...grpc.CallOption) (*DKGFinalizeResponse, error)
+	RefreshShards(ctx context.Context, in *RefreshShardsRequest, opts ...grpc.CallOption) (*RefreshShardsResponse, error)
+	ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*ChangeMembershipResponse, error)
+	ReshareShards(ctx context.Context, in *ReshareShardsRequest, opts ...grpc.CallOption) (*ReshareShardsResponse, error)
+	SetSignFreeze(ctx context.Context, in *SetSignFreezeRequest, opts ...grpc.CallOption) (*SetSignFreezeResponse, error)
+	GetSignFreezes(ctx context.Context, in *GetSignFreezesRequest, opts ...grpc.CallOption) (*GetSignFreezesResponse, error)
+	FROSTCommit(ctx context.Context, in *FROSTCommitRequest, opts ...grpc.CallOption) (*FROSTCommitResponse, error)
+	FROSTSign(ctx context.Context, in *FROSTSignRequest, opts ...grpc.CallOption) (*SetNoncesAndSignResponse, error)
 }
 
-func (m *GetLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type cosignerClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *GetLeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewCosignerClient(cc grpc1.ClientConn) CosignerClient {
+	return &cosignerClient{cc}
+}
+
+func (c *cosignerClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error) {
+	out := new(SignBlockResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/SignBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *cosignerClient) SetNoncesAndSign(ctx context.Context, in *SetNoncesAndSignRequest, opts ...grpc.CallOption) (*SetNoncesAndSignResponse, error) {
+	out := new(SetNoncesAndSignResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/SetNoncesAndSign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Leader != 0 {
-		i = encodeVarintCosigner(dAtA, i, uint64(m.Leader))
-		i--
-		dAtA[i] = 0x8
+func (c *cosignerClient) GetNonces(ctx context.Context, in *GetNoncesRequest, opts ...grpc.CallOption) (*GetNoncesResponse, error) {
+	out := new(GetNoncesResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/GetNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *cosignerClient) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*TransferLeadershipResponse, error) {
+	out := new(TransferLeadershipResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/TransferLeadership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *cosignerClient) GetLeader(ctx context.Context, in *GetLeaderRequest, opts ...grpc.CallOption) (*GetLeaderResponse, error) {
+	out := new(GetLeaderResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/GetLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *cosignerClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *cosignerClient) DKGCommit(ctx context.Context, in *DKGCommitRequest, opts ...grpc.CallOption) (*DKGCommitResponse, error) {
+	out := new(DKGCommitResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/DKGCommit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *cosignerClient) DKGDeal(ctx context.Context, in *DKGDealRequest, opts ...grpc.CallOption) (*DKGDealResponse, error) {
+	out := new(DKGDealResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/DKGDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *cosignerClient) DKGFinalize(ctx context.Context, in *DKGFinalizeRequest, opts ...grpc.CallOption) (*DKGFinalizeResponse, error) {
+	out := new(DKGFinalizeResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/DKGFinalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintCosigner(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCosigner(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *cosignerClient) RefreshShards(ctx context.Context, in *RefreshShardsRequest, opts ...grpc.CallOption) (*RefreshShardsResponse, error) {
+	out := new(RefreshShardsResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/RefreshShards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *Block) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovCosigner(uint64(m.Height))
-	}
-	if m.Round != 0 {
-		n += 1 + sovCosigner(uint64(m.Round))
-	}
-	if m.Step != 0 {
-		n += 1 + sovCosigner(uint64(m.Step))
-	}
-	l = len(m.SignBytes)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
-	}
-	l = len(m.VoteExtSignBytes)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovCosigner(uint64(m.Timestamp))
+
+func (c *cosignerClient) ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*ChangeMembershipResponse, error) {
+	out := new(ChangeMembershipResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/ChangeMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
-	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovCosigner(uint64(l))
+func (c *cosignerClient) ReshareShards(ctx context.Context, in *ReshareShardsRequest, opts ...grpc.CallOption) (*ReshareShardsResponse, error) {
+	out := new(ReshareShardsResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/ReshareShards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
-	}
-	l = len(m.VoteExtSignature)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovCosigner(uint64(m.Timestamp))
+func (c *cosignerClient) SetSignFreeze(ctx context.Context, in *SetSignFreezeRequest, opts ...grpc.CallOption) (*SetSignFreezeResponse, error) {
+	out := new(SetSignFreezeResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/SetSignFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Nonce) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *cosignerClient) GetSignFreezes(ctx context.Context, in *GetSignFreezesRequest, opts ...grpc.CallOption) (*GetSignFreezesResponse, error) {
+	out := new(GetSignFreezesResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/GetSignFreezes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SourceID != 0 {
-		n += 1 + sovCosigner(uint64(m.SourceID))
+	return out, nil
+}
+
+func (c *cosignerClient) FROSTCommit(ctx context.Context, in *FROSTCommitRequest, opts ...grpc.CallOption) (*FROSTCommitResponse, error) {
+	out := new(FROSTCommitResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/FROSTCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DestinationID != 0 {
-		n += 1 + sovCosigner(uint64(m.DestinationID))
+	return out, nil
+}
+
+func (c *cosignerClient) FROSTSign(ctx context.Context, in *FROSTSignRequest, opts ...grpc.CallOption) (*SetNoncesAndSignResponse, error) {
+	out := new(SetNoncesAndSignResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Cosigner/FROSTSign", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	return out, nil
+}
+
+// CosignerServer is the server API for Cosigner service.
+type CosignerServer interface {
+	SignBlock(context.Context, *SignBlockRequest) (*SignBlockResponse, error)
+	SetNoncesAndSign(context.Context, *SetNoncesAndSignRequest) (*SetNoncesAndSignResponse, error)
+	GetNonces(context.Context, *GetNoncesRequest) (*GetNoncesResponse, error)
+	TransferLeadership(context.Context, *TransferLeadershipRequest) (*TransferLeadershipResponse, error)
+	GetLeader(context.Context, *GetLeaderRequest) (*GetLeaderResponse, error)
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	DKGCommit(context.Context, *DKGCommitRequest) (*DKGCommitResponse, error)
+	DKGDeal(context.Context, *DKGDealRequest) (*DKGDealResponse, error)
+	DKGFinalize(context.Context, *DKGFinalizeRequest) (*DKGFinalizeResponse, error)
+	RefreshShards(context.Context, *RefreshShardsRequest) (*RefreshShardsResponse, error)
+	ChangeMembership(context.Context, *ChangeMembershipRequest) (*ChangeMembershipResponse, error)
+	ReshareShards(context.Context, *ReshareShardsRequest) (*ReshareShardsResponse, error)
+	SetSignFreeze(context.Context, *SetSignFreezeRequest) (*SetSignFreezeResponse, error)
+	GetSignFreezes(context.Context, *GetSignFreezesRequest) (*GetSignFreezesResponse, error)
+	FROSTCommit(context.Context, *FROSTCommitRequest) (*FROSTCommitResponse, error)
+	FROSTSign(context.Context, *FROSTSignRequest) (*SetNoncesAndSignResponse, error)
+}
+
+// UnimplementedCosignerServer can be embedded to have forward compatible implementations.
+type UnimplementedCosignerServer struct {
+}
+
+func (*UnimplementedCosignerServer) SignBlock(ctx context.Context, req *SignBlockRequest) (*SignBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignBlock not implemented")
+}
+func (*UnimplementedCosignerServer) SetNoncesAndSign(ctx context.Context, req *SetNoncesAndSignRequest) (*SetNoncesAndSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNoncesAndSign not implemented")
+}
+func (*UnimplementedCosignerServer) GetNonces(ctx context.Context, req *GetNoncesRequest) (*GetNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNonces not implemented")
+}
+func (*UnimplementedCosignerServer) TransferLeadership(ctx context.Context, req *TransferLeadershipRequest) (*TransferLeadershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
+func (*UnimplementedCosignerServer) GetLeader(ctx context.Context, req *GetLeaderRequest) (*GetLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeader not implemented")
+}
+func (*UnimplementedCosignerServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedCosignerServer) DKGCommit(ctx context.Context, req *DKGCommitRequest) (*DKGCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGCommit not implemented")
+}
+func (*UnimplementedCosignerServer) DKGDeal(ctx context.Context, req *DKGDealRequest) (*DKGDealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGDeal not implemented")
+}
+func (*UnimplementedCosignerServer) DKGFinalize(ctx context.Context, req *DKGFinalizeRequest) (*DKGFinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DKGFinalize not implemented")
+}
+func (*UnimplementedCosignerServer) RefreshShards(ctx context.Context, req *RefreshShardsRequest) (*RefreshShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshShards not implemented")
+}
+func (*UnimplementedCosignerServer) ChangeMembership(ctx context.Context, req *ChangeMembershipRequest) (*ChangeMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMembership not implemented")
+}
+func (*UnimplementedCosignerServer) ReshareShards(ctx context.Context, req *ReshareShardsRequest) (*ReshareShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshareShards not implemented")
+}
+func (*UnimplementedCosignerServer) SetSignFreeze(ctx context.Context, req *SetSignFreezeRequest) (*SetSignFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSignFreeze not implemented")
+}
+func (*UnimplementedCosignerServer) GetSignFreezes(ctx context.Context, req *GetSignFreezesRequest) (*GetSignFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignFreezes not implemented")
+}
+func (*UnimplementedCosignerServer) FROSTCommit(ctx context.Context, req *FROSTCommitRequest) (*FROSTCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FROSTCommit not implemented")
+}
+func (*UnimplementedCosignerServer) FROSTSign(ctx context.Context, req *FROSTSignRequest) (*SetNoncesAndSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FROSTSign not implemented")
+}
+
+func RegisterCosignerServer(s grpc1.Server, srv CosignerServer) {
+	s.RegisterService(&_Cosigner_serviceDesc, srv)
+}
+
+func _Cosigner_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Share)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	if interceptor == nil {
+		return srv.(CosignerServer).SignBlock(ctx, in)
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/SignBlock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).SignBlock(ctx, req.(*SignBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UUIDNonce) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_SetNoncesAndSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNoncesAndSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	if interceptor == nil {
+		return srv.(CosignerServer).SetNoncesAndSign(ctx, in)
 	}
-	if len(m.Nonces) > 0 {
-		for _, e := range m.Nonces {
-			l = e.Size()
-			n += 1 + l + sovCosigner(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/SetNoncesAndSign",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).SetNoncesAndSign(ctx, req.(*SetNoncesAndSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HRST) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovCosigner(uint64(m.Height))
+func _Cosigner_GetNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Round != 0 {
-		n += 1 + sovCosigner(uint64(m.Round))
+	if interceptor == nil {
+		return srv.(CosignerServer).GetNonces(ctx, in)
 	}
-	if m.Step != 0 {
-		n += 1 + sovCosigner(uint64(m.Step))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/GetNonces",
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovCosigner(uint64(m.Timestamp))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).GetNonces(ctx, req.(*GetNoncesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetNoncesAndSignRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+func _Cosigner_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Nonces) > 0 {
-		for _, e := range m.Nonces {
-			l = e.Size()
-			n += 1 + l + sovCosigner(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(CosignerServer).TransferLeadership(ctx, in)
 	}
-	if m.Hrst != nil {
-		l = m.Hrst.Size()
-		n += 1 + l + sovCosigner(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/TransferLeadership",
 	}
-	l = len(m.SignBytes)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).TransferLeadership(ctx, req.(*TransferLeadershipRequest))
 	}
-	l = len(m.VoteExtUuid)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cosigner_GetLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.VoteExtNonces) > 0 {
-		for _, e := range m.VoteExtNonces {
-			l = e.Size()
-			n += 1 + l + sovCosigner(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(CosignerServer).GetLeader(ctx, in)
 	}
-	l = len(m.VoteExtSignBytes)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/GetLeader",
 	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).GetLeader(ctx, req.(*GetLeaderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetNoncesAndSignResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovCosigner(uint64(m.Timestamp))
+	if interceptor == nil {
+		return srv.(CosignerServer).Ping(ctx, in)
 	}
-	l = len(m.NoncePublic)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/Ping",
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).Ping(ctx, req.(*PingRequest))
 	}
-	l = len(m.VoteExtNoncePublic)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cosigner_DKGCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DKGCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.VoteExtSignature)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	if interceptor == nil {
+		return srv.(CosignerServer).DKGCommit(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/DKGCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).DKGCommit(ctx, req.(*DKGCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_DKGDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DKGDealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Uuids) > 0 {
-		for _, b := range m.Uuids {
-			l = len(b)
-			n += 1 + l + sovCosigner(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(CosignerServer).DKGDeal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/DKGDeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).DKGDeal(ctx, req.(*DKGDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_DKGFinalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DKGFinalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Nonces) > 0 {
-		for _, e := range m.Nonces {
-			l = e.Size()
-			n += 1 + l + sovCosigner(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(CosignerServer).DKGFinalize(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/DKGFinalize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).DKGFinalize(ctx, req.(*DKGFinalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferLeadershipRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_RefreshShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.LeaderID)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	if interceptor == nil {
+		return srv.(CosignerServer).RefreshShards(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/RefreshShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).RefreshShards(ctx, req.(*RefreshShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferLeadershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_ChangeMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.LeaderID)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	if interceptor == nil {
+		return srv.(CosignerServer).ChangeMembership(ctx, in)
 	}
-	l = len(m.LeaderAddress)
-	if l > 0 {
-		n += 1 + l + sovCosigner(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/ChangeMembership",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).ChangeMembership(ctx, req.(*ChangeMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetLeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_ReshareShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReshareShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(CosignerServer).ReshareShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/ReshareShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).ReshareShards(ctx, req.(*ReshareShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetLeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cosigner_SetSignFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSignFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerServer).SetSignFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/SetSignFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).SetSignFreeze(ctx, req.(*SetSignFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cosigner_GetSignFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerServer).GetSignFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/GetSignFreezes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).GetSignFreezes(ctx, req.(*GetSignFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cosigner_FROSTCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FROSTCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerServer).FROSTCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/FROSTCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).FROSTCommit(ctx, req.(*FROSTCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cosigner_FROSTSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FROSTSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerServer).FROSTSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Cosigner/FROSTSign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerServer).FROSTSign(ctx, req.(*FROSTSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Cosigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "strangelove.horcrux.Cosigner",
+	HandlerType: (*CosignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignBlock",
+			Handler:    _Cosigner_SignBlock_Handler,
+		},
+		{
+			MethodName: "SetNoncesAndSign",
+			Handler:    _Cosigner_SetNoncesAndSign_Handler,
+		},
+		{
+			MethodName: "GetNonces",
+			Handler:    _Cosigner_GetNonces_Handler,
+		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _Cosigner_TransferLeadership_Handler,
+		},
+		{
+			MethodName: "GetLeader",
+			Handler:    _Cosigner_GetLeader_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Cosigner_Ping_Handler,
+		},
+		{
+			MethodName: "DKGCommit",
+			Handler:    _Cosigner_DKGCommit_Handler,
+		},
+		{
+			MethodName: "DKGDeal",
+			Handler:    _Cosigner_DKGDeal_Handler,
+		},
+		{
+			MethodName: "DKGFinalize",
+			Handler:    _Cosigner_DKGFinalize_Handler,
+		},
+		{
+			MethodName: "RefreshShards",
+			Handler:    _Cosigner_RefreshShards_Handler,
+		},
+		{
+			MethodName: "ChangeMembership",
+			Handler:    _Cosigner_ChangeMembership_Handler,
+		},
+		{
+			MethodName: "ReshareShards",
+			Handler:    _Cosigner_ReshareShards_Handler,
+		},
+		{
+			MethodName: "SetSignFreeze",
+			Handler:    _Cosigner_SetSignFreeze_Handler,
+		},
+		{
+			MethodName: "GetSignFreezes",
+			Handler:    _Cosigner_GetSignFreezes_Handler,
+		},
+		{
+			MethodName: "FROSTCommit",
+			Handler:    _Cosigner_FROSTCommit_Handler,
+		},
+		{
+			MethodName: "FROSTSign",
+			Handler:    _Cosigner_FROSTSign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "strangelove/horcrux/cosigner.proto",
+}
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Leader != 0 {
-		n += 1 + sovCosigner(uint64(m.Leader))
+	if m.Timestamp != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.VoteExtSignBytes) > 0 {
+		i -= len(m.VoteExtSignBytes)
+		copy(dAtA[i:], m.VoteExtSignBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Step != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VoteExtSignature) > 0 {
+		i -= len(m.VoteExtSignature)
+		copy(dAtA[i:], m.VoteExtSignature)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Nonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareCommitments) > 0 {
+		for iNdEx := len(m.ShareCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ShareCommitments[iNdEx])
+			copy(dAtA[i:], m.ShareCommitments[iNdEx])
+			i = encodeVarintCosigner(dAtA, i, uint64(len(m.ShareCommitments[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationID != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.DestinationID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceID != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.SourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UUIDNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UUIDNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UUIDNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HRST) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HRST) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HRST) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Step != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetNoncesAndSignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetNoncesAndSignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetNoncesAndSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawBytes) > 0 {
+		i -= len(m.RawBytes)
+		copy(dAtA[i:], m.RawBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.RawBytes)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RawBytesUniqueID) > 0 {
+		i -= len(m.RawBytesUniqueID)
+		copy(dAtA[i:], m.RawBytesUniqueID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.RawBytesUniqueID)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.NonceDealers) > 0 {
+		dAtA3 := make([]byte, len(m.NonceDealers)*10)
+		var j2 int
+		for _, num1 := range m.NonceDealers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintCosigner(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VoteExtSignBytes) > 0 {
+		i -= len(m.VoteExtSignBytes)
+		copy(dAtA[i:], m.VoteExtSignBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignBytes)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VoteExtNonces) > 0 {
+		for iNdEx := len(m.VoteExtNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteExtNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoteExtUuid) > 0 {
+		i -= len(m.VoteExtUuid)
+		copy(dAtA[i:], m.VoteExtUuid)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtUuid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Hrst != nil {
+		{
+			size, err := m.Hrst.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetNoncesAndSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetNoncesAndSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetNoncesAndSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteExtSignature) > 0 {
+		i -= len(m.VoteExtSignature)
+		copy(dAtA[i:], m.VoteExtSignature)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VoteExtNoncePublic) > 0 {
+		i -= len(m.VoteExtNoncePublic)
+		copy(dAtA[i:], m.VoteExtNoncePublic)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtNoncePublic)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NoncePublic) > 0 {
+		i -= len(m.NoncePublic)
+		copy(dAtA[i:], m.NoncePublic)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.NoncePublic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FROSTCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FROSTCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FROSTCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hiding) > 0 {
+		i -= len(m.Hiding)
+		copy(dAtA[i:], m.Hiding)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Hiding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CosignerID != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.CosignerID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FROSTCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FROSTCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FROSTCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FROSTCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FROSTCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FROSTCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FROSTSignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FROSTSignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FROSTSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteExtCommitments) > 0 {
+		for iNdEx := len(m.VoteExtCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteExtCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoteExtSignBytes) > 0 {
+		i -= len(m.VoteExtSignBytes)
+		copy(dAtA[i:], m.VoteExtSignBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.VoteExtSignBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Hrst != nil {
+		{
+			size, err := m.Hrst.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNoncesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uuids) > 0 {
+		for iNdEx := len(m.Uuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Uuids[iNdEx])
+			copy(dAtA[i:], m.Uuids[iNdEx])
+			i = encodeVarintCosigner(dAtA, i, uint64(len(m.Uuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeadershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeadershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeadershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaderID) > 0 {
+		i -= len(m.LeaderID)
+		copy(dAtA[i:], m.LeaderID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.LeaderID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeadershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeadershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeadershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaderAddress) > 0 {
+		i -= len(m.LeaderAddress)
+		copy(dAtA[i:], m.LeaderAddress)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.LeaderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LeaderID) > 0 {
+		i -= len(m.LeaderID)
+		copy(dAtA[i:], m.LeaderID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.LeaderID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Leader != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGPackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGPackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofS) > 0 {
+		i -= len(m.ProofS)
+		copy(dAtA[i:], m.ProofS)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ProofS)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProofR) > 0 {
+		i -= len(m.ProofR)
+		copy(dAtA[i:], m.ProofR)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ProofR)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintCosigner(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SourceID != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.SourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Participants) > 0 {
+		dAtA7 := make([]byte, len(m.Participants)*10)
+		var j6 int
+		for _, num1 := range m.Participants {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintCosigner(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Package != nil {
+		{
+			size, err := m.Package.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGDealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGDealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGDealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packages) > 0 {
+		for iNdEx := len(m.Packages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGDealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGDealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGDealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGFinalizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGFinalizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGFinalizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGFinalizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGFinalizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGFinalizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TranscriptHash) > 0 {
+		i -= len(m.TranscriptHash)
+		copy(dAtA[i:], m.TranscriptHash)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.TranscriptHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshShardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshShardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshShardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshShardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshShardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshShardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeMembershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeMembershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeMembershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.P2PAddr) > 0 {
+		i -= len(m.P2PAddr)
+		copy(dAtA[i:], m.P2PAddr)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.P2PAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Op != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeMembershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeMembershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeMembershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReshareShardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReshareShardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReshareShardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReshareShardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReshareShardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReshareShardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainIDs) > 0 {
+		for iNdEx := len(m.ChainIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIDs[iNdEx])
+			copy(dAtA[i:], m.ChainIDs[iNdEx])
+			i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreezeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreezeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreezeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HaltHeight != 0 {
+		i = encodeVarintCosigner(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCosigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetSignFreezeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSignFreezeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSignFreezeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetSignFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSignFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSignFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSignFreezesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSignFreezesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSignFreezesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSignFreezesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSignFreezesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSignFreezesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Freezes) > 0 {
+		for iNdEx := len(m.Freezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Freezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCosigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCosigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovCosigner(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovCosigner(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovCosigner(uint64(m.Step))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.VoteExtSignBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovCosigner(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *SignBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.VoteExtSignature)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovCosigner(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *Nonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceID != 0 {
+		n += 1 + sovCosigner(uint64(m.SourceID))
+	}
+	if m.DestinationID != 0 {
+		n += 1 + sovCosigner(uint64(m.DestinationID))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.ShareCommitments) > 0 {
+		for _, b := range m.ShareCommitments {
+			l = len(b)
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UUIDNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		for _, e := range m.Nonces {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HRST) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovCosigner(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovCosigner(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovCosigner(uint64(m.Step))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovCosigner(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *SetNoncesAndSignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		for _, e := range m.Nonces {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	if m.Hrst != nil {
+		l = m.Hrst.Size()
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.VoteExtUuid)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.VoteExtNonces) > 0 {
+		for _, e := range m.VoteExtNonces {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	l = len(m.VoteExtSignBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.NonceDealers) > 0 {
+		l = 0
+		for _, e := range m.NonceDealers {
+			l += sovCosigner(uint64(e))
+		}
+		n += 1 + sovCosigner(uint64(l)) + l
+	}
+	l = len(m.RawBytesUniqueID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.RawBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SetNoncesAndSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovCosigner(uint64(m.Timestamp))
+	}
+	l = len(m.NoncePublic)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.VoteExtNoncePublic)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.VoteExtSignature)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *FROSTCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.CosignerID != 0 {
+		n += 1 + sovCosigner(uint64(m.CosignerID))
+	}
+	l = len(m.Hiding)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *FROSTCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovCosigner(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *FROSTCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FROSTSignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.Hrst != nil {
+		l = m.Hrst.Size()
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	l = len(m.VoteExtSignBytes)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.VoteExtCommitments) > 0 {
+		for _, e := range m.VoteExtCommitments {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uuids) > 0 {
+		for _, b := range m.Uuids {
+			l = len(b)
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nonces) > 0 {
+		for _, e := range m.Nonces {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferLeadershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaderID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferLeadershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaderID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.LeaderAddress)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader != 0 {
+		n += 1 + sovCosigner(uint64(m.Leader))
+	}
+	return n
+}
+
+func (m *PingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DKGPackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceID != 0 {
+		n += 1 + sovCosigner(uint64(m.SourceID))
+	}
+	if len(m.Commitments) > 0 {
+		for _, b := range m.Commitments {
+			l = len(b)
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	l = len(m.ProofR)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.ProofS)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovCosigner(uint64(m.Threshold))
+	}
+	if len(m.Participants) > 0 {
+		l = 0
+		for _, e := range m.Participants {
+			l += sovCosigner(uint64(e))
+		}
+		n += 1 + sovCosigner(uint64(l)) + l
+	}
+	if m.Mode != 0 {
+		n += 1 + sovCosigner(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *DKGCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Package != nil {
+		l = m.Package.Size()
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGDealRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.Packages) > 0 {
+		for _, e := range m.Packages {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DKGDealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DKGFinalizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DKGFinalizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.TranscriptHash)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshShardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshShardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeMembershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovCosigner(uint64(m.Op))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovCosigner(uint64(m.ShardID))
+	}
+	l = len(m.P2PAddr)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeMembershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovCosigner(uint64(m.ShardID))
+	}
+	return n
+}
+
+func (m *ReshareShardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovCosigner(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *ReshareShardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainIDs) > 0 {
+		for _, s := range m.ChainIDs {
+			l = len(s)
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovCosigner(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *FreezeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	if m.HaltHeight != 0 {
+		n += 1 + sovCosigner(uint64(m.HaltHeight))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetSignFreezeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 1 + l + sovCosigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SetSignFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSignFreezesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSignFreezesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Freezes) > 0 {
+		for _, e := range m.Freezes {
+			l = e.Size()
+			n += 1 + l + sovCosigner(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCosigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCosigner(x uint64) (n int) {
+	return sovCosigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtSignBytes = append(m.VoteExtSignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtSignBytes == nil {
+				m.VoteExtSignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtSignature = append(m.VoteExtSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtSignature == nil {
+				m.VoteExtSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Nonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			m.SourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationID", wireType)
+			}
+			m.DestinationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
+			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UUIDNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UUIDNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UUIDNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uuid == nil {
+				m.Uuid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, &Nonce{})
+			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HRST) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HRST: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HRST: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetNoncesAndSignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetNoncesAndSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uuid == nil {
+				m.Uuid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, &Nonce{})
+			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hrst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hrst == nil {
+				m.Hrst = &HRST{}
+			}
+			if err := m.Hrst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtUuid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtUuid = append(m.VoteExtUuid[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtUuid == nil {
+				m.VoteExtUuid = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtNonces = append(m.VoteExtNonces, &Nonce{})
+			if err := m.VoteExtNonces[len(m.VoteExtNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtSignBytes = append(m.VoteExtSignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtSignBytes == nil {
+				m.VoteExtSignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCosigner
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NonceDealers = append(m.NonceDealers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCosigner
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCosigner
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCosigner
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NonceDealers) == 0 {
+					m.NonceDealers = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCosigner
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NonceDealers = append(m.NonceDealers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceDealers", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytesUniqueID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawBytesUniqueID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawBytes == nil {
+				m.RawBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetNoncesAndSignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetNoncesAndSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoncePublic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoncePublic = append(m.NoncePublic[:0], dAtA[iNdEx:postIndex]...)
+			if m.NoncePublic == nil {
+				m.NoncePublic = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtNoncePublic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtNoncePublic = append(m.VoteExtNoncePublic[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtNoncePublic == nil {
+				m.VoteExtNoncePublic = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtSignature = append(m.VoteExtSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtSignature == nil {
+				m.VoteExtSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FROSTCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FROSTCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FROSTCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uuid == nil {
+				m.Uuid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosignerID", wireType)
+			}
+			m.CosignerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosignerID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hiding = append(m.Hiding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hiding == nil {
+				m.Hiding = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binding = append(m.Binding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Binding == nil {
+				m.Binding = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FROSTCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FROSTCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FROSTCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FROSTCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FROSTCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FROSTCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, &FROSTCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FROSTSignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FROSTSignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FROSTSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hrst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hrst == nil {
+				m.Hrst = &HRST{}
+			}
+			if err := m.Hrst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, &FROSTCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtSignBytes = append(m.VoteExtSignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtSignBytes == nil {
+				m.VoteExtSignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtCommitments = append(m.VoteExtCommitments, &FROSTCommitment{})
+			if err := m.VoteExtCommitments[len(m.VoteExtCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuids", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuids = append(m.Uuids, make([]byte, postIndex-iNdEx))
+			copy(m.Uuids[len(m.Uuids)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, &UUIDNonce{})
+			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLeadershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeadershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeadershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *PingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovCosigner(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCosigner(x uint64) (n int) {
-	return sovCosigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *TransferLeadershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2285,17 +7811,17 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeadershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeadershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderID", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2305,54 +7831,29 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
 			}
-			m.Step = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Step |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.LeaderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2362,65 +7863,129 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCosigner
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.SignBytes == nil {
-				m.SignBytes = []byte{}
-			}
+			m.LeaderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignBytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteExtSignBytes = append(m.VoteExtSignBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.VoteExtSignBytes == nil {
-				m.VoteExtSignBytes = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.Timestamp = 0
+			m.Leader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2430,7 +7995,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.Leader |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2456,7 +8021,57 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *PingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2479,80 +8094,12 @@ func (m *SignBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -2574,7 +8121,7 @@ func (m *SignBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *DKGPackage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2597,15 +8144,34 @@ func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGPackage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGPackage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			m.SourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2632,14 +8198,12 @@ func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Commitments = append(m.Commitments, make([]byte, postIndex-iNdEx))
+			copy(m.Commitments[len(m.Commitments)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofR", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2666,16 +8230,16 @@ func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteExtSignature = append(m.VoteExtSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.VoteExtSignature == nil {
-				m.VoteExtSignature = []byte{}
+			m.ProofR = append(m.ProofR[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofR == nil {
+				m.ProofR = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofS", wireType)
 			}
-			m.Timestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2685,11 +8249,26 @@ func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofS = append(m.ProofS[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofS == nil {
+				m.ProofS = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -2711,7 +8290,7 @@ func (m *SignBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Nonce) Unmarshal(dAtA []byte) error {
+func (m *DKGCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2734,17 +8313,17 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Nonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Nonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
-			m.SourceID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2754,16 +8333,31 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceID |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			m.DestinationID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2773,16 +8367,29 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationID |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var byteLen int
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2792,31 +8399,92 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Threshold |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCosigner
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Participants = append(m.Participants, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCosigner
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCosigner
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCosigner
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Participants) == 0 {
+					m.Participants = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCosigner
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Participants = append(m.Participants, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var byteLen int
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2826,31 +8494,66 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Mode |= DKGMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCosigner
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
-			if m.Share == nil {
-				m.Share = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -2860,24 +8563,26 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCosigner
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			if m.Package == nil {
+				m.Package = &DKGPackage{}
+			}
+			if err := m.Package.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2901,7 +8606,7 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UUIDNonce) Unmarshal(dAtA []byte) error {
+func (m *DKGDealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2924,15 +8629,15 @@ func (m *UUIDNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UUIDNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGDealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UUIDNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGDealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2959,14 +8664,14 @@ func (m *UUIDNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
-			if m.Uuid == nil {
-				m.Uuid = []byte{}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2993,8 +8698,8 @@ func (m *UUIDNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonces = append(m.Nonces, &Nonce{})
-			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packages = append(m.Packages, &DKGPackage{})
+			if err := m.Packages[len(m.Packages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3019,7 +8724,7 @@ func (m *UUIDNonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HRST) Unmarshal(dAtA []byte) error {
+func (m *DKGDealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3042,36 +8747,17 @@ func (m *HRST) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HRST: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGDealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HRST: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGDealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			m.Round = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3081,49 +8767,26 @@ func (m *HRST) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
 			}
-			m.Step = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Step |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Shares = append(m.Shares, &Nonce{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -3145,7 +8808,7 @@ func (m *HRST) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
+func (m *DKGFinalizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3168,15 +8831,15 @@ func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetNoncesAndSignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DKGFinalizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetNoncesAndSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DKGFinalizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3203,14 +8866,14 @@ func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
-			if m.Uuid == nil {
-				m.Uuid = []byte{}
+			m.SessionID = append(m.SessionID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionID == nil {
+				m.SessionID = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,84 +8900,64 @@ func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonces = append(m.Nonces, &Nonce{})
-			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shares = append(m.Shares, &Nonce{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hrst", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCosigner
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hrst == nil {
-				m.Hrst = &HRST{}
-			}
-			if err := m.Hrst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGFinalizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.SignBytes == nil {
-				m.SignBytes = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGFinalizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGFinalizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3334,55 +8977,21 @@ func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
 			if byteLen < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoteExtUuid = append(m.VoteExtUuid[:0], dAtA[iNdEx:postIndex]...)
-			if m.VoteExtUuid == nil {
-				m.VoteExtUuid = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtNonces", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCosigner
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteExtNonces = append(m.VoteExtNonces, &Nonce{})
-			if err := m.VoteExtNonces[len(m.VoteExtNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TranscriptHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3409,12 +9018,62 @@ func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteExtSignBytes = append(m.VoteExtSignBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.VoteExtSignBytes == nil {
-				m.VoteExtSignBytes = []byte{}
+			m.TranscriptHash = append(m.TranscriptHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TranscriptHash == nil {
+				m.TranscriptHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshShardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshShardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshShardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
@@ -3467,7 +9126,7 @@ func (m *SetNoncesAndSignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshShardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,34 +9149,15 @@ func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetNoncesAndSignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshShardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetNoncesAndSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshShardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoncePublic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3544,16 +9184,66 @@ func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoncePublic = append(m.NoncePublic[:0], dAtA[iNdEx:postIndex]...)
-			if m.NoncePublic == nil {
-				m.NoncePublic = []byte{}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeMembershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeMembershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeMembershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3563,31 +9253,16 @@ func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Op |= MembershipOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtNoncePublic", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
 			}
-			var byteLen int
+			m.ShardID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3597,31 +9272,16 @@ func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ShardID |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoteExtNoncePublic = append(m.VoteExtNoncePublic[:0], dAtA[iNdEx:postIndex]...)
-			if m.VoteExtNoncePublic == nil {
-				m.VoteExtNoncePublic = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P2PAddr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3631,26 +9291,93 @@ func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCosigner
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteExtSignature = append(m.VoteExtSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.VoteExtSignature == nil {
-				m.VoteExtSignature = []byte{}
+			m.P2PAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeMembershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeMembershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeMembershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -3672,7 +9399,7 @@ func (m *SetNoncesAndSignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *ReshareShardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3695,17 +9422,17 @@ func (m *GetNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReshareShardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReshareShardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuids", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var byteLen int
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3715,24 +9442,11 @@ func (m *GetNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Threshold |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCosigner
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uuids = append(m.Uuids, make([]byte, postIndex-iNdEx))
-			copy(m.Uuids[len(m.Uuids)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -3754,7 +9468,7 @@ func (m *GetNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *ReshareShardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3777,17 +9491,17 @@ func (m *GetNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReshareShardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReshareShardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3797,26 +9511,43 @@ func (m *GetNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCosigner
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonces = append(m.Nonces, &UUIDNonce{})
-			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainIDs = append(m.ChainIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -3838,7 +9569,7 @@ func (m *GetNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeadershipRequest) Unmarshal(dAtA []byte) error {
+func (m *FreezeWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3861,15 +9592,15 @@ func (m *TransferLeadershipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeadershipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreezeWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeadershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreezeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3897,7 +9628,71 @@ func (m *TransferLeadershipRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaderID = string(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3920,7 +9715,7 @@ func (m *TransferLeadershipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeadershipResponse) Unmarshal(dAtA []byte) error {
+func (m *SignFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3943,15 +9738,15 @@ func (m *TransferLeadershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeadershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeadershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3979,13 +9774,32 @@ func (m *TransferLeadershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaderID = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCosigner
@@ -3995,23 +9809,25 @@ func (m *TransferLeadershipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCosigner
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCosigner
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaderAddress = string(dAtA[iNdEx:postIndex])
+			m.Windows = append(m.Windows, &FreezeWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4034,7 +9850,7 @@ func (m *TransferLeadershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *SetSignFreezeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4057,12 +9873,48 @@ func (m *GetLeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetSignFreezeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetSignFreezeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freeze == nil {
+				m.Freeze = &SignFreeze{}
+			}
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -4084,7 +9936,7 @@ func (m *GetLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *SetSignFreezeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4107,31 +9959,12 @@ func (m *GetLeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetSignFreezeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetSignFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			m.Leader = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCosigner
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Leader |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -4153,7 +9986,7 @@ func (m *GetLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSignFreezesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4176,10 +10009,10 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSignFreezesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSignFreezesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4203,7 +10036,7 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSignFreezesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4226,12 +10059,46 @@ func (m *PingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSignFreezesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSignFreezesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freezes = append(m.Freezes, &SignFreeze{})
+			if err := m.Freezes[len(m.Freezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosigner(dAtA[iNdEx:])
@@ -0,0 +1,1511 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: strangelove/horcrux/fence.proto
+
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FenceRequest struct {
+	ClusterID string `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	ChainID   string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Height    int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int64  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Step      int32  `protobuf:"varint,5,opt,name=step,proto3" json:"step,omitempty"`
+	// leaseDuration in nanoseconds
+	LeaseDuration int64 `protobuf:"varint,6,opt,name=leaseDuration,proto3" json:"leaseDuration,omitempty"`
+}
+
+func (m *FenceRequest) Reset()         { *m = FenceRequest{} }
+func (m *FenceRequest) String() string { return proto.CompactTextString(m) }
+func (*FenceRequest) ProtoMessage()    {}
+func (*FenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc2ba733aaa893bd, []int{0}
+}
+func (m *FenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FenceRequest.Merge(m, src)
+}
+func (m *FenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FenceRequest proto.InternalMessageInfo
+
+func (m *FenceRequest) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *FenceRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *FenceRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FenceRequest) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *FenceRequest) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *FenceRequest) GetLeaseDuration() int64 {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return 0
+}
+
+type FenceResponse struct {
+	// leaseExpiration in unix nanoseconds
+	LeaseExpiration int64 `protobuf:"varint,1,opt,name=leaseExpiration,proto3" json:"leaseExpiration,omitempty"`
+}
+
+func (m *FenceResponse) Reset()         { *m = FenceResponse{} }
+func (m *FenceResponse) String() string { return proto.CompactTextString(m) }
+func (*FenceResponse) ProtoMessage()    {}
+func (*FenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc2ba733aaa893bd, []int{1}
+}
+func (m *FenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FenceResponse.Merge(m, src)
+}
+func (m *FenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FenceResponse proto.InternalMessageInfo
+
+func (m *FenceResponse) GetLeaseExpiration() int64 {
+	if m != nil {
+		return m.LeaseExpiration
+	}
+	return 0
+}
+
+type FenceStatusRequest struct {
+}
+
+func (m *FenceStatusRequest) Reset()         { *m = FenceStatusRequest{} }
+func (m *FenceStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*FenceStatusRequest) ProtoMessage()    {}
+func (*FenceStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc2ba733aaa893bd, []int{2}
+}
+func (m *FenceStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FenceStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FenceStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FenceStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FenceStatusRequest.Merge(m, src)
+}
+func (m *FenceStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FenceStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FenceStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FenceStatusRequest proto.InternalMessageInfo
+
+type FenceWatermark struct {
+	ChainID   string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Height    int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int64  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Step      int32  `protobuf:"varint,4,opt,name=step,proto3" json:"step,omitempty"`
+	ClusterID string `protobuf:"bytes,5,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+}
+
+func (m *FenceWatermark) Reset()         { *m = FenceWatermark{} }
+func (m *FenceWatermark) String() string { return proto.CompactTextString(m) }
+func (*FenceWatermark) ProtoMessage()    {}
+func (*FenceWatermark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc2ba733aaa893bd, []int{3}
+}
+func (m *FenceWatermark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FenceWatermark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FenceWatermark.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FenceWatermark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FenceWatermark.Merge(m, src)
+}
+func (m *FenceWatermark) XXX_Size() int {
+	return m.Size()
+}
+func (m *FenceWatermark) XXX_DiscardUnknown() {
+	xxx_messageInfo_FenceWatermark.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FenceWatermark proto.InternalMessageInfo
+
+func (m *FenceWatermark) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *FenceWatermark) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FenceWatermark) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *FenceWatermark) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *FenceWatermark) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+type FenceStatusResponse struct {
+	Holder          string            `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	LeaseExpiration int64             `protobuf:"varint,2,opt,name=leaseExpiration,proto3" json:"leaseExpiration,omitempty"`
+	Watermarks      []*FenceWatermark `protobuf:"bytes,3,rep,name=watermarks,proto3" json:"watermarks,omitempty"`
+}
+
+func (m *FenceStatusResponse) Reset()         { *m = FenceStatusResponse{} }
+func (m *FenceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*FenceStatusResponse) ProtoMessage()    {}
+func (*FenceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc2ba733aaa893bd, []int{4}
+}
+func (m *FenceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FenceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FenceStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FenceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FenceStatusResponse.Merge(m, src)
+}
+func (m *FenceStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FenceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FenceStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FenceStatusResponse proto.InternalMessageInfo
+
+func (m *FenceStatusResponse) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *FenceStatusResponse) GetLeaseExpiration() int64 {
+	if m != nil {
+		return m.LeaseExpiration
+	}
+	return 0
+}
+
+func (m *FenceStatusResponse) GetWatermarks() []*FenceWatermark {
+	if m != nil {
+		return m.Watermarks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FenceRequest)(nil), "strangelove.horcrux.FenceRequest")
+	proto.RegisterType((*FenceResponse)(nil), "strangelove.horcrux.FenceResponse")
+	proto.RegisterType((*FenceStatusRequest)(nil), "strangelove.horcrux.FenceStatusRequest")
+	proto.RegisterType((*FenceWatermark)(nil), "strangelove.horcrux.FenceWatermark")
+	proto.RegisterType((*FenceStatusResponse)(nil), "strangelove.horcrux.FenceStatusResponse")
+}
+
+func init() { proto.RegisterFile("strangelove/horcrux/fence.proto", fileDescriptor_dc2ba733aaa893bd) }
+
+var fileDescriptor_dc2ba733aaa893bd = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6e, 0xd4, 0x30,
+	0x14, 0xc6, 0xc7, 0x93, 0xc9, 0xa0, 0xbe, 0x52, 0x90, 0xdc, 0x0a, 0x59, 0x15, 0x0a, 0x21, 0x20,
+	0x91, 0x0d, 0x89, 0xd4, 0x2e, 0x10, 0x5b, 0x18, 0x90, 0xba, 0x83, 0xb0, 0x40, 0x62, 0x97, 0x49,
+	0x1f, 0x49, 0x44, 0x6a, 0x07, 0xff, 0x19, 0x7a, 0x06, 0x56, 0x1c, 0x80, 0x33, 0x70, 0x03, 0xf6,
+	0x2c, 0xbb, 0x64, 0x89, 0x66, 0x2e, 0x82, 0xea, 0x3a, 0x90, 0x54, 0x19, 0x58, 0x8d, 0xdf, 0xe7,
+	0xef, 0xb3, 0xdf, 0xef, 0x4d, 0x0c, 0xf7, 0x94, 0x96, 0x39, 0x2f, 0xb1, 0x11, 0x2b, 0x4c, 0x2b,
+	0x21, 0x0b, 0x69, 0xce, 0xd3, 0xf7, 0xc8, 0x0b, 0x4c, 0x5a, 0x29, 0xb4, 0xa0, 0xfb, 0x3d, 0x43,
+	0xe2, 0x0c, 0xd1, 0x37, 0x02, 0x37, 0x5f, 0x5e, 0x9a, 0x32, 0xfc, 0x68, 0x50, 0x69, 0x7a, 0x17,
+	0x76, 0x8a, 0xc6, 0x28, 0x8d, 0xf2, 0x64, 0xc1, 0x48, 0x48, 0xe2, 0x9d, 0xec, 0xaf, 0x40, 0x19,
+	0xdc, 0x28, 0xaa, 0xbc, 0xe6, 0x27, 0x0b, 0x36, 0xb5, 0x7b, 0x5d, 0x49, 0xef, 0xc0, 0xbc, 0xc2,
+	0xba, 0xac, 0x34, 0xf3, 0x42, 0x12, 0x7b, 0x99, 0xab, 0xe8, 0x01, 0xf8, 0x52, 0x18, 0x7e, 0xca,
+	0x66, 0x56, 0xbe, 0x2a, 0x28, 0x85, 0x99, 0xd2, 0xd8, 0x32, 0x3f, 0x24, 0xb1, 0x9f, 0xd9, 0x35,
+	0x7d, 0x08, 0x7b, 0x0d, 0xe6, 0x0a, 0x17, 0x46, 0xe6, 0xba, 0x16, 0x9c, 0xcd, 0x6d, 0x62, 0x28,
+	0x46, 0x4f, 0x61, 0xcf, 0xf5, 0xab, 0x5a, 0xc1, 0x15, 0xd2, 0x18, 0x6e, 0x5b, 0xc7, 0x8b, 0xf3,
+	0xb6, 0x76, 0x41, 0x62, 0x83, 0xd7, 0xe5, 0xe8, 0x00, 0xa8, 0x8d, 0xbe, 0xd1, 0xb9, 0x36, 0xca,
+	0x01, 0x47, 0x9f, 0x09, 0xdc, 0xb2, 0xf2, 0xdb, 0x5c, 0xa3, 0x3c, 0xcb, 0xe5, 0x87, 0x3e, 0x25,
+	0xd9, 0x46, 0x39, 0x1d, 0xa7, 0xf4, 0xc6, 0x28, 0x67, 0x3d, 0xca, 0xc1, 0x7c, 0xfd, 0x6b, 0xf3,
+	0x8d, 0xbe, 0x12, 0xd8, 0x1f, 0xf4, 0xe8, 0x20, 0x2f, 0xef, 0x15, 0xcd, 0x29, 0x4a, 0xd7, 0x90,
+	0xab, 0xc6, 0xe0, 0xa7, 0xa3, 0xf0, 0xf4, 0x39, 0xc0, 0xa7, 0x0e, 0x50, 0x31, 0x2f, 0xf4, 0xe2,
+	0xdd, 0xa3, 0x07, 0xc9, 0xc8, 0x27, 0x91, 0x0c, 0x87, 0x91, 0xf5, 0x62, 0x47, 0xdf, 0x09, 0xf8,
+	0x76, 0x9b, 0xbe, 0xea, 0x16, 0xf7, 0xb7, 0x9f, 0xe1, 0x26, 0x7c, 0x18, 0xfd, 0xcb, 0x72, 0x05,
+	0x18, 0x4d, 0xe8, 0x12, 0x76, 0x7b, 0xe4, 0xf4, 0xd1, 0xf6, 0xd0, 0xe0, 0xff, 0x3b, 0x8c, 0xff,
+	0x6f, 0xec, 0xee, 0x78, 0xf6, 0xfa, 0xc7, 0x3a, 0x20, 0x17, 0xeb, 0x80, 0xfc, 0x5a, 0x07, 0xe4,
+	0xcb, 0x26, 0x98, 0x5c, 0x6c, 0x82, 0xc9, 0xcf, 0x4d, 0x30, 0x79, 0xf7, 0xa4, 0xac, 0x75, 0x65,
+	0x96, 0x49, 0x21, 0xce, 0xd2, 0xde, 0x79, 0x8f, 0x57, 0xc8, 0xb5, 0x91, 0xa8, 0xfe, 0xbc, 0xa8,
+	0xd5, 0x71, 0xaa, 0xea, 0x92, 0xa3, 0x4c, 0xed, 0xab, 0x5a, 0xce, 0xed, 0xcf, 0xf1, 0xef, 0x01,
+	0x00, 0x80, 0x88, 0x43, 0x5e, 0x7f, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FenceClient is the client API for Fence service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FenceClient interface {
+	Fence(ctx context.Context, in *FenceRequest, opts ...grpc.CallOption) (*FenceResponse, error)
+	FenceStatus(ctx context.Context, in *FenceStatusRequest, opts ...grpc.CallOption) (*FenceStatusResponse, error)
+}
+
+type fenceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewFenceClient(cc grpc1.ClientConn) FenceClient {
+	return &fenceClient{cc}
+}
+
+func (c *fenceClient) Fence(ctx context.Context, in *FenceRequest, opts ...grpc.CallOption) (*FenceResponse, error) {
+	out := new(FenceResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Fence/Fence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fenceClient) FenceStatus(ctx context.Context, in *FenceStatusRequest, opts ...grpc.CallOption) (*FenceStatusResponse, error) {
+	out := new(FenceStatusResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.Fence/FenceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FenceServer is the server API for Fence service.
+type FenceServer interface {
+	Fence(context.Context, *FenceRequest) (*FenceResponse, error)
+	FenceStatus(context.Context, *FenceStatusRequest) (*FenceStatusResponse, error)
+}
+
+// UnimplementedFenceServer can be embedded to have forward compatible implementations.
+type UnimplementedFenceServer struct {
+}
+
+func (*UnimplementedFenceServer) Fence(ctx context.Context, req *FenceRequest) (*FenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fence not implemented")
+}
+func (*UnimplementedFenceServer) FenceStatus(ctx context.Context, req *FenceStatusRequest) (*FenceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FenceStatus not implemented")
+}
+
+func RegisterFenceServer(s grpc1.Server, srv FenceServer) {
+	s.RegisterService(&_Fence_serviceDesc, srv)
+}
+
+func _Fence_Fence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FenceServer).Fence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Fence/Fence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FenceServer).Fence(ctx, req.(*FenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fence_FenceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FenceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FenceServer).FenceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.Fence/FenceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FenceServer).FenceStatus(ctx, req.(*FenceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Fence_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "strangelove.horcrux.Fence",
+	HandlerType: (*FenceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Fence",
+			Handler:    _Fence_Fence_Handler,
+		},
+		{
+			MethodName: "FenceStatus",
+			Handler:    _Fence_FenceStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "strangelove/horcrux/fence.proto",
+}
+
+func (m *FenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeaseDuration != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.LeaseDuration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Step != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintFence(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterID) > 0 {
+		i -= len(m.ClusterID)
+		copy(dAtA[i:], m.ClusterID)
+		i = encodeVarintFence(dAtA, i, uint64(len(m.ClusterID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeaseExpiration != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.LeaseExpiration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FenceStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FenceStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FenceStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FenceWatermark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FenceWatermark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FenceWatermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterID) > 0 {
+		i -= len(m.ClusterID)
+		copy(dAtA[i:], m.ClusterID)
+		i = encodeVarintFence(dAtA, i, uint64(len(m.ClusterID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Step != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintFence(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FenceStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FenceStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FenceStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Watermarks) > 0 {
+		for iNdEx := len(m.Watermarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Watermarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LeaseExpiration != 0 {
+		i = encodeVarintFence(dAtA, i, uint64(m.LeaseExpiration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintFence(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterID)
+	if l > 0 {
+		n += 1 + l + sovFence(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovFence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovFence(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovFence(uint64(m.Step))
+	}
+	if m.LeaseDuration != 0 {
+		n += 1 + sovFence(uint64(m.LeaseDuration))
+	}
+	return n
+}
+
+func (m *FenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseExpiration != 0 {
+		n += 1 + sovFence(uint64(m.LeaseExpiration))
+	}
+	return n
+}
+
+func (m *FenceStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FenceWatermark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovFence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovFence(uint64(m.Round))
+	}
+	if m.Step != 0 {
+		n += 1 + sovFence(uint64(m.Step))
+	}
+	l = len(m.ClusterID)
+	if l > 0 {
+		n += 1 + l + sovFence(uint64(l))
+	}
+	return n
+}
+
+func (m *FenceStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovFence(uint64(l))
+	}
+	if m.LeaseExpiration != 0 {
+		n += 1 + sovFence(uint64(m.LeaseExpiration))
+	}
+	if len(m.Watermarks) > 0 {
+		for _, e := range m.Watermarks {
+			l = e.Size()
+			n += 1 + l + sovFence(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFence(x uint64) (n int) {
+	return sovFence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseDuration", wireType)
+			}
+			m.LeaseDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpiration", wireType)
+			}
+			m.LeaseExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FenceStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FenceStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FenceStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FenceWatermark) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FenceWatermark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FenceWatermark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FenceStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FenceStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FenceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpiration", wireType)
+			}
+			m.LeaseExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Watermarks = append(m.Watermarks, &FenceWatermark{})
+			if err := m.Watermarks[len(m.Watermarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFence = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1612 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: strangelove/horcrux/privval.proto
+
+package proto
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PrivValMessage holds the privval messages CometBFT added after v0.38. Its fields have the same numbers
+// and wire types as the Message of the CometBFT privval protocol, so a message read from a chain node
+// can be decoded as either.
+type PrivValMessage struct {
+	// Types that are valid to be assigned to Sum:
+	//	*PrivValMessage_PubKeyResponse
+	//	*PrivValMessage_SignRawBytesRequest
+	//	*PrivValMessage_SignedRawBytesResponse
+	Sum isPrivValMessage_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *PrivValMessage) Reset()         { *m = PrivValMessage{} }
+func (m *PrivValMessage) String() string { return proto.CompactTextString(m) }
+func (*PrivValMessage) ProtoMessage()    {}
+func (*PrivValMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8f9a80a6bb1cde, []int{0}
+}
+func (m *PrivValMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivValMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivValMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivValMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivValMessage.Merge(m, src)
+}
+func (m *PrivValMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivValMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivValMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivValMessage proto.InternalMessageInfo
+
+type isPrivValMessage_Sum interface {
+	isPrivValMessage_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type PrivValMessage_PubKeyResponse struct {
+	PubKeyResponse *PrivValPubKeyResponse `protobuf:"bytes,2,opt,name=pubKeyResponse,proto3,oneof" json:"pubKeyResponse,omitempty"`
+}
+type PrivValMessage_SignRawBytesRequest struct {
+	SignRawBytesRequest *SignRawBytesRequest `protobuf:"bytes,9,opt,name=signRawBytesRequest,proto3,oneof" json:"signRawBytesRequest,omitempty"`
+}
+type PrivValMessage_SignedRawBytesResponse struct {
+	SignedRawBytesResponse *SignedRawBytesResponse `protobuf:"bytes,10,opt,name=signedRawBytesResponse,proto3,oneof" json:"signedRawBytesResponse,omitempty"`
+}
+
+func (*PrivValMessage_PubKeyResponse) isPrivValMessage_Sum()         {}
+func (*PrivValMessage_SignRawBytesRequest) isPrivValMessage_Sum()    {}
+func (*PrivValMessage_SignedRawBytesResponse) isPrivValMessage_Sum() {}
+
+func (m *PrivValMessage) GetSum() isPrivValMessage_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *PrivValMessage) GetPubKeyResponse() *PrivValPubKeyResponse {
+	if x, ok := m.GetSum().(*PrivValMessage_PubKeyResponse); ok {
+		return x.PubKeyResponse
+	}
+	return nil
+}
+
+func (m *PrivValMessage) GetSignRawBytesRequest() *SignRawBytesRequest {
+	if x, ok := m.GetSum().(*PrivValMessage_SignRawBytesRequest); ok {
+		return x.SignRawBytesRequest
+	}
+	return nil
+}
+
+func (m *PrivValMessage) GetSignedRawBytesResponse() *SignedRawBytesResponse {
+	if x, ok := m.GetSum().(*PrivValMessage_SignedRawBytesResponse); ok {
+		return x.SignedRawBytesResponse
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PrivValMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PrivValMessage_PubKeyResponse)(nil),
+		(*PrivValMessage_SignRawBytesRequest)(nil),
+		(*PrivValMessage_SignedRawBytesResponse)(nil),
+	}
+}
+
+// PrivValPubKeyResponse answers chain nodes on either version of the protocol: CometBFT v0.38 reads the
+// encoded tendermint.crypto.PublicKey of pubKey, and CometBFT v1 reads pubKeyBytes and pubKeyType.
+type PrivValPubKeyResponse struct {
+	PubKey      []byte                    `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Error       *PrivValRemoteSignerError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PubKeyBytes []byte                    `protobuf:"bytes,3,opt,name=pubKeyBytes,proto3" json:"pubKeyBytes,omitempty"`
+	PubKeyType  string                    `protobuf:"bytes,4,opt,name=pubKeyType,proto3" json:"pubKeyType,omitempty"`
+}
+
+func (m *PrivValPubKeyResponse) Reset()         { *m = PrivValPubKeyResponse{} }
+func (m *PrivValPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*PrivValPubKeyResponse) ProtoMessage()    {}
+func (*PrivValPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8f9a80a6bb1cde, []int{1}
+}
+func (m *PrivValPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivValPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivValPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivValPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivValPubKeyResponse.Merge(m, src)
+}
+func (m *PrivValPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivValPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivValPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivValPubKeyResponse proto.InternalMessageInfo
+
+func (m *PrivValPubKeyResponse) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PrivValPubKeyResponse) GetError() *PrivValRemoteSignerError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *PrivValPubKeyResponse) GetPubKeyBytes() []byte {
+	if m != nil {
+		return m.PubKeyBytes
+	}
+	return nil
+}
+
+func (m *PrivValPubKeyResponse) GetPubKeyType() string {
+	if m != nil {
+		return m.PubKeyType
+	}
+	return ""
+}
+
+type PrivValRemoteSignerError struct {
+	Code        int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *PrivValRemoteSignerError) Reset()         { *m = PrivValRemoteSignerError{} }
+func (m *PrivValRemoteSignerError) String() string { return proto.CompactTextString(m) }
+func (*PrivValRemoteSignerError) ProtoMessage()    {}
+func (*PrivValRemoteSignerError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8f9a80a6bb1cde, []int{2}
+}
+func (m *PrivValRemoteSignerError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivValRemoteSignerError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivValRemoteSignerError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivValRemoteSignerError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivValRemoteSignerError.Merge(m, src)
+}
+func (m *PrivValRemoteSignerError) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivValRemoteSignerError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivValRemoteSignerError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivValRemoteSignerError proto.InternalMessageInfo
+
+func (m *PrivValRemoteSignerError) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *PrivValRemoteSignerError) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// SignRawBytesRequest is a request to sign bytes which are not a vote or a proposal.
+type SignRawBytesRequest struct {
+	ChainID  string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	RawBytes []byte `protobuf:"bytes,2,opt,name=rawBytes,proto3" json:"rawBytes,omitempty"`
+	UniqueID string `protobuf:"bytes,3,opt,name=uniqueID,proto3" json:"uniqueID,omitempty"`
+}
+
+func (m *SignRawBytesRequest) Reset()         { *m = SignRawBytesRequest{} }
+func (m *SignRawBytesRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRawBytesRequest) ProtoMessage()    {}
+func (*SignRawBytesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8f9a80a6bb1cde, []int{3}
+}
+func (m *SignRawBytesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRawBytesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRawBytesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignRawBytesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRawBytesRequest.Merge(m, src)
+}
+func (m *SignRawBytesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRawBytesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRawBytesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRawBytesRequest proto.InternalMessageInfo
+
+func (m *SignRawBytesRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SignRawBytesRequest) GetRawBytes() []byte {
+	if m != nil {
+		return m.RawBytes
+	}
+	return nil
+}
+
+func (m *SignRawBytesRequest) GetUniqueID() string {
+	if m != nil {
+		return m.UniqueID
+	}
+	return ""
+}
+
+type SignedRawBytesResponse struct {
+	Signature []byte                    `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Error     *PrivValRemoteSignerError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SignedRawBytesResponse) Reset()         { *m = SignedRawBytesResponse{} }
+func (m *SignedRawBytesResponse) String() string { return proto.CompactTextString(m) }
+func (*SignedRawBytesResponse) ProtoMessage()    {}
+func (*SignedRawBytesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8f9a80a6bb1cde, []int{4}
+}
+func (m *SignedRawBytesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedRawBytesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedRawBytesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedRawBytesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedRawBytesResponse.Merge(m, src)
+}
+func (m *SignedRawBytesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedRawBytesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedRawBytesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedRawBytesResponse proto.InternalMessageInfo
+
+func (m *SignedRawBytesResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignedRawBytesResponse) GetError() *PrivValRemoteSignerError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PrivValMessage)(nil), "strangelove.horcrux.PrivValMessage")
+	proto.RegisterType((*PrivValPubKeyResponse)(nil), "strangelove.horcrux.PrivValPubKeyResponse")
+	proto.RegisterType((*PrivValRemoteSignerError)(nil), "strangelove.horcrux.PrivValRemoteSignerError")
+	proto.RegisterType((*SignRawBytesRequest)(nil), "strangelove.horcrux.SignRawBytesRequest")
+	proto.RegisterType((*SignedRawBytesResponse)(nil), "strangelove.horcrux.SignedRawBytesResponse")
+}
+
+func init() { proto.RegisterFile("strangelove/horcrux/privval.proto", fileDescriptor_2b8f9a80a6bb1cde) }
+
+var fileDescriptor_2b8f9a80a6bb1cde = []byte{
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xbd, 0x6d, 0x53, 0xf0, 0xb4, 0xea, 0x61, 0x23, 0x22, 0x0b, 0x21, 0x2b, 0xf8, 0x64,
+	0x81, 0x6a, 0x4b, 0xf4, 0xc0, 0x3d, 0x14, 0x29, 0x15, 0x42, 0x0a, 0x4b, 0xc5, 0x01, 0x71, 0x71,
+	0x9c, 0x91, 0xb3, 0x52, 0xe2, 0x75, 0xf7, 0xc3, 0x10, 0xf1, 0x12, 0x3c, 0x0b, 0x07, 0x9e, 0x81,
+	0x63, 0x8f, 0x1c, 0x51, 0xf2, 0x22, 0xc8, 0x6b, 0x93, 0x1a, 0xe2, 0x70, 0xe2, 0x64, 0xcf, 0xec,
+	0xcc, 0xef, 0x3f, 0x1f, 0xbb, 0xf0, 0x58, 0x69, 0x99, 0xe4, 0x19, 0x2e, 0x44, 0x89, 0xf1, 0x5c,
+	0xc8, 0x54, 0x9a, 0x4f, 0x71, 0x21, 0x79, 0x59, 0x26, 0x8b, 0xa8, 0x90, 0x42, 0x0b, 0xda, 0x6f,
+	0x85, 0x44, 0x4d, 0x48, 0xf0, 0xf5, 0x00, 0xce, 0x26, 0x92, 0x97, 0xef, 0x92, 0xc5, 0x6b, 0x54,
+	0x2a, 0xc9, 0x90, 0x5e, 0xc3, 0x59, 0x61, 0xa6, 0xaf, 0x70, 0xc5, 0x50, 0x15, 0x22, 0x57, 0xe8,
+	0x1d, 0x0c, 0x49, 0x78, 0xf2, 0xec, 0x49, 0xd4, 0x01, 0x88, 0x9a, 0xe4, 0xc9, 0x1f, 0x19, 0x63,
+	0x87, 0xfd, 0xc5, 0xa0, 0x1f, 0xa0, 0xaf, 0x78, 0x96, 0xb3, 0xe4, 0xe3, 0x68, 0xa5, 0x51, 0x31,
+	0xbc, 0x31, 0xa8, 0xb4, 0xe7, 0x5a, 0x74, 0xd8, 0x89, 0x7e, 0xbb, 0x1b, 0x3f, 0x76, 0x58, 0x17,
+	0x86, 0x22, 0x0c, 0x2a, 0x37, 0xce, 0xee, 0x0e, 0x9a, 0xda, 0xc1, 0x0a, 0x3c, 0xdd, 0x2b, 0xb0,
+	0x9b, 0x32, 0x76, 0xd8, 0x1e, 0xd8, 0xa8, 0x07, 0x87, 0xca, 0x2c, 0x83, 0x6f, 0x04, 0x1e, 0x74,
+	0xf6, 0x4d, 0x07, 0x70, 0x5c, 0xf7, 0xed, 0x91, 0x21, 0x09, 0x4f, 0x59, 0x63, 0xd1, 0x17, 0xd0,
+	0x43, 0x29, 0x85, 0x6c, 0x46, 0x79, 0xfe, 0xaf, 0x51, 0x32, 0x5c, 0x0a, 0x8d, 0xb6, 0x36, 0xf9,
+	0xb2, 0x4a, 0x62, 0x75, 0x2e, 0x1d, 0xc2, 0x49, 0x8d, 0xb3, 0x45, 0x79, 0x87, 0x56, 0xa1, 0xed,
+	0xa2, 0x3e, 0x40, 0x6d, 0x5e, 0xaf, 0x0a, 0xf4, 0x8e, 0x86, 0x24, 0x74, 0x59, 0xcb, 0x13, 0x4c,
+	0xc0, 0xdb, 0x27, 0x42, 0x29, 0x1c, 0xa5, 0x62, 0x86, 0xb6, 0xf0, 0x1e, 0xb3, 0xff, 0x95, 0xe2,
+	0x0c, 0x55, 0x2a, 0x79, 0xa1, 0xb9, 0xc8, 0x6d, 0xf1, 0x2e, 0x6b, 0xbb, 0x82, 0x0c, 0xfa, 0x1d,
+	0x6b, 0xa2, 0x1e, 0xdc, 0x4b, 0xe7, 0x09, 0xcf, 0xaf, 0x2e, 0x2d, 0xcf, 0x65, 0xbf, 0x4d, 0xfa,
+	0x10, 0xee, 0xcb, 0x26, 0xd8, 0xf2, 0x4e, 0xd9, 0xd6, 0xae, 0xce, 0x4c, 0xce, 0x6f, 0x0c, 0x5e,
+	0x5d, 0xda, 0xee, 0x5c, 0xb6, 0xb5, 0x83, 0xcf, 0x30, 0xe8, 0x5e, 0x17, 0x7d, 0x04, 0x6e, 0xb5,
+	0xae, 0x44, 0x1b, 0x89, 0xcd, 0xd8, 0xef, 0x1c, 0xff, 0x65, 0xf2, 0xa3, 0x37, 0xdf, 0xd7, 0x3e,
+	0xb9, 0x5d, 0xfb, 0xe4, 0xe7, 0xda, 0x27, 0x5f, 0x36, 0xbe, 0x73, 0xbb, 0xf1, 0x9d, 0x1f, 0x1b,
+	0xdf, 0x79, 0xff, 0x3c, 0xe3, 0x7a, 0x6e, 0xa6, 0x51, 0x2a, 0x96, 0x71, 0x8b, 0x7c, 0x5e, 0x62,
+	0x5e, 0xc9, 0xab, 0xed, 0x5b, 0x2c, 0x2f, 0x62, 0x7b, 0xa9, 0x64, 0x6c, 0x5f, 0xe3, 0xf4, 0xd8,
+	0x7e, 0x2e, 0x7e, 0x0d, 0x00, 0x96, 0x01, 0x68, 0x87, 0xb9, 0x03, 0x00, 0x00,
+}
+
+func (m *PrivValMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivValMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivValMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivValMessage_PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivValMessage_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PubKeyResponse != nil {
+		{
+			size, err := m.PubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPrivval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PrivValMessage_SignRawBytesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivValMessage_SignRawBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignRawBytesRequest != nil {
+		{
+			size, err := m.SignRawBytesRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPrivval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PrivValMessage_SignedRawBytesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivValMessage_SignedRawBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignedRawBytesResponse != nil {
+		{
+			size, err := m.SignedRawBytesResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPrivval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PrivValPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivValPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivValPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeyType) > 0 {
+		i -= len(m.PubKeyType)
+		copy(dAtA[i:], m.PubKeyType)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.PubKeyType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKeyBytes) > 0 {
+		i -= len(m.PubKeyBytes)
+		copy(dAtA[i:], m.PubKeyBytes)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.PubKeyBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPrivval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivValRemoteSignerError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivValRemoteSignerError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivValRemoteSignerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPrivval(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignRawBytesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRawBytesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRawBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UniqueID) > 0 {
+		i -= len(m.UniqueID)
+		copy(dAtA[i:], m.UniqueID)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.UniqueID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawBytes) > 0 {
+		i -= len(m.RawBytes)
+		copy(dAtA[i:], m.RawBytes)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.RawBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedRawBytesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedRawBytesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedRawBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPrivval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintPrivval(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrivval(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrivval(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PrivValMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *PrivValMessage_PubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeyResponse != nil {
+		l = m.PubKeyResponse.Size()
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+func (m *PrivValMessage_SignRawBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignRawBytesRequest != nil {
+		l = m.SignRawBytesRequest.Size()
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+func (m *PrivValMessage_SignedRawBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedRawBytesResponse != nil {
+		l = m.SignedRawBytesResponse.Size()
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+func (m *PrivValPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	l = len(m.PubKeyBytes)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	l = len(m.PubKeyType)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+
+func (m *PrivValRemoteSignerError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPrivval(uint64(m.Code))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+
+func (m *SignRawBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	l = len(m.RawBytes)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	l = len(m.UniqueID)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedRawBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPrivval(uint64(l))
+	}
+	return n
+}
+
+func sovPrivval(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrivval(x uint64) (n int) {
+	return sovPrivval(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PrivValMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivValMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivValMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PrivValPubKeyResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PrivValMessage_PubKeyResponse{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignRawBytesRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignRawBytesRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PrivValMessage_SignRawBytesRequest{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedRawBytesResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignedRawBytesResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &PrivValMessage_SignedRawBytesResponse{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrivValPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivValPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivValPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &PrivValRemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyBytes = append(m.PubKeyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKeyBytes == nil {
+				m.PubKeyBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrivValRemoteSignerError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivValRemoteSignerError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivValRemoteSignerError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRawBytesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRawBytesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRawBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawBytes == nil {
+				m.RawBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedRawBytesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrivval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedRawBytesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedRawBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &PrivValRemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrivval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrivval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrivval(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrivval
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrivval
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrivval
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrivval
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrivval
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrivval        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrivval          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrivval = fmt.Errorf("proto: unexpected end of group")
+)
@@ -73,6 +73,8 @@ func (m *PubKeyRequest) GetChainId() string {
 
 type PubKeyResponse struct {
 	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// key_type is the consensus key type, e.g. ed25519 or secp256k1.
+	KeyType string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
 }
 
 func (m *PubKeyResponse) Reset()         { *m = PubKeyResponse{} }
@@ -115,6 +117,13 @@ func (m *PubKeyResponse) GetPubKey() []byte {
 	return nil
 }
 
+func (m *PubKeyResponse) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PubKeyRequest)(nil), "strangelove.horcrux.PubKeyRequest")
 	proto.RegisterType((*PubKeyResponse)(nil), "strangelove.horcrux.PubKeyResponse")
@@ -125,25 +134,26 @@ func init() {
 }
 
 var fileDescriptor_afd7664cd19b584a = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
+	// 298 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x2e, 0x29, 0x4a,
 	0xcc, 0x4b, 0x4f, 0xcd, 0xc9, 0x2f, 0x4b, 0xd5, 0xcf, 0xc8, 0x2f, 0x4a, 0x2e, 0x2a, 0xad, 0xd0,
 	0x2f, 0x4a, 0xcd, 0xcd, 0x2f, 0x49, 0x8d, 0x2f, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0xd2, 0x2b, 0x28,
 	0xca, 0x2f, 0xc9, 0x17, 0x12, 0x46, 0x52, 0xa8, 0x07, 0x55, 0x28, 0xa5, 0x84, 0x4d, 0x77, 0x72,
 	0x3e, 0xb2, 0x46, 0x25, 0x2d, 0x2e, 0xde, 0x80, 0xd2, 0x24, 0xef, 0xd4, 0xca, 0xa0, 0xd4, 0xc2,
 	0xd2, 0xd4, 0xe2, 0x12, 0x21, 0x49, 0x2e, 0x8e, 0xe4, 0x8c, 0xc4, 0xcc, 0xbc, 0xf8, 0xcc, 0x14,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x76, 0x30, 0xdf, 0x33, 0x45, 0x49, 0x93, 0x8b, 0x0f,
+	0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x76, 0x30, 0xdf, 0x33, 0x45, 0xc9, 0x85, 0x8b, 0x0f,
 	0xa6, 0xb6, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x48, 0x9c, 0x8b, 0xbd, 0xa0, 0x34, 0x29, 0x3e,
-	0x3b, 0xb5, 0x12, 0xac, 0x96, 0x27, 0x88, 0xad, 0x00, 0xac, 0xc0, 0x68, 0x0f, 0x23, 0x17, 0x4f,
-	0x10, 0xd8, 0x9d, 0xc1, 0x60, 0xdb, 0x84, 0x82, 0xb9, 0xd8, 0x20, 0x7a, 0x85, 0x94, 0xf4, 0xb0,
-	0xb8, 0x55, 0x0f, 0xc5, 0x11, 0x52, 0xca, 0x78, 0xd5, 0x40, 0x2c, 0x57, 0x62, 0x10, 0x0a, 0xe7,
-	0x62, 0x01, 0x19, 0x2f, 0xa4, 0x8a, 0x55, 0x39, 0x48, 0xca, 0x29, 0x27, 0x3f, 0x39, 0x1b, 0x66,
-	0xaa, 0x1a, 0x21, 0x65, 0x30, 0x83, 0x9d, 0x02, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xc9,
-	0x34, 0xdd, 0xb2, 0xd4, 0xbc, 0x92, 0xd2, 0xa2, 0xd4, 0x62, 0x78, 0x38, 0x97, 0x19, 0xeb, 0x43,
-	0x02, 0x5a, 0x1f, 0x1c, 0xd0, 0x49, 0x6c, 0x60, 0xca, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x1e,
-	0xa5, 0x90, 0x75, 0xd3, 0x01, 0x00, 0x00,
+	0x3b, 0xb5, 0x12, 0xac, 0x96, 0x27, 0x88, 0xad, 0x00, 0xac, 0x00, 0x64, 0x4a, 0x76, 0x6a, 0x65,
+	0x7c, 0x49, 0x65, 0x41, 0xaa, 0x04, 0x13, 0xc4, 0x94, 0xec, 0xd4, 0xca, 0x90, 0xca, 0x82, 0x54,
+	0xa3, 0x3d, 0x8c, 0x5c, 0x3c, 0x41, 0x60, 0x2f, 0x04, 0x83, 0x1d, 0x22, 0x14, 0xcc, 0xc5, 0x06,
+	0x31, 0x56, 0x48, 0x49, 0x0f, 0x8b, 0x37, 0xf4, 0x50, 0xdc, 0x27, 0xa5, 0x8c, 0x57, 0x0d, 0xc4,
+	0x5d, 0x4a, 0x0c, 0x42, 0xe1, 0x5c, 0x2c, 0x20, 0xe3, 0x85, 0x54, 0xb1, 0x2a, 0x07, 0x49, 0x39,
+	0xe5, 0xe4, 0x27, 0x67, 0xc3, 0x4c, 0x55, 0x23, 0xa4, 0x0c, 0x66, 0xb0, 0x53, 0xe0, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
+	0x25, 0xe7, 0xe7, 0xea, 0x23, 0x99, 0xa6, 0x5b, 0x96, 0x9a, 0x57, 0x52, 0x5a, 0x94, 0x5a, 0x0c,
+	0x8f, 0x82, 0x32, 0x63, 0x7d, 0x48, 0x1c, 0xe8, 0x83, 0xe3, 0x20, 0x89, 0x0d, 0x4c, 0x19, 0x03,
+	0x06, 0x00, 0xc2, 0xb2, 0x43, 0xbe, 0xee, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -312,6 +322,13 @@ func (m *PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PubKey) > 0 {
 		i -= len(m.PubKey)
 		copy(dAtA[i:], m.PubKey)
@@ -356,6 +373,10 @@ func (m *PubKeyResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRemoteSigner(uint64(l))
 	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
 	return n
 }
 
@@ -510,6 +531,38 @@ func (m *PubKeyResponse) Unmarshal(dAtA []byte) error {
 				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
@@ -0,0 +1,1114 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: strangelove/horcrux/shard_signer.proto
+
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LoadShardRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ShardID int32  `protobuf:"varint,2,opt,name=shardID,proto3" json:"shardID,omitempty"`
+}
+
+func (m *LoadShardRequest) Reset()         { *m = LoadShardRequest{} }
+func (m *LoadShardRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadShardRequest) ProtoMessage()    {}
+func (*LoadShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7a18a0396bb7755, []int{0}
+}
+func (m *LoadShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoadShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoadShardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LoadShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadShardRequest.Merge(m, src)
+}
+func (m *LoadShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoadShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadShardRequest proto.InternalMessageInfo
+
+func (m *LoadShardRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *LoadShardRequest) GetShardID() int32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+type LoadShardResponse struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+}
+
+func (m *LoadShardResponse) Reset()         { *m = LoadShardResponse{} }
+func (m *LoadShardResponse) String() string { return proto.CompactTextString(m) }
+func (*LoadShardResponse) ProtoMessage()    {}
+func (*LoadShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7a18a0396bb7755, []int{1}
+}
+func (m *LoadShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoadShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoadShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LoadShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadShardResponse.Merge(m, src)
+}
+func (m *LoadShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoadShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadShardResponse proto.InternalMessageInfo
+
+func (m *LoadShardResponse) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+type SignWithShardRequest struct {
+	ChainID string   `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Nonces  []*Nonce `protobuf:"bytes,2,rep,name=nonces,proto3" json:"nonces,omitempty"`
+	Payload []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *SignWithShardRequest) Reset()         { *m = SignWithShardRequest{} }
+func (m *SignWithShardRequest) String() string { return proto.CompactTextString(m) }
+func (*SignWithShardRequest) ProtoMessage()    {}
+func (*SignWithShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7a18a0396bb7755, []int{2}
+}
+func (m *SignWithShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignWithShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignWithShardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignWithShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignWithShardRequest.Merge(m, src)
+}
+func (m *SignWithShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignWithShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignWithShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignWithShardRequest proto.InternalMessageInfo
+
+func (m *SignWithShardRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SignWithShardRequest) GetNonces() []*Nonce {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+func (m *SignWithShardRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type SignWithShardResponse struct {
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignWithShardResponse) Reset()         { *m = SignWithShardResponse{} }
+func (m *SignWithShardResponse) String() string { return proto.CompactTextString(m) }
+func (*SignWithShardResponse) ProtoMessage()    {}
+func (*SignWithShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7a18a0396bb7755, []int{3}
+}
+func (m *SignWithShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignWithShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignWithShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignWithShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignWithShardResponse.Merge(m, src)
+}
+func (m *SignWithShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignWithShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignWithShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignWithShardResponse proto.InternalMessageInfo
+
+func (m *SignWithShardResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LoadShardRequest)(nil), "strangelove.horcrux.LoadShardRequest")
+	proto.RegisterType((*LoadShardResponse)(nil), "strangelove.horcrux.LoadShardResponse")
+	proto.RegisterType((*SignWithShardRequest)(nil), "strangelove.horcrux.SignWithShardRequest")
+	proto.RegisterType((*SignWithShardResponse)(nil), "strangelove.horcrux.SignWithShardResponse")
+}
+
+func init() {
+	proto.RegisterFile("strangelove/horcrux/shard_signer.proto", fileDescriptor_c7a18a0396bb7755)
+}
+
+var fileDescriptor_c7a18a0396bb7755 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcf, 0x4a, 0xfb, 0x40,
+	0x18, 0xcc, 0xb6, 0xfc, 0xfa, 0xa3, 0x5b, 0x05, 0x5d, 0xff, 0x10, 0x82, 0x84, 0x12, 0xb0, 0x54,
+	0xc5, 0x04, 0x5a, 0xc4, 0xbb, 0x14, 0xa1, 0x28, 0x82, 0xe9, 0x41, 0x10, 0x41, 0xb6, 0xe9, 0x92,
+	0x04, 0xea, 0x6e, 0xdc, 0x4d, 0x8a, 0xbd, 0xf8, 0x0c, 0x3e, 0x96, 0xc7, 0x5e, 0x04, 0x8f, 0xd2,
+	0xbc, 0x88, 0xec, 0x36, 0xa9, 0xb1, 0x04, 0xec, 0x29, 0x4c, 0x76, 0xbe, 0x99, 0xf9, 0x86, 0x0f,
+	0xb6, 0x44, 0xcc, 0x31, 0xf5, 0xc9, 0x98, 0x4d, 0x88, 0x13, 0x30, 0xee, 0xf1, 0xe4, 0xc5, 0x11,
+	0x01, 0xe6, 0xa3, 0x47, 0x11, 0xfa, 0x94, 0x70, 0x3b, 0xe2, 0x2c, 0x66, 0x68, 0xa7, 0xc0, 0xb3,
+	0x33, 0x9e, 0x61, 0x95, 0x0d, 0x7b, 0xac, 0x38, 0x68, 0x5d, 0xc2, 0xad, 0x6b, 0x86, 0x47, 0x03,
+	0x29, 0xe9, 0x92, 0xe7, 0x84, 0x88, 0x18, 0xe9, 0xf0, 0xbf, 0x17, 0xe0, 0x90, 0xf6, 0x7b, 0x3a,
+	0x68, 0x82, 0x76, 0xdd, 0xcd, 0xa1, 0x7c, 0x51, 0xe6, 0xfd, 0x9e, 0x5e, 0x69, 0x82, 0xf6, 0x3f,
+	0x37, 0x87, 0xd6, 0x09, 0xdc, 0x2e, 0xe8, 0x88, 0x88, 0x51, 0x41, 0xd0, 0x3e, 0xac, 0x45, 0xc9,
+	0xf0, 0x8a, 0x4c, 0x95, 0xce, 0x86, 0x9b, 0x21, 0xeb, 0x15, 0xee, 0x0e, 0x42, 0x9f, 0xde, 0x85,
+	0x71, 0xb0, 0xa6, 0x71, 0x07, 0xd6, 0x28, 0xa3, 0x1e, 0x11, 0x7a, 0xa5, 0x59, 0x6d, 0x37, 0x3a,
+	0x86, 0x5d, 0xb2, 0xb0, 0x7d, 0x23, 0x29, 0x6e, 0xc6, 0x94, 0x6a, 0x11, 0x9e, 0x8e, 0x19, 0x1e,
+	0xe9, 0x55, 0x65, 0x9f, 0x43, 0xeb, 0x0c, 0xee, 0xad, 0xf8, 0x67, 0x81, 0x0f, 0x60, 0x5d, 0xb6,
+	0x83, 0xe3, 0x84, 0x93, 0x2c, 0xf3, 0xcf, 0x8f, 0xce, 0x07, 0x80, 0x0d, 0xc5, 0x1f, 0xa8, 0x06,
+	0xd1, 0x03, 0xac, 0x2f, 0x77, 0x46, 0x87, 0xa5, 0x89, 0x56, 0xbb, 0x35, 0x5a, 0x7f, 0xd1, 0x16,
+	0x49, 0x2c, 0x0d, 0x05, 0x70, 0xf3, 0x57, 0x48, 0x74, 0x54, 0x3a, 0x5a, 0x56, 0xa4, 0x71, 0xbc,
+	0x0e, 0x35, 0x77, 0xba, 0xb8, 0x7d, 0x9f, 0x9b, 0x60, 0x36, 0x37, 0xc1, 0xd7, 0xdc, 0x04, 0x6f,
+	0xa9, 0xa9, 0xcd, 0x52, 0x53, 0xfb, 0x4c, 0x4d, 0xed, 0xfe, 0xdc, 0x0f, 0xe3, 0x20, 0x19, 0xda,
+	0x1e, 0x7b, 0x72, 0x0a, 0x8a, 0xa7, 0x13, 0x42, 0x65, 0x23, 0x62, 0x79, 0x55, 0x93, 0xae, 0xb3,
+	0x38, 0x2b, 0x47, 0x9d, 0xd5, 0xb0, 0xa6, 0x3e, 0xdd, 0xef, 0x01, 0x00, 0x3f, 0x49, 0x3e, 0xc6,
+	0xc0, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ShardSignerClient is the client API for ShardSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShardSignerClient interface {
+	LoadShard(ctx context.Context, in *LoadShardRequest, opts ...grpc.CallOption) (*LoadShardResponse, error)
+	SignWithShard(ctx context.Context, in *SignWithShardRequest, opts ...grpc.CallOption) (*SignWithShardResponse, error)
+}
+
+type shardSignerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewShardSignerClient(cc grpc1.ClientConn) ShardSignerClient {
+	return &shardSignerClient{cc}
+}
+
+func (c *shardSignerClient) LoadShard(ctx context.Context, in *LoadShardRequest, opts ...grpc.CallOption) (*LoadShardResponse, error) {
+	out := new(LoadShardResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.ShardSigner/LoadShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shardSignerClient) SignWithShard(ctx context.Context, in *SignWithShardRequest, opts ...grpc.CallOption) (*SignWithShardResponse, error) {
+	out := new(SignWithShardResponse)
+	err := c.cc.Invoke(ctx, "/strangelove.horcrux.ShardSigner/SignWithShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShardSignerServer is the server API for ShardSigner service.
+type ShardSignerServer interface {
+	LoadShard(context.Context, *LoadShardRequest) (*LoadShardResponse, error)
+	SignWithShard(context.Context, *SignWithShardRequest) (*SignWithShardResponse, error)
+}
+
+// UnimplementedShardSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedShardSignerServer struct {
+}
+
+func (*UnimplementedShardSignerServer) LoadShard(ctx context.Context, req *LoadShardRequest) (*LoadShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadShard not implemented")
+}
+func (*UnimplementedShardSignerServer) SignWithShard(ctx context.Context, req *SignWithShardRequest) (*SignWithShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWithShard not implemented")
+}
+
+func RegisterShardSignerServer(s grpc1.Server, srv ShardSignerServer) {
+	s.RegisterService(&_ShardSigner_serviceDesc, srv)
+}
+
+func _ShardSigner_LoadShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShardSignerServer).LoadShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.ShardSigner/LoadShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShardSignerServer).LoadShard(ctx, req.(*LoadShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShardSigner_SignWithShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignWithShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShardSignerServer).SignWithShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove.horcrux.ShardSigner/SignWithShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShardSignerServer).SignWithShard(ctx, req.(*SignWithShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ShardSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "strangelove.horcrux.ShardSigner",
+	HandlerType: (*ShardSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoadShard",
+			Handler:    _ShardSigner_LoadShard_Handler,
+		},
+		{
+			MethodName: "SignWithShard",
+			Handler:    _ShardSigner_SignWithShard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "strangelove/horcrux/shard_signer.proto",
+}
+
+func (m *LoadShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		i = encodeVarintShardSigner(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintShardSigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoadShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintShardSigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignWithShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignWithShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignWithShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintShardSigner(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nonces) > 0 {
+		for iNdEx := len(m.Nonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShardSigner(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintShardSigner(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignWithShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignWithShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignWithShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintShardSigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintShardSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovShardSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LoadShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovShardSigner(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovShardSigner(uint64(m.ShardID))
+	}
+	return n
+}
+
+func (m *LoadShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovShardSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignWithShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovShardSigner(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		for _, e := range m.Nonces {
+			l = e.Size()
+			n += 1 + l + sovShardSigner(uint64(l))
+		}
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovShardSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignWithShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovShardSigner(uint64(l))
+	}
+	return n
+}
+
+func sovShardSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozShardSigner(x uint64) (n int) {
+	return sovShardSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LoadShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShardSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShardSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShardSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShardSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignWithShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShardSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignWithShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignWithShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonces = append(m.Nonces, &Nonce{})
+			if err := m.Nonces[len(m.Nonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShardSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignWithShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShardSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignWithShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignWithShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShardSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShardSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipShardSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowShardSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShardSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthShardSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupShardSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthShardSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthShardSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowShardSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupShardSigner = fmt.Errorf("proto: unexpected end of group")
+)
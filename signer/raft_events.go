@@ -2,21 +2,61 @@ package signer
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
 )
 
 const (
-	raftEventLSS = "LSS"
+	raftEventLSS           = "LSS"
+	raftEventShardRefresh  = "SR"
+	raftEventMembership    = "MEM"
+	raftEventShardReshare  = "RS"
+	raftEventSignInitiated = "SI"
+	raftEventSignFreeze    = "FRZ"
+
+	// raftKeyWatermarkPrefix prefixes the retained sign watermark of each chain ID.
+	raftKeyWatermarkPrefix = "WM/"
+	// raftKeySignFreezePrefix prefixes the retained runtime sign freeze of each chain ID.
+	raftKeySignFreezePrefix = "FRZ/"
 )
 
+// ErrSignFenced is returned when the leader tries to start signing at or below
+// an HRS which was already started, possibly by a previous leader.
+var ErrSignFenced = errors.New("sign fenced by the replicated watermark")
+
+// SignInitiated is committed through raft by the leader before it requests signature shares.
+type SignInitiated struct {
+	ChainID string `json:"chainID"`
+	HRS     HRSKey `json:"hrs"`
+	Leader  int    `json:"leader"`
+}
+
+// SignWatermark is the highest HRS started or signed for a chain ID, retained in the raft FSM and its snapshots.
+type SignWatermark struct {
+	HRS HRSKey `json:"hrs"`
+	// Leader is the shard ID of the leader which started signing HRS, or 0 if it is only known to be signed.
+	Leader int `json:"leader"`
+}
+
 func (f *fsm) getEventHandler(key string) func(string) {
 	return map[string]func(string){
-		raftEventLSS: f.handleLSSEvent,
+		raftEventLSS:          f.handleLSSEvent,
+		raftEventShardRefresh: f.handleShardRefreshEvent,
+		raftEventMembership:   f.handleMembershipEvent,
+		raftEventShardReshare: f.handleShardReshareEvent,
+		raftEventSignFreeze:   f.handleSignFreezeEvent,
 	}[key]
 }
 
 func (f *fsm) shouldRetain(key string) bool {
-	// Last sign state handled as events only
-	return key != raftEventLSS
+	// Last sign state, shard refresh, membership, reshare and sign freeze handled as events only
+	switch key {
+	case raftEventLSS, raftEventShardRefresh, raftEventMembership, raftEventShardReshare, raftEventSignInitiated,
+		raftEventSignFreeze:
+		return false
+	}
+	return true
 }
 
 func (f *fsm) handleLSSEvent(value string) {
@@ -39,4 +79,170 @@ func (f *fsm) handleLSSEvent(value string) {
 	}
 	_ = f.thresholdValidator.SaveLastSignedState(lss.ChainID, lss.SignStateConsensus)
 	_ = f.cosigner.SaveLastSignedState(lss.ChainID, lss.SignStateConsensus)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	hrs := lss.SignStateConsensus.HRSKey()
+	if wm, ok := f.watermarkLocked(lss.ChainID); !ok || hrs.GreaterThan(wm.HRS) {
+		f.setWatermarkLocked(lss.ChainID, SignWatermark{HRS: hrs})
+	}
+}
+
+// applySignInitiated raises the watermark of the chain ID to the initiated HRS. It returns an
+// ErrSignFenced error to the leader if the HRS is not above the watermark, unless the same leader
+// started it, which may sign the HRS again with a different timestamp.
+func (f *fsm) applySignInitiated(value string) interface{} {
+	si := SignInitiated{}
+	if err := json.Unmarshal([]byte(value), &si); err != nil {
+		f.logger.Error(
+			"SignInitiated Unmarshal Error",
+			"error", err,
+		)
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	wm, ok := f.watermarkLocked(si.ChainID)
+	if ok && !si.HRS.GreaterThan(wm.HRS) && (si.HRS != wm.HRS || si.Leader != wm.Leader) {
+		return fmt.Errorf("%w: [%s] %d.%d.%d was already started at %d.%d.%d by leader %d",
+			ErrSignFenced, si.ChainID, si.HRS.Height, si.HRS.Round, si.HRS.Step,
+			wm.HRS.Height, wm.HRS.Round, wm.HRS.Step, wm.Leader)
+	}
+	f.setWatermarkLocked(si.ChainID, SignWatermark{HRS: si.HRS, Leader: si.Leader})
+	return nil
+}
+
+func (f *fsm) watermarkLocked(chainID string) (SignWatermark, bool) {
+	var wm SignWatermark
+	value, ok := f.m[raftKeyWatermarkPrefix+chainID]
+	if !ok {
+		return wm, false
+	}
+	if err := json.Unmarshal([]byte(value), &wm); err != nil {
+		f.logger.Error(
+			"SignWatermark Unmarshal Error",
+			"chain_id", chainID,
+			"error", err,
+		)
+		return wm, false
+	}
+	return wm, true
+}
+
+func (f *fsm) setWatermarkLocked(chainID string, wm SignWatermark) {
+	bz, err := json.Marshal(wm)
+	if err != nil {
+		panic(err)
+	}
+	f.m[raftKeyWatermarkPrefix+chainID] = string(bz)
+}
+
+func (f *fsm) handleShardRefreshEvent(value string) {
+	commit := &ShardRefreshCommit{}
+	if err := json.Unmarshal([]byte(value), commit); err != nil {
+		f.logger.Error(
+			"ShardRefreshCommit Unmarshal Error",
+			"error", err,
+		)
+		return
+	}
+	if err := f.cosigner.CommitShardRefresh(commit.ChainID, commit.SessionID); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// raft log replay of a refresh which was already committed.
+			f.logger.Debug(
+				"No staged key shard for refresh",
+				"chain_id", commit.ChainID,
+			)
+			return
+		}
+		f.logger.Error(
+			"Error committing key shard refresh",
+			"chain_id", commit.ChainID,
+			"error", err,
+		)
+	}
+}
+
+func (f *fsm) handleMembershipEvent(value string) {
+	membership := ClusterMembership{}
+	if err := json.Unmarshal([]byte(value), &membership); err != nil {
+		f.logger.Error(
+			"ClusterMembership Unmarshal Error",
+			"error", err,
+		)
+		return
+	}
+	if err := (*RaftStore)(f).applyMembership(membership); err != nil {
+		f.logger.Error(
+			"Error applying cluster membership change",
+			"error", err,
+		)
+	}
+}
+
+func (f *fsm) handleShardReshareEvent(value string) {
+	commit := ShardReshareCommit{}
+	if err := json.Unmarshal([]byte(value), &commit); err != nil {
+		f.logger.Error(
+			"ShardReshareCommit Unmarshal Error",
+			"error", err,
+		)
+		return
+	}
+	if err := f.cosigner.CommitShardReshare(commit); err != nil {
+		f.logger.Error(
+			"Error committing key shard reshare",
+			"error", err,
+		)
+	}
+	if f.thresholdValidator != nil {
+		f.thresholdValidator.SetThreshold(commit.Threshold)
+	}
+}
+
+// handleSignFreezeEvent retains the runtime sign freeze of a chain, so that it is included in raft snapshots.
+// A freeze without a halt height or windows clears the runtime freeze of the chain.
+func (f *fsm) handleSignFreezeEvent(value string) {
+	freeze := SignFreeze{}
+	if err := json.Unmarshal([]byte(value), &freeze); err != nil {
+		f.logger.Error(
+			"SignFreeze Unmarshal Error",
+			"error", err,
+		)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if freeze.IsZero() {
+		delete(f.m, raftKeySignFreezePrefix+freeze.ChainID)
+		f.logger.Info("Sign freeze cleared", "chain_id", freeze.ChainID)
+		return
+	}
+	f.m[raftKeySignFreezePrefix+freeze.ChainID] = value
+	f.logger.Info(
+		"Sign freeze set",
+		"chain_id", freeze.ChainID,
+		"halt_height", freeze.HaltHeight,
+		"freeze_windows", len(freeze.FreezeWindows),
+	)
+}
+
+func (f *fsm) signFreezeLocked(chainID string) (SignFreeze, bool) {
+	var freeze SignFreeze
+	value, ok := f.m[raftKeySignFreezePrefix+chainID]
+	if !ok {
+		return freeze, false
+	}
+	if err := json.Unmarshal([]byte(value), &freeze); err != nil {
+		f.logger.Error(
+			"SignFreeze Unmarshal Error",
+			"chain_id", chainID,
+			"error", err,
+		)
+		return freeze, false
+	}
+	return freeze, true
 }
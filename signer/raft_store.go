@@ -15,7 +15,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,11 +30,15 @@ import (
 	boltdb "github.com/hashicorp/raft-boltdb/v2"
 	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
-var _ Leader = (*RaftStore)(nil)
+var (
+	_ Leader               = (*RaftStore)(nil)
+	_ LeadershipTransferer = (*RaftStore)(nil)
+)
 
 const (
 	retainSnapshotCount = 2
@@ -53,6 +59,10 @@ type RaftStore struct {
 	RaftBind    string
 	RaftTimeout time.Duration
 	Cosigners   []Cosigner
+	// protects Cosigners, which change with cluster membership
+	cosignersMu sync.RWMutex
+	// serializes membership changes coordinated by this node
+	membershipMu sync.Mutex
 
 	mu sync.Mutex
 	m  map[string]string // The key-value store for the system.
@@ -62,6 +72,7 @@ type RaftStore struct {
 	logger             log.Logger
 	cosigner           *LocalCosigner
 	thresholdValidator *ThresholdValidator
+	tls                *CosignerTLS
 }
 
 // New returns a new Store.
@@ -83,10 +94,42 @@ func NewRaftStore(
 	return cosignerRaftStore
 }
 
+// getCosigners returns the current peer cosigners.
+func (s *RaftStore) getCosigners() []Cosigner {
+	s.cosignersMu.RLock()
+	defer s.cosignersMu.RUnlock()
+	return s.Cosigners
+}
+
+func (s *RaftStore) setCosigners(cosigners []Cosigner) {
+	s.cosignersMu.Lock()
+	defer s.cosignersMu.Unlock()
+	s.Cosigners = cosigners
+}
+
 func (s *RaftStore) SetThresholdValidator(thresholdValidator *ThresholdValidator) {
 	s.thresholdValidator = thresholdValidator
 }
 
+// SetTLS enables mutual TLS for the p2p listener and the Raft transport.
+func (s *RaftStore) SetTLS(cosignerTLS *CosignerTLS) {
+	s.tls = cosignerTLS
+}
+
+func (s *RaftStore) serverOptions() []grpc.ServerOption {
+	if s.tls == nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(s.tls.ServerCredentials())}
+}
+
+func (s *RaftStore) transportCredentials() credentials.TransportCredentials {
+	if s.tls == nil {
+		return insecure.NewCredentials()
+	}
+	return s.tls.TransportCredentials()
+}
+
 func (s *RaftStore) init() error {
 	host := p2pURLToRaftAddress(s.RaftBind)
 	_, port, err := net.SplitHostPort(host)
@@ -102,7 +145,7 @@ func (s *RaftStore) init() error {
 	if err != nil {
 		return err
 	}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(s.serverOptions()...)
 	proto.RegisterCosignerServer(grpcServer, NewCosignerGRPCServer(s.cosigner, s.thresholdValidator, s))
 	transportManager.Register(grpcServer)
 	leaderhealth.Setup(s.raft, grpcServer, []string{"Leader"})
@@ -166,7 +209,7 @@ func (s *RaftStore) Open() (*raftgrpctransport.Manager, error) {
 
 	// Setup Raft communication.
 	transportManager := raftgrpctransport.New(raftAddress, []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(s.transportCredentials()),
 	})
 
 	// Instantiate the Raft systems.
@@ -184,7 +227,7 @@ func (s *RaftStore) Open() (*raftgrpctransport.Manager, error) {
 			},
 		},
 	}
-	for _, c := range s.Cosigners {
+	for _, c := range s.getCosigners() {
 		configuration.Servers = append(configuration.Servers, raft.Server{
 			ID:      raft.ServerID(fmt.Sprint(c.GetID())),
 			Address: raft.ServerAddress(p2pURLToRaftAddress(c.GetAddress())),
@@ -306,10 +349,106 @@ func (s *RaftStore) GetLeader() int {
 	return id
 }
 
+// TransferLeadershipTo transfers the raft leadership to the cosigner with id.
+func (s *RaftStore) TransferLeadershipTo(id int) error {
+	if !s.IsLeader() {
+		return fmt.Errorf("not leader")
+	}
+	cosigner := Cosigners(s.getCosigners()).GetByID(id)
+	if cosigner == nil {
+		return fmt.Errorf("cosigner %d is not a peer", id)
+	}
+	f := s.raft.LeadershipTransferToServer(
+		raft.ServerID(fmt.Sprint(id)),
+		raft.ServerAddress(p2pURLToRaftAddress(cosigner.GetAddress())),
+	)
+	return f.Error()
+}
+
 func (s *RaftStore) ShareSigned(lss ChainSignStateConsensus) error {
 	return s.Emit(raftEventLSS, lss)
 }
 
+// FenceSign commits the HRS this leader is about to sign for chainID through raft.
+// It returns an ErrSignFenced error if the HRS was already started, e.g. by a previous leader.
+func (s *RaftStore) FenceSign(chainID string, hrs HRSKey) error {
+	if !s.IsLeader() {
+		return fmt.Errorf("not leader")
+	}
+
+	value, err := json.Marshal(SignInitiated{
+		ChainID: chainID,
+		HRS:     hrs,
+		Leader:  s.cosigner.GetID(),
+	})
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(&command{
+		Op:    "set",
+		Key:   raftEventSignInitiated,
+		Value: string(value),
+	})
+	if err != nil {
+		return err
+	}
+
+	f := s.raft.Apply(b, s.RaftTimeout)
+	if err := f.Error(); err != nil {
+		return err
+	}
+	if err, ok := f.Response().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// SignWatermark returns the replicated sign watermark for chainID, if any.
+func (s *RaftStore) SignWatermark(chainID string) (SignWatermark, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return (*fsm)(s).watermarkLocked(chainID)
+}
+
+// SetSignFreeze replicates a runtime sign freeze for a chain, in addition to the freeze in the config.
+// A freeze without a halt height or windows clears the runtime freeze of the chain.
+func (s *RaftStore) SetSignFreeze(freeze SignFreeze) error {
+	if freeze.ChainID == "" {
+		return fmt.Errorf("chain ID must not be empty")
+	}
+	if err := freeze.Validate(); err != nil {
+		return err
+	}
+	return s.Emit(raftEventSignFreeze, freeze)
+}
+
+// SignFreeze returns the runtime sign freeze for chainID, if any.
+func (s *RaftStore) SignFreeze(chainID string) (SignFreeze, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return (*fsm)(s).signFreezeLocked(chainID)
+}
+
+// SignFreezes returns the runtime sign freezes of all chains, sorted by chain ID.
+func (s *RaftStore) SignFreezes() []SignFreeze {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var freezes []SignFreeze
+	for key := range s.m {
+		if !strings.HasPrefix(key, raftKeySignFreezePrefix) {
+			continue
+		}
+		if freeze, ok := (*fsm)(s).signFreezeLocked(strings.TrimPrefix(key, raftKeySignFreezePrefix)); ok {
+			freezes = append(freezes, freeze)
+		}
+	}
+	sort.Slice(freezes, func(i, j int) bool {
+		return freezes[i].ChainID < freezes[j].ChainID
+	})
+	return freezes
+}
+
 type fsm RaftStore
 
 // Apply applies a Raft log entry to the key-value store.
@@ -358,6 +497,9 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 }
 
 func (f *fsm) applySet(key, value string) interface{} {
+	if key == raftEventSignInitiated {
+		return f.applySignInitiated(value)
+	}
 	eventHandler := f.getEventHandler(key)
 	if eventHandler != nil {
 		eventHandler(value)
@@ -1,7 +1,10 @@
 package signer
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/json"
+	"io"
 	"os"
 	"testing"
 	"time"
@@ -87,3 +90,44 @@ func Test_StoreInMemOpenSingleNode(t *testing.T) {
 		t.Fatalf("key has wrong value: %s", value)
 	}
 }
+
+func TestFSMSignWatermark(t *testing.T) {
+	f := &fsm{
+		m:      make(map[string]string),
+		logger: log.NewNopLogger(),
+	}
+
+	signInitiated := func(height int64, round int64, leader int) interface{} {
+		value, err := json.Marshal(SignInitiated{
+			ChainID: testChainID,
+			HRS:     HRSKey{Height: height, Round: round, Step: stepPrevote},
+			Leader:  leader,
+		})
+		require.NoError(t, err)
+		return f.applySet(raftEventSignInitiated, string(value))
+	}
+
+	require.Nil(t, signInitiated(10, 0, 1))
+	// the same leader may sign the same HRS again, e.g. with a different timestamp.
+	require.Nil(t, signInitiated(10, 0, 1))
+
+	// a new leader can not start at or below the HRS started by the previous leader.
+	for _, res := range []interface{}{signInitiated(10, 0, 2), signInitiated(9, 5, 2)} {
+		err, ok := res.(error)
+		require.True(t, ok)
+		require.ErrorIs(t, err, ErrSignFenced)
+	}
+	require.Nil(t, signInitiated(10, 1, 2))
+
+	// the watermark is part of the snapshot, so it survives log compaction.
+	snapshot, err := f.Snapshot()
+	require.NoError(t, err)
+	restored := &fsm{logger: log.NewNopLogger()}
+	bz, err := json.Marshal(snapshot.(*fsmSnapshot).store)
+	require.NoError(t, err)
+	require.NoError(t, restored.Restore(io.NopCloser(bytes.NewReader(bz))))
+
+	wm, ok := restored.watermarkLocked(testChainID)
+	require.True(t, ok)
+	require.Equal(t, SignWatermark{HRS: HRSKey{Height: 10, Round: 1, Step: stepPrevote}, Leader: 2}, wm)
+}
@@ -0,0 +1,127 @@
+package signer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/tempfile"
+)
+
+// rawBytesSignPrefix separates the sign bytes of raw bytes from the sign bytes of votes and proposals,
+// as in CometBFT, so a raw bytes signature can never be used as a vote or proposal signature.
+const rawBytesSignPrefix = "COMET::RAW_BYTES::SIGN"
+
+var (
+	// ErrRawBytesNotAllowed is returned for raw bytes which the chain policy does not allow signing.
+	ErrRawBytesNotAllowed = errors.New("raw bytes signing is not allowed")
+
+	// ErrRawBytesConflict is returned when a unique ID was already signed for different raw bytes.
+	ErrRawBytesConflict = errors.New("conflicting raw bytes")
+)
+
+// RawBytesSigner is implemented by PrivValidators which sign raw bytes for SignRawBytes requests,
+// which CometBFT added to the privval protocol after v0.38.
+type RawBytesSigner interface {
+	SignRawBytes(ctx context.Context, chainID, uniqueID string, rawBytes []byte) ([]byte, error)
+}
+
+// RawBytesSignBytes returns the domain separated bytes which are signed for rawBytes.
+func RawBytesSignBytes(uniqueID string, rawBytes []byte) ([]byte, error) {
+	if uniqueID == "" {
+		return nil, fmt.Errorf("unique ID of raw bytes must not be empty")
+	}
+	signBytes := make([]byte, 0, len(rawBytesSignPrefix)+len(uniqueID)+len(rawBytes))
+	signBytes = append(signBytes, rawBytesSignPrefix...)
+	signBytes = append(signBytes, uniqueID...)
+	return append(signBytes, rawBytes...), nil
+}
+
+// RawBytesConfig allows raw bytes to be signed for a chain.
+type RawBytesConfig struct {
+	// UniqueIDPrefixes are the prefixes of the unique IDs which may be signed. Any unique ID is allowed when empty.
+	UniqueIDPrefixes []string `yaml:"uniqueIDPrefixes,omitempty"`
+}
+
+// CheckUniqueID returns an ErrRawBytesNotAllowed error if uniqueID does not have an allowed prefix.
+func (c RawBytesConfig) CheckUniqueID(uniqueID string) error {
+	if len(c.UniqueIDPrefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range c.UniqueIDPrefixes {
+		if strings.HasPrefix(uniqueID, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unique ID %s does not have an allowed prefix", ErrRawBytesNotAllowed, uniqueID)
+}
+
+// RawBytesSignState is the double sign protection of raw bytes. It records a digest of the raw bytes signed
+// for every unique ID of a chain, so a unique ID is never signed for two different raw bytes.
+type RawBytesSignState struct {
+	mu       sync.Mutex
+	filePath string
+
+	// Signed is the hex sha256 digest of the raw bytes signed for each unique ID.
+	Signed map[string]string `json:"signed"`
+}
+
+// LoadOrCreateRawBytesSignState loads the raw bytes sign state from filePath, or returns an empty state
+// if the file does not exist yet.
+func LoadOrCreateRawBytesSignState(filePath string) (*RawBytesSignState, error) {
+	state := &RawBytesSignState{
+		filePath: filePath,
+		Signed:   make(map[string]string),
+	}
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, fmt.Errorf("failed to read raw bytes sign state %s: %w", filePath, err)
+	}
+	if state.Signed == nil {
+		state.Signed = make(map[string]string)
+	}
+	return state, nil
+}
+
+// Record returns an ErrRawBytesConflict error if uniqueID was signed for other raw bytes. Otherwise it persists
+// the digest of rawBytes for uniqueID, which must happen before the signature is returned.
+func (s *RawBytesSignState) Record(uniqueID string, rawBytes []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	digest := sha256.Sum256(rawBytes)
+	hexDigest := hex.EncodeToString(digest[:])
+
+	signed, ok := s.Signed[uniqueID]
+	if ok {
+		if signed != hexDigest {
+			return fmt.Errorf("%w: unique ID %s was already signed for raw bytes with digest %s",
+				ErrRawBytesConflict, uniqueID, signed)
+		}
+		return nil
+	}
+
+	s.Signed[uniqueID] = hexDigest
+	bz, err := json.Marshal(s)
+	if err != nil {
+		delete(s.Signed, uniqueID)
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(s.filePath, bz, 0600); err != nil {
+		delete(s.Signed, uniqueID)
+		return fmt.Errorf("failed to persist raw bytes sign state: %w", err)
+	}
+	return nil
+}
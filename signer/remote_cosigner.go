@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -23,9 +24,15 @@ type RemoteCosigner struct {
 	client proto.CosignerClient
 }
 
-// NewRemoteCosigner returns a newly initialized RemoteCosigner
-func NewRemoteCosigner(id int, address string) (*RemoteCosigner, error) {
-	client, err := getGRPCClient(address)
+// NewRemoteCosigner returns a newly initialized RemoteCosigner.
+// If cosignerTLS is non-nil, the connection uses mutual TLS and the peer must identify as the given ID.
+func NewRemoteCosigner(id int, address string, cosignerTLS *CosignerTLS) (*RemoteCosigner, error) {
+	creds := insecure.NewCredentials()
+	if cosignerTLS != nil {
+		creds = cosignerTLS.PeerCredentials(id)
+	}
+
+	client, err := getGRPCClient(address, creds)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +70,7 @@ func (cosigner *RemoteCosigner) VerifySignature(_ string, _, _ []byte) bool {
 	return false
 }
 
-func getGRPCClient(address string) (proto.CosignerClient, error) {
+func getGRPCClient(address string, creds credentials.TransportCredentials) (proto.CosignerClient, error) {
 	var grpcAddress string
 	url, err := url.Parse(address)
 	if err != nil {
@@ -71,7 +78,7 @@ func getGRPCClient(address string) (proto.CosignerClient, error) {
 	} else {
 		grpcAddress = url.Host
 	}
-	conn, err := grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(grpcAddress, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +121,12 @@ func (cosigner *RemoteCosigner) SetNoncesAndSign(
 		Nonces:    req.Nonces.Nonces.toProto(),
 		Hrst:      req.HRST.toProto(),
 		SignBytes: req.SignBytes,
+
+		RawBytesUniqueID: req.RawBytesUniqueID,
+		RawBytes:         req.RawBytes,
+	}
+	for _, id := range req.NonceDealers {
+		cosignerReq.NonceDealers = append(cosignerReq.NonceDealers, int32(id))
 	}
 
 	if req.VoteExtensionNonces != nil && len(req.VoteExtensionSignBytes) > 0 {
@@ -151,3 +164,92 @@ func (cosigner *RemoteCosigner) Sign(
 		VoteExtensionSignature: res.VoteExtSignature,
 	}, nil
 }
+
+// DKGCommit implements DKGParticipant.
+func (cosigner *RemoteCosigner) DKGCommit(ctx context.Context, req DKGCommitRequest) (*DKGPackage, error) {
+	participants := make([]int32, len(req.Participants))
+	for i, id := range req.Participants {
+		participants[i] = int32(id)
+	}
+	res, err := cosigner.client.DKGCommit(ctx, &proto.DKGCommitRequest{
+		SessionID:    req.SessionID,
+		ChainID:      req.ChainID,
+		Threshold:    int32(req.Threshold),
+		Participants: participants,
+		Mode:         proto.DKGMode(req.Mode),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Package == nil {
+		return nil, fmt.Errorf("cosigner %d returned no dkg package", cosigner.id)
+	}
+	pkg := DKGPackageFromProto(res.Package)
+	return &pkg, nil
+}
+
+// DKGDeal implements DKGParticipant.
+func (cosigner *RemoteCosigner) DKGDeal(
+	ctx context.Context,
+	sessionID []byte,
+	packages DKGPackages,
+) ([]CosignerNonce, error) {
+	res, err := cosigner.client.DKGDeal(ctx, &proto.DKGDealRequest{
+		SessionID: sessionID,
+		Packages:  packages.toProto(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return CosignerNoncesFromProto(res.Shares), nil
+}
+
+// DKGFinalize implements DKGParticipant.
+func (cosigner *RemoteCosigner) DKGFinalize(
+	ctx context.Context,
+	sessionID []byte,
+	shares []CosignerNonce,
+) (*DKGResult, error) {
+	res, err := cosigner.client.DKGFinalize(ctx, &proto.DKGFinalizeRequest{
+		SessionID: sessionID,
+		Shares:    CosignerNonces(shares).toProto(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DKGResult{
+		PubKey:         res.PubKey,
+		TranscriptHash: res.TranscriptHash,
+	}, nil
+}
+
+// FROSTCommit implements FROSTParticipant.
+func (cosigner *RemoteCosigner) FROSTCommit(ctx context.Context, count int) (FROSTCommitments, error) {
+	res, err := cosigner.client.FROSTCommit(ctx, &proto.FROSTCommitRequest{
+		Count: int32(count),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return FROSTCommitmentsFromProto(res.Commitments), nil
+}
+
+// FROSTSign implements FROSTParticipant.
+func (cosigner *RemoteCosigner) FROSTSign(ctx context.Context, req FROSTSignRequest) (*CosignerSignResponse, error) {
+	res, err := cosigner.client.FROSTSign(ctx, &proto.FROSTSignRequest{
+		ChainID:            req.ChainID,
+		Hrst:               req.HRST.toProto(),
+		SignBytes:          req.SignBytes,
+		Commitments:        req.Commitments.toProto(),
+		VoteExtSignBytes:   req.VoteExtensionSignBytes,
+		VoteExtCommitments: req.VoteExtensionCommitments.toProto(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CosignerSignResponse{
+		Timestamp:              time.Unix(0, res.Timestamp),
+		Signature:              res.Signature,
+		VoteExtensionSignature: res.VoteExtSignature,
+	}, nil
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"time"
 
+	cometcrypto "github.com/cometbft/cometbft/crypto"
 	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
 	cometcryptoencoding "github.com/cometbft/cometbft/crypto/encoding"
 	cometlog "github.com/cometbft/cometbft/libs/log"
@@ -15,6 +18,7 @@ import (
 	cometprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cometprotoprivval "github.com/cometbft/cometbft/proto/tendermint/privval"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
 )
 
 const connRetrySec = 2
@@ -23,38 +27,82 @@ const connRetrySec = 2
 // with additional Stop method for safe shutdown.
 type PrivValidator interface {
 	Sign(ctx context.Context, chainID string, block Block) ([]byte, []byte, time.Time, error)
-	GetPubKey(ctx context.Context, chainID string) ([]byte, error)
+	GetPubKey(ctx context.Context, chainID string) (cometcrypto.PubKey, error)
 	Stop()
 }
 
+// privValHandler responds to the privval requests of a chain node using its privVal.
+type privValHandler struct {
+	logger  cometlog.Logger
+	address string
+	chainID string
+	privVal PrivValidator
+
+	// protocols are the privval protocols of the chains which do not use the default protocol.
+	protocols map[string]PrivValProtocol
+}
+
+// SetPrivValProtocols sets the privval protocols of chains whose nodes run a release before CometBFT v0.38.
+// It must be called before Start.
+func (h *privValHandler) SetPrivValProtocols(protocols map[string]PrivValProtocol) {
+	h.protocols = protocols
+}
+
+// protocol returns the privval protocol of chainID.
+func (h *privValHandler) protocol(chainID string) PrivValProtocol {
+	if p, ok := h.protocols[chainID]; ok && p != "" {
+		return p
+	}
+	return PrivValProtocolCometBFT038
+}
+
 // ReconnRemoteSigner dials using its dialer and responds to any
 // signature requests using its privVal.
 type ReconnRemoteSigner struct {
 	cometservice.BaseService
+	privValHandler
 
-	address string
-	privKey cometcryptoed25519.PrivKey
-	privVal PrivValidator
+	privKey cometcrypto.PrivKey
+
+	// remotePubKey is the key the chain node must authenticate with. Any key is accepted when nil.
+	remotePubKey cometcrypto.PubKey
 
 	dialer net.Dialer
+
+	// cancel cancels the requests in progress when the signer is stopped.
+	cancel context.CancelFunc
 }
 
 // NewReconnRemoteSigner return a ReconnRemoteSigner that will dial using the given
 // dialer and respond to any signature requests over the connection
-// using the given privVal.
+// using the given privVal. If chainID is not empty, requests for any other chain ID are rejected.
+// The secret connection is authenticated with privKey, or with an ephemeral key if privKey is nil,
+// and if remotePubKey is not nil, connections to a chain node with any other key are closed.
 //
 // If the connection is broken, the ReconnRemoteSigner will attempt to reconnect.
 func NewReconnRemoteSigner(
 	address string,
+	chainID string,
 	logger cometlog.Logger,
 	privVal PrivValidator,
+	privKey cometcrypto.PrivKey,
+	remotePubKey cometcrypto.PubKey,
 	dialer net.Dialer,
 ) *ReconnRemoteSigner {
+	if privKey == nil {
+		privKey = cometcryptoed25519.GenPrivKey()
+	}
+
 	rs := &ReconnRemoteSigner{
-		address: address,
-		privVal: privVal,
-		dialer:  dialer,
-		privKey: cometcryptoed25519.GenPrivKey(),
+		privValHandler: privValHandler{
+			logger:  logger,
+			address: address,
+			chainID: chainID,
+			privVal: privVal,
+		},
+		privKey:      privKey,
+		remotePubKey: remotePubKey,
+		dialer:       dialer,
 	}
 
 	rs.BaseService = *cometservice.NewBaseService(logger, "RemoteSigner", rs)
@@ -63,12 +111,15 @@ func NewReconnRemoteSigner(
 
 // OnStart implements cmn.Service.
 func (rs *ReconnRemoteSigner) OnStart() error {
-	go rs.loop(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.cancel = cancel
+	go rs.loop(ctx)
 	return nil
 }
 
 // OnStop implements cmn.Service.
 func (rs *ReconnRemoteSigner) OnStop() {
+	rs.cancel()
 	rs.privVal.Stop()
 }
 
@@ -76,18 +127,36 @@ func (rs *ReconnRemoteSigner) establishConnection(ctx context.Context) (net.Conn
 	ctx, cancel := context.WithTimeout(ctx, connRetrySec*time.Second)
 	defer cancel()
 
-	proto, address := cometnet.ProtocolAndAddress(rs.address)
-	netConn, err := rs.dialer.DialContext(ctx, proto, address)
+	protocol, address := cometnet.ProtocolAndAddress(rs.address)
+	if protocol == protocolUnix {
+		if err := checkUnixSocket(address); err != nil {
+			return nil, err
+		}
+	}
+	netConn, err := rs.dialer.DialContext(ctx, protocol, address)
 	if err != nil {
 		return nil, fmt.Errorf("dial error: %w", err)
 	}
 
+	// like CometBFT, privval on unix sockets does not use a secret connection,
+	// the permissions of the socket protect it instead.
+	if protocol == protocolUnix {
+		return netConn, nil
+	}
+
 	conn, err := cometp2pconn.MakeSecretConnection(netConn, rs.privKey)
 	if err != nil {
 		netConn.Close()
 		return nil, fmt.Errorf("secret connection error: %w", err)
 	}
 
+	if rs.remotePubKey != nil && !rs.remotePubKey.Equals(conn.RemotePubKey()) {
+		conn.Close()
+		totalSentryPubKeyMismatch.WithLabelValues(rs.address).Inc()
+		return nil, fmt.Errorf("chain node authenticated with public key %X, expected %X",
+			conn.RemotePubKey().Bytes(), rs.remotePubKey.Bytes())
+	}
+
 	return conn, nil
 }
 
@@ -108,14 +177,14 @@ func (rs *ReconnRemoteSigner) loop(ctx context.Context) {
 			if err == nil {
 				sentryConnectTries.WithLabelValues(rs.address).Set(0)
 				timer.Stop()
-				rs.Logger.Info("Connected to Sentry", "address", rs.address)
+				rs.logger.Info("Connected to Sentry", "address", rs.address, "chain_id", rs.chainID)
 				break
 			}
 
 			sentryConnectTries.WithLabelValues(rs.address).Add(1)
 			totalSentryConnectTries.WithLabelValues(rs.address).Inc()
 			retries++
-			rs.Logger.Error(
+			rs.logger.Error(
 				"Error establishing connection, will retry",
 				"sleep (s)", connRetrySec,
 				"address", rs.address,
@@ -136,9 +205,9 @@ func (rs *ReconnRemoteSigner) loop(ctx context.Context) {
 			return
 		}
 
-		req, err := ReadMsg(conn)
+		req, err := readMsgBytes(conn)
 		if err != nil {
-			rs.Logger.Error(
+			rs.logger.Error(
 				"Failed to read message from connection",
 				"address", rs.address,
 				"err", err,
@@ -148,12 +217,22 @@ func (rs *ReconnRemoteSigner) loop(ctx context.Context) {
 			continue
 		}
 
-		// handleRequest handles request errors. We always send back a response
-		res := rs.handleRequest(req)
+		// handleMsgBytes handles request errors. We always send back a response
+		res, err := rs.handleMsgBytes(ctx, req)
+		if err != nil {
+			rs.logger.Error(
+				"Failed to decode message from connection",
+				"address", rs.address,
+				"err", err,
+			)
+			rs.closeConn(conn)
+			conn = nil
+			continue
+		}
 
-		err = WriteMsg(conn, res)
+		err = writeMsgBytes(conn, res)
 		if err != nil {
-			rs.Logger.Error(
+			rs.logger.Error(
 				"Failed to write message to connection",
 				"address", rs.address,
 				"err", err,
@@ -164,47 +243,183 @@ func (rs *ReconnRemoteSigner) loop(ctx context.Context) {
 	}
 }
 
-func (rs *ReconnRemoteSigner) handleRequest(req cometprotoprivval.Message) cometprotoprivval.Message {
+// sentryChainLabel is the chain_id metric label of a sentry, which is "*" if it is allowed any chain ID.
+func (h *privValHandler) sentryChainLabel() string {
+	if h.chainID == "" {
+		return "*"
+	}
+	return h.chainID
+}
+
+// checkChainID returns an error if chainID is not allowed on this connection.
+func (h *privValHandler) checkChainID(chainID string) error {
+	if h.chainID == "" || h.chainID == chainID {
+		return nil
+	}
+	totalSentryRejectedRequests.WithLabelValues(h.address, h.chainID).Inc()
+	h.logger.Error(
+		"Rejected request for chain ID not allowed on this sentry",
+		"address", h.address,
+		"chain_id", chainID,
+		"allowed_chain_id", h.chainID,
+	)
+	return fmt.Errorf("chain ID %s is not allowed on this connection, expected %s", chainID, h.chainID)
+}
+
+// handleMsgBytes responds to an encoded privval message of a chain node, and returns the encoded response.
+// Messages CometBFT added to the privval protocol after v0.38 are decoded as a PrivValMessage,
+// and all other messages as a CometBFT v0.38 Message. ctx is canceled when the signer stops.
+func (h *privValHandler) handleMsgBytes(ctx context.Context, bz []byte) ([]byte, error) {
+	var ext proto.PrivValMessage
+	if err := ext.Unmarshal(bz); err == nil {
+		if req := ext.GetSignRawBytesRequest(); req != nil {
+			res := &proto.PrivValMessage{Sum: &proto.PrivValMessage_SignedRawBytesResponse{
+				SignedRawBytesResponse: h.handleSignRawBytesRequest(ctx, req),
+			}}
+			return res.Marshal()
+		}
+	}
+
+	var req cometprotoprivval.Message
+	if err := req.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	res := h.handleRequest(ctx, req)
+	if pubKeyRes := res.GetPubKeyResponse(); pubKeyRes != nil {
+		return pubKeyResponseBytes(pubKeyRes)
+	}
+	return res.Marshal()
+}
+
+// pubKeyResponseBytes encodes a public key response for chain nodes on either version of the privval protocol.
+func pubKeyResponseBytes(res *cometprotoprivval.PubKeyResponse) ([]byte, error) {
+	out := &proto.PrivValPubKeyResponse{}
+	if res.Error != nil {
+		out.Error = &proto.PrivValRemoteSignerError{Code: res.Error.Code, Description: res.Error.Description}
+	} else {
+		pubKey, err := cometcryptoencoding.PubKeyFromProto(res.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		out.PubKey, err = res.PubKey.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		out.PubKeyBytes = pubKey.Bytes()
+		out.PubKeyType = pubKey.Type()
+	}
+	msg := &proto.PrivValMessage{Sum: &proto.PrivValMessage_PubKeyResponse{PubKeyResponse: out}}
+	return msg.Marshal()
+}
+
+func (h *privValHandler) handleSignRawBytesRequest(
+	ctx context.Context,
+	req *proto.SignRawBytesRequest,
+) *proto.SignedRawBytesResponse {
+	res := &proto.SignedRawBytesResponse{}
+	if err := h.checkChainID(req.ChainID); err != nil {
+		res.Error = privValRemoteSignerError(err)
+		return res
+	}
+
+	signer, ok := h.privVal.(RawBytesSigner)
+	if !ok {
+		totalRawBytesSigns.WithLabelValues(req.ChainID, "unsupported").Inc()
+		res.Error = privValRemoteSignerError(fmt.Errorf("%w: the signer does not support raw bytes", ErrRawBytesNotAllowed))
+		return res
+	}
+
+	sig, err := signer.SignRawBytes(ctx, req.ChainID, req.UniqueID, req.RawBytes)
+	if err != nil {
+		totalRawBytesSigns.WithLabelValues(req.ChainID, "error").Inc()
+		h.logger.Error(
+			"Failed to sign raw bytes",
+			"chain_id", req.ChainID,
+			"unique_id", req.UniqueID,
+			"node", h.address,
+			"error", err,
+		)
+		res.Error = privValRemoteSignerError(err)
+		return res
+	}
+
+	totalRawBytesSigns.WithLabelValues(req.ChainID, "success").Inc()
+	res.Signature = sig
+	return res
+}
+
+func (h *privValHandler) handleRequest(ctx context.Context, req cometprotoprivval.Message) cometprotoprivval.Message {
 	switch typedReq := req.Sum.(type) {
 	case *cometprotoprivval.Message_SignVoteRequest:
-		return rs.handleSignVoteRequest(typedReq.SignVoteRequest.ChainId, typedReq.SignVoteRequest.Vote)
+		if err := h.checkChainID(typedReq.SignVoteRequest.ChainId); err != nil {
+			return cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignedVoteResponse{
+				SignedVoteResponse: &cometprotoprivval.SignedVoteResponse{Error: getRemoteSignerError(err)},
+			}}
+		}
+		return h.handleSignVoteRequest(ctx, typedReq.SignVoteRequest.ChainId, typedReq.SignVoteRequest.Vote)
 	case *cometprotoprivval.Message_SignProposalRequest:
-		return rs.handleSignProposalRequest(typedReq.SignProposalRequest.ChainId, typedReq.SignProposalRequest.Proposal)
+		if err := h.checkChainID(typedReq.SignProposalRequest.ChainId); err != nil {
+			return cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignedProposalResponse{
+				SignedProposalResponse: &cometprotoprivval.SignedProposalResponse{Error: getRemoteSignerError(err)},
+			}}
+		}
+		return h.handleSignProposalRequest(ctx, typedReq.SignProposalRequest.ChainId, typedReq.SignProposalRequest.Proposal)
 	case *cometprotoprivval.Message_PubKeyRequest:
-		return rs.handlePubKeyRequest(typedReq.PubKeyRequest.ChainId)
+		if err := h.checkChainID(typedReq.PubKeyRequest.ChainId); err != nil {
+			return cometprotoprivval.Message{Sum: &cometprotoprivval.Message_PubKeyResponse{
+				PubKeyResponse: &cometprotoprivval.PubKeyResponse{Error: getRemoteSignerError(err)},
+			}}
+		}
+		return h.handlePubKeyRequest(ctx, typedReq.PubKeyRequest.ChainId)
 	case *cometprotoprivval.Message_PingRequest:
-		return rs.handlePingRequest()
+		return h.handlePingRequest()
 	default:
-		rs.Logger.Error("Unknown request", "err", fmt.Errorf("%v", typedReq))
+		h.logger.Error("Unknown request", "err", fmt.Errorf("%v", typedReq))
 		return cometprotoprivval.Message{}
 	}
 }
 
-func (rs *ReconnRemoteSigner) handleSignVoteRequest(chainID string, vote *cometproto.Vote) cometprotoprivval.Message {
+func (h *privValHandler) handleSignVoteRequest(
+	ctx context.Context,
+	chainID string,
+	vote *cometproto.Vote,
+) cometprotoprivval.Message {
 	msgSum := &cometprotoprivval.Message_SignedVoteResponse{SignedVoteResponse: &cometprotoprivval.SignedVoteResponse{
 		Vote:  cometproto.Vote{},
 		Error: nil,
 	}}
 
+	protocol := h.protocol(chainID)
 	sig, voteExtSig, timestamp, err := signAndTrack(
-		context.TODO(),
-		rs.Logger,
-		rs.privVal,
+		ctx,
+		h.logger,
+		h.privVal,
 		chainID,
-		VoteToBlock(chainID, vote),
+		protocol.VoteToBlock(chainID, vote),
 	)
 	if err != nil {
 		msgSum.SignedVoteResponse.Error = getRemoteSignerError(err)
 		return cometprotoprivval.Message{Sum: msgSum}
 	}
 
+	if protocol.Legacy() {
+		// older nodes replace their vote with the one in the response, so it must be complete.
+		msgSum.SignedVoteResponse.Vote = *vote
+		msgSum.SignedVoteResponse.Vote.Extension = nil
+		msgSum.SignedVoteResponse.Vote.ExtensionSignature = nil
+		msgSum.SignedVoteResponse.Vote.Timestamp = timestamp
+		msgSum.SignedVoteResponse.Vote.Signature = sig
+		return cometprotoprivval.Message{Sum: msgSum}
+	}
+
 	msgSum.SignedVoteResponse.Vote.Timestamp = timestamp
 	msgSum.SignedVoteResponse.Vote.Signature = sig
 	msgSum.SignedVoteResponse.Vote.ExtensionSignature = voteExtSig
 	return cometprotoprivval.Message{Sum: msgSum}
 }
 
-func (rs *ReconnRemoteSigner) handleSignProposalRequest(
+func (h *privValHandler) handleSignProposalRequest(
+	ctx context.Context,
 	chainID string,
 	proposal *cometproto.Proposal,
 ) cometprotoprivval.Message {
@@ -216,9 +431,9 @@ func (rs *ReconnRemoteSigner) handleSignProposalRequest(
 	}
 
 	signature, _, timestamp, err := signAndTrack(
-		context.TODO(),
-		rs.Logger,
-		rs.privVal,
+		ctx,
+		h.logger,
+		h.privVal,
 		chainID,
 		ProposalToBlock(chainID, proposal),
 	)
@@ -227,35 +442,40 @@ func (rs *ReconnRemoteSigner) handleSignProposalRequest(
 		return cometprotoprivval.Message{Sum: msgSum}
 	}
 
+	if h.protocol(chainID).Legacy() {
+		// older nodes replace their proposal with the one in the response, so it must be complete.
+		msgSum.SignedProposalResponse.Proposal = *proposal
+	}
+
 	msgSum.SignedProposalResponse.Proposal.Timestamp = timestamp
 	msgSum.SignedProposalResponse.Proposal.Signature = signature
 	return cometprotoprivval.Message{Sum: msgSum}
 }
 
-func (rs *ReconnRemoteSigner) handlePubKeyRequest(chainID string) cometprotoprivval.Message {
+func (h *privValHandler) handlePubKeyRequest(ctx context.Context, chainID string) cometprotoprivval.Message {
 	totalPubKeyRequests.WithLabelValues(chainID).Inc()
 	msgSum := &cometprotoprivval.Message_PubKeyResponse{PubKeyResponse: &cometprotoprivval.PubKeyResponse{
 		PubKey: cometprotocrypto.PublicKey{},
 		Error:  nil,
 	}}
 
-	pubKey, err := rs.privVal.GetPubKey(context.TODO(), chainID)
+	pubKey, err := h.privVal.GetPubKey(ctx, chainID)
 	if err != nil {
-		rs.Logger.Error(
+		h.logger.Error(
 			"Failed to get Pub Key",
 			"chain_id", chainID,
-			"node", rs.address,
+			"node", h.address,
 			"error", err,
 		)
 		msgSum.PubKeyResponse.Error = getRemoteSignerError(err)
 		return cometprotoprivval.Message{Sum: msgSum}
 	}
-	pk, err := cometcryptoencoding.PubKeyToProto(cometcryptoed25519.PubKey(pubKey))
+	pk, err := cometcryptoencoding.PubKeyToProto(pubKey)
 	if err != nil {
-		rs.Logger.Error(
+		h.logger.Error(
 			"Failed to get Pub Key",
 			"chain_id", chainID,
-			"node", rs.address,
+			"node", h.address,
 			"error", err,
 		)
 		msgSum.PubKeyResponse.Error = getRemoteSignerError(err)
@@ -265,7 +485,7 @@ func (rs *ReconnRemoteSigner) handlePubKeyRequest(chainID string) cometprotopriv
 	return cometprotoprivval.Message{Sum: msgSum}
 }
 
-func (rs *ReconnRemoteSigner) handlePingRequest() cometprotoprivval.Message {
+func (h *privValHandler) handlePingRequest() cometprotoprivval.Message {
 	return cometprotoprivval.Message{
 		Sum: &cometprotoprivval.Message_PingResponse{
 			PingResponse: &cometprotoprivval.PingResponse{},
@@ -283,29 +503,64 @@ func getRemoteSignerError(err error) *cometprotoprivval.RemoteSignerError {
 	}
 }
 
+func privValRemoteSignerError(err error) *proto.PrivValRemoteSignerError {
+	return &proto.PrivValRemoteSignerError{Description: err.Error()}
+}
+
 func StartRemoteSigners(
 	services []cometservice.Service,
 	logger cometlog.Logger,
 	privVal PrivValidator,
-	nodes []string,
+	nodes ChainNodes,
+	privKey cometcrypto.PrivKey,
+	protocols map[string]PrivValProtocol,
 ) ([]cometservice.Service, error) {
-	var err error
 	go StartMetrics()
 	for _, node := range nodes {
 		// CometBFT requires a connection within 3 seconds of start or crashes
 		// A long timeout such as 30 seconds would cause the sentry to fail in loops
 		// Use a short timeout and dial often to connect within 3 second window
 		dialer := net.Dialer{Timeout: 2 * time.Second}
-		s := NewReconnRemoteSigner(node, logger, privVal, dialer)
-
-		err = s.Start()
+		remotePubKey, err := node.RemotePubKey()
 		if err != nil {
 			return nil, err
 		}
+		s := NewReconnRemoteSigner(node.PrivValAddr, node.ChainID, logger, privVal, privKey, remotePubKey, dialer)
+		s.SetPrivValProtocols(protocols)
+		sentryChainInfo.WithLabelValues(node.PrivValAddr, s.sentryChainLabel()).Set(1)
+
+		if err := s.Start(); err != nil {
+			return nil, err
+		}
 
 		services = append(services, s)
 	}
-	return services, err
+	return services, nil
+}
+
+// checkUnixSocket returns an error if path is not a unix socket, or if other users
+// could write to the socket or replace it.
+func checkUnixSocket(path string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unix socket error: %w", err)
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s is not a unix socket", path)
+	}
+	if fi.Mode().Perm()&0002 != 0 {
+		return fmt.Errorf("unix socket %s must not be writable by other users, has mode %s", path, fi.Mode().Perm())
+	}
+	dir := filepath.Dir(path)
+	di, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("unix socket error: %w", err)
+	}
+	if di.Mode().Perm()&0002 != 0 {
+		return fmt.Errorf("directory %s of unix socket must not be writable by other users, has mode %s",
+			dir, di.Mode().Perm())
+	}
+	return nil
 }
 
 func (rs *ReconnRemoteSigner) closeConn(conn net.Conn) {
@@ -313,7 +568,7 @@ func (rs *ReconnRemoteSigner) closeConn(conn net.Conn) {
 		return
 	}
 	if err := conn.Close(); err != nil {
-		rs.Logger.Error("Failed to close connection to chain node",
+		rs.logger.Error("Failed to close connection to chain node",
 			"address", rs.address,
 			"err", err,
 		)
@@ -2,6 +2,7 @@ package signer
 
 import (
 	"context"
+	"errors"
 	"net"
 	"time"
 
@@ -73,7 +74,8 @@ func (s *RemoteSignerGRPCServer) PubKey(ctx context.Context, req *proto.PubKeyRe
 	}
 
 	return &proto.PubKeyResponse{
-		PubKey: pubKey,
+		PubKey:  pubKey.Bytes(),
+		KeyType: pubKey.Type(),
 	}, nil
 }
 
@@ -103,6 +105,10 @@ func signAndTrack(
 	block Block,
 ) ([]byte, []byte, time.Time, error) {
 	sig, voteExtSig, timestamp, err := validator.Sign(ctx, chainID, block)
+	if errors.Is(err, ErrShadowMode) {
+		// the shadow validator records the result.
+		return nil, nil, block.Timestamp, err
+	}
 	if err != nil {
 		switch typedErr := err.(type) {
 		case *BeyondBlockError:
@@ -0,0 +1,325 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometnet "github.com/cometbft/cometbft/libs/net"
+	cometservice "github.com/cometbft/cometbft/libs/service"
+	cometp2pconn "github.com/cometbft/cometbft/p2p/conn"
+)
+
+const (
+	defaultPrivValMaxConnections = 10
+
+	// privValHandshakeTimeout bounds the secret connection handshake of an accepted connection.
+	privValHandshakeTimeout = 5 * time.Second
+)
+
+// ListenRemoteSigner listens for chain nodes which dial horcrux, e.g. when only the chain nodes can originate
+// connections, and responds to the privval requests of every accepted secret connection using its privVal.
+type ListenRemoteSigner struct {
+	cometservice.BaseService
+
+	logger     cometlog.Logger
+	listenAddr string
+	chainID    string
+	privVal    PrivValidator
+	privKey    cometcrypto.PrivKey
+
+	// unix is true when listening on a unix socket, whose connections are not secret connections.
+	unix bool
+
+	// allowedNodeIDs are the node IDs of the chain nodes allowed to connect. Any node is allowed when empty.
+	allowedNodeIDs map[string]struct{}
+
+	// protocols are the privval protocols of the chains which do not use the default protocol.
+	protocols map[string]PrivValProtocol
+
+	// slots limits the number of connections served at once.
+	slots chan struct{}
+
+	listener net.Listener
+
+	// cancel cancels the requests in progress when the signer is stopped.
+	cancel context.CancelFunc
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// NewListenRemoteSigner returns a ListenRemoteSigner for the listener config which responds
+// to requests using the given privVal. The secret connections are authenticated with privKey,
+// or with an ephemeral key if privKey is nil.
+func NewListenRemoteSigner(
+	logger cometlog.Logger,
+	listener PrivValListener,
+	privVal PrivValidator,
+	privKey cometcrypto.PrivKey,
+) *ListenRemoteSigner {
+	if privKey == nil {
+		privKey = cometcryptoed25519.GenPrivKey()
+	}
+
+	maxConnections := listener.MaxConnections
+	if maxConnections == 0 {
+		maxConnections = defaultPrivValMaxConnections
+	}
+
+	var allowed map[string]struct{}
+	if len(listener.AllowedNodeIDs) > 0 {
+		allowed = make(map[string]struct{}, len(listener.AllowedNodeIDs))
+		for _, id := range listener.AllowedNodeIDs {
+			allowed[strings.ToLower(id)] = struct{}{}
+		}
+	}
+
+	rs := &ListenRemoteSigner{
+		logger:         logger,
+		listenAddr:     listener.ListenAddr,
+		chainID:        listener.ChainID,
+		privVal:        privVal,
+		privKey:        privKey,
+		allowedNodeIDs: allowed,
+		slots:          make(chan struct{}, maxConnections),
+		conns:          make(map[net.Conn]struct{}),
+	}
+
+	rs.BaseService = *cometservice.NewBaseService(logger, "ListenRemoteSigner", rs)
+	return rs
+}
+
+// SetPrivValProtocols sets the privval protocols of chains whose nodes run a release before CometBFT v0.38.
+// It must be called before Start.
+func (rs *ListenRemoteSigner) SetPrivValProtocols(protocols map[string]PrivValProtocol) {
+	rs.protocols = protocols
+}
+
+// OnStart implements cmn.Service.
+func (rs *ListenRemoteSigner) OnStart() error {
+	proto, address := cometnet.ProtocolAndAddress(rs.listenAddr)
+	rs.unix = proto == protocolUnix
+
+	var listener net.Listener
+	var err error
+	if rs.unix {
+		// a socket left behind by an unclean shutdown is replaced, and only this user can connect.
+		listener, err = listenUnix(address, 0600, -1)
+	} else {
+		listener, err = net.Listen(proto, address)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to listen for privval connections on %s: %w", rs.listenAddr, err)
+	}
+	rs.listener = listener
+
+	rs.logger.Info("Listening for privval connections", "address", rs.listenAddr, "chain_id", rs.chainID)
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.cancel = cancel
+	go rs.acceptLoop(ctx)
+	return nil
+}
+
+// OnStop implements cmn.Service.
+func (rs *ListenRemoteSigner) OnStop() {
+	rs.cancel()
+	if err := rs.listener.Close(); err != nil {
+		rs.logger.Error("Failed to close privval listener", "address", rs.listenAddr, "err", err)
+	}
+
+	rs.mu.Lock()
+	for conn := range rs.conns {
+		_ = conn.Close()
+	}
+	rs.mu.Unlock()
+
+	rs.privVal.Stop()
+}
+
+// Addr returns the address the signer is listening on.
+func (rs *ListenRemoteSigner) Addr() net.Addr {
+	return rs.listener.Addr()
+}
+
+func (rs *ListenRemoteSigner) acceptLoop(ctx context.Context) {
+	for {
+		conn, err := rs.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) || !rs.IsRunning() {
+				return
+			}
+			rs.logger.Error("Failed to accept privval connection", "address", rs.listenAddr, "err", err)
+			continue
+		}
+
+		select {
+		case rs.slots <- struct{}{}:
+		default:
+			rs.reject(conn, "connection_limit", fmt.Errorf("limit of %d connections reached", cap(rs.slots)))
+			continue
+		}
+
+		go func() {
+			defer func() { <-rs.slots }()
+			rs.serve(ctx, conn)
+		}()
+	}
+}
+
+// reject closes an accepted connection which will not be served.
+func (rs *ListenRemoteSigner) reject(conn net.Conn, reason string, err error) {
+	totalPrivValListenerRejected.WithLabelValues(rs.listenAddr, reason).Inc()
+	rs.logger.Error(
+		"Rejected privval connection",
+		"address", rs.listenAddr,
+		"remote", conn.RemoteAddr(),
+		"reason", reason,
+		"err", err,
+	)
+	_ = conn.Close()
+}
+
+// checkNodeID returns an error if the node ID of the secret connection key of a chain node is not allowed.
+func (rs *ListenRemoteSigner) checkNodeID(nodeID string) error {
+	if rs.allowedNodeIDs == nil {
+		return nil
+	}
+	if _, ok := rs.allowedNodeIDs[strings.ToLower(nodeID)]; ok {
+		return nil
+	}
+	return fmt.Errorf("node ID %s is not in allowedNodeIDs", nodeID)
+}
+
+// secretConnection performs the secret connection handshake of an accepted connection,
+// and returns it if the chain node is allowed to connect.
+func (rs *ListenRemoteSigner) secretConnection(netConn net.Conn) (net.Conn, string, bool) {
+	if err := netConn.SetDeadline(time.Now().Add(privValHandshakeTimeout)); err != nil {
+		rs.reject(netConn, "handshake", err)
+		return nil, "", false
+	}
+	conn, err := cometp2pconn.MakeSecretConnection(netConn, rs.privKey)
+	if err != nil {
+		rs.reject(netConn, "handshake", err)
+		return nil, "", false
+	}
+	if err := netConn.SetDeadline(time.Time{}); err != nil {
+		rs.reject(netConn, "handshake", err)
+		return nil, "", false
+	}
+
+	nodeID := hex.EncodeToString(conn.RemotePubKey().Address())
+	if err := rs.checkNodeID(nodeID); err != nil {
+		rs.reject(netConn, "node_id", err)
+		return nil, "", false
+	}
+	return conn, nodeID, true
+}
+
+// serve runs the request loop of an accepted connection until it is closed.
+// The requests of the connection are canceled when it is closed, or when ctx is canceled.
+func (rs *ListenRemoteSigner) serve(ctx context.Context, netConn net.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// like CometBFT, privval on unix sockets does not use a secret connection,
+	// the permissions of the socket protect it instead.
+	conn, nodeID, remote := netConn, "", rs.listenAddr
+	if !rs.unix {
+		var ok bool
+		if conn, nodeID, ok = rs.secretConnection(netConn); !ok {
+			return
+		}
+		remote = netConn.RemoteAddr().String()
+	}
+
+	if !rs.track(conn) {
+		_ = conn.Close()
+		return
+	}
+	defer rs.untrack(conn)
+
+	rs.logger.Info("Accepted privval connection", "address", rs.listenAddr, "remote", remote, "node_id", nodeID)
+
+	h := privValHandler{
+		logger:    rs.logger,
+		address:   remote,
+		chainID:   rs.chainID,
+		privVal:   rs.privVal,
+		protocols: rs.protocols,
+	}
+	for {
+		req, err := readMsgBytes(conn)
+		if err != nil {
+			if rs.IsRunning() {
+				rs.logger.Error("Failed to read message from connection", "address", remote, "err", err)
+			}
+			return
+		}
+
+		// handleMsgBytes handles request errors. We always send back a response
+		res, err := h.handleMsgBytes(ctx, req)
+		if err != nil {
+			rs.logger.Error("Failed to decode message from connection", "address", remote, "err", err)
+			return
+		}
+
+		if err := writeMsgBytes(conn, res); err != nil {
+			if rs.IsRunning() {
+				rs.logger.Error("Failed to write message to connection", "address", remote, "err", err)
+			}
+			return
+		}
+	}
+}
+
+// track adds conn to the open connections, or returns false if the signer is stopping.
+func (rs *ListenRemoteSigner) track(conn net.Conn) bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if !rs.IsRunning() {
+		return false
+	}
+	rs.conns[conn] = struct{}{}
+	privValListenerConnections.WithLabelValues(rs.listenAddr).Inc()
+	return true
+}
+
+func (rs *ListenRemoteSigner) untrack(conn net.Conn) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if _, ok := rs.conns[conn]; !ok {
+		return
+	}
+	delete(rs.conns, conn)
+	privValListenerConnections.WithLabelValues(rs.listenAddr).Dec()
+	_ = conn.Close()
+}
+
+// StartListenRemoteSigners starts a ListenRemoteSigner for each of the listeners, all sharing privVal.
+func StartListenRemoteSigners(
+	services []cometservice.Service,
+	logger cometlog.Logger,
+	privVal PrivValidator,
+	listeners PrivValListeners,
+	privKey cometcrypto.PrivKey,
+	protocols map[string]PrivValProtocol,
+) ([]cometservice.Service, error) {
+	for _, listener := range listeners {
+		s := NewListenRemoteSigner(logger, listener, privVal, privKey)
+		s.SetPrivValProtocols(protocols)
+		if err := s.Start(); err != nil {
+			return nil, err
+		}
+		services = append(services, s)
+	}
+	return services, nil
+}
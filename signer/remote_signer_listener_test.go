@@ -0,0 +1,111 @@
+package signer
+
+import (
+	"encoding/hex"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometp2pconn "github.com/cometbft/cometbft/p2p/conn"
+	cometprotoprivval "github.com/cometbft/cometbft/proto/tendermint/privval"
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenRemoteSigner(t *testing.T) {
+	nodeKey := cometcryptoed25519.GenPrivKey()
+	nodeID := hex.EncodeToString(nodeKey.PubKey().Address())
+
+	pv := &signingPrivValidator{privKey: cometcryptoed25519.GenPrivKey()}
+	rs := NewListenRemoteSigner(cometlog.NewNopLogger(), PrivValListener{
+		ListenAddr:     "tcp://127.0.0.1:0",
+		ChainID:        testChainID,
+		MaxConnections: 1,
+		AllowedNodeIDs: []string{strings.ToUpper(nodeID)},
+	}, pv, nil)
+	require.NoError(t, rs.Start())
+	t.Cleanup(func() { _ = rs.Stop() })
+
+	dial := func() (net.Conn, error) {
+		conn, err := net.Dial("tcp", rs.Addr().String())
+		if err != nil {
+			return nil, err
+		}
+		sc, err := cometp2pconn.MakeSecretConnection(conn, nodeKey)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return sc, nil
+	}
+
+	conn, err := dial()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, WriteMsg(conn, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignVoteRequest{
+		SignVoteRequest: &cometprotoprivval.SignVoteRequest{
+			ChainId: testChainID,
+			Vote:    &cometproto.Vote{Height: 1, Type: cometproto.PrevoteType},
+		},
+	}}))
+	res, err := ReadMsg(conn)
+	require.NoError(t, err)
+	require.Nil(t, res.GetSignedVoteResponse().Error)
+	require.NotEmpty(t, res.GetSignedVoteResponse().Vote.Signature)
+
+	// the chain ID of the listener is enforced like for chain nodes horcrux dials.
+	require.NoError(t, WriteMsg(conn, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_PubKeyRequest{
+		PubKeyRequest: &cometprotoprivval.PubKeyRequest{ChainId: testChainID2},
+	}}))
+	res, err = ReadMsg(conn)
+	require.NoError(t, err)
+	require.NotNil(t, res.GetPubKeyResponse().Error)
+
+	// the only connection slot is taken, so the next connection is closed before the handshake.
+	_, err = dial()
+	require.Error(t, err)
+
+	require.NoError(t, rs.checkNodeID(nodeID))
+	require.ErrorContains(t,
+		rs.checkNodeID(hex.EncodeToString(cometcryptoed25519.GenPrivKey().PubKey().Address())),
+		"is not in allowedNodeIDs",
+	)
+}
+
+func TestListenRemoteSignerUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "privval.sock")
+
+	pv := &signingPrivValidator{privKey: cometcryptoed25519.GenPrivKey()}
+	rs := NewListenRemoteSigner(cometlog.NewNopLogger(), PrivValListener{ListenAddr: "unix://" + socket}, pv, nil)
+	require.NoError(t, rs.Start())
+	t.Cleanup(func() { _ = rs.Stop() })
+
+	fi, err := os.Stat(socket)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	// connections on a unix socket are not secret connections.
+	conn, err := net.Dial("unix", socket)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, WriteMsg(conn, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_PingRequest{
+		PingRequest: &cometprotoprivval.PingRequest{},
+	}}))
+	res, err := ReadMsg(conn)
+	require.NoError(t, err)
+	require.NotNil(t, res.GetPingResponse())
+
+	// a file which is not a socket is never replaced.
+	file := filepath.Join(t.TempDir(), "privval.sock")
+	require.NoError(t, os.WriteFile(file, nil, 0600))
+	rs = NewListenRemoteSigner(cometlog.NewNopLogger(), PrivValListener{ListenAddr: "unix://" + file}, pv, nil)
+	require.ErrorContains(t, rs.Start(), "is not a unix socket")
+	_, err = os.Stat(file)
+	require.NoError(t, err)
+}
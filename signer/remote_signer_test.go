@@ -0,0 +1,256 @@
+package signer
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cometcryptoencoding "github.com/cometbft/cometbft/crypto/encoding"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometp2pconn "github.com/cometbft/cometbft/p2p/conn"
+	cometprotoprivval "github.com/cometbft/cometbft/proto/tendermint/privval"
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconnRemoteSignerRejectsOtherChainIDs(t *testing.T) {
+	// the privVal is never reached for rejected requests.
+	rs := NewReconnRemoteSigner("tcp://127.0.0.1:1234", testChainID, cometlog.NewNopLogger(), nil, nil, nil, net.Dialer{})
+	ctx := context.Background()
+
+	res := rs.handleRequest(ctx, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignVoteRequest{
+		SignVoteRequest: &cometprotoprivval.SignVoteRequest{
+			ChainId: testChainID2,
+			Vote:    &cometproto.Vote{Height: 1, Type: cometproto.PrevoteType},
+		},
+	}})
+	voteRes := res.GetSignedVoteResponse()
+	require.NotNil(t, voteRes)
+	require.NotNil(t, voteRes.Error)
+	require.Contains(t, voteRes.Error.Description, "chain ID chain-2 is not allowed on this connection")
+	require.Nil(t, voteRes.Vote.Signature)
+
+	res = rs.handleRequest(ctx, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignProposalRequest{
+		SignProposalRequest: &cometprotoprivval.SignProposalRequest{
+			ChainId:  testChainID2,
+			Proposal: &cometproto.Proposal{Height: 1, Type: cometproto.ProposalType},
+		},
+	}})
+	require.NotNil(t, res.GetSignedProposalResponse().Error)
+
+	res = rs.handleRequest(ctx, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_PubKeyRequest{
+		PubKeyRequest: &cometprotoprivval.PubKeyRequest{ChainId: testChainID2},
+	}})
+	require.NotNil(t, res.GetPubKeyResponse().Error)
+}
+
+func TestReconnRemoteSignerPinsSentryPubKey(t *testing.T) {
+	sentryKey := cometcryptoed25519.GenPrivKey()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = cometp2pconn.MakeSecretConnection(conn, sentryKey)
+			}()
+		}
+	}()
+
+	address := "tcp://" + ln.Addr().String()
+	horcruxKey := cometcryptoed25519.GenPrivKey()
+
+	rs := NewReconnRemoteSigner(address, "", cometlog.NewNopLogger(), nil, horcruxKey, sentryKey.PubKey(), net.Dialer{})
+	conn, err := rs.establishConnection(context.Background())
+	require.NoError(t, err)
+	conn.Close()
+
+	// anything else listening at the sentry address is rejected.
+	otherKey := cometcryptoed25519.GenPrivKey().PubKey()
+	rs = NewReconnRemoteSigner(address, "", cometlog.NewNopLogger(), nil, horcruxKey, otherKey, net.Dialer{})
+	_, err = rs.establishConnection(context.Background())
+	require.ErrorContains(t, err, "chain node authenticated with public key")
+}
+
+func TestReconnRemoteSignerUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "privval.sock")
+
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	rs := NewReconnRemoteSigner("unix://"+socket, "", cometlog.NewNopLogger(), nil, nil, nil, net.Dialer{})
+	conn, err := rs.establishConnection(context.Background())
+	require.NoError(t, err)
+	conn.Close()
+
+	// other users could connect to the sentry in place of horcrux.
+	require.NoError(t, os.Chmod(socket, 0666))
+	_, err = rs.establishConnection(context.Background())
+	require.ErrorContains(t, err, "must not be writable by other users")
+}
+
+func TestPrivValHandlerCometBFTV1(t *testing.T) {
+	pv := &signingPrivValidator{privKey: cometcryptoed25519.GenPrivKey()}
+	rs := NewReconnRemoteSigner("tcp://127.0.0.1:1234", "", cometlog.NewNopLogger(), pv, nil, nil, net.Dialer{})
+	ctx := context.Background()
+
+	req := cometprotoprivval.Message{Sum: &cometprotoprivval.Message_PubKeyRequest{
+		PubKeyRequest: &cometprotoprivval.PubKeyRequest{ChainId: testChainID},
+	}}
+	bz, err := req.Marshal()
+	require.NoError(t, err)
+	res, err := rs.handleMsgBytes(ctx, bz)
+	require.NoError(t, err)
+
+	// the public key response is read by chain nodes on CometBFT v0.38,
+	var v038 cometprotoprivval.Message
+	require.NoError(t, v038.Unmarshal(res))
+	pubKey, err := cometcryptoencoding.PubKeyFromProto(v038.GetPubKeyResponse().PubKey)
+	require.NoError(t, err)
+	require.Equal(t, pv.privKey.PubKey(), pubKey)
+
+	// and on CometBFT v1.
+	var v1 proto.PrivValMessage
+	require.NoError(t, v1.Unmarshal(res))
+	require.Equal(t, pv.privKey.PubKey().Bytes(), v1.GetPubKeyResponse().PubKeyBytes)
+	require.Equal(t, "ed25519", v1.GetPubKeyResponse().PubKeyType)
+
+	// raw bytes are refused by signers which do not support them, instead of an empty response.
+	rawReq := proto.PrivValMessage{Sum: &proto.PrivValMessage_SignRawBytesRequest{
+		SignRawBytesRequest: &proto.SignRawBytesRequest{
+			ChainID:  testChainID,
+			RawBytes: []byte("price"),
+			UniqueID: "oracle/1",
+		},
+	}}
+	bz, err = rawReq.Marshal()
+	require.NoError(t, err)
+	res, err = rs.handleMsgBytes(ctx, bz)
+	require.NoError(t, err)
+
+	var rawRes proto.PrivValMessage
+	require.NoError(t, rawRes.Unmarshal(res))
+	require.NotNil(t, rawRes.GetSignedRawBytesResponse())
+	require.Contains(t, rawRes.GetSignedRawBytesResponse().Error.Description, "does not support raw bytes")
+}
+
+func TestPrivValHandlerLegacyProtocol(t *testing.T) {
+	pv := &signingPrivValidator{privKey: cometcryptoed25519.GenPrivKey()}
+	rs := NewReconnRemoteSigner("tcp://127.0.0.1:1234", "", cometlog.NewNopLogger(), pv, nil, nil, net.Dialer{})
+	ctx := context.Background()
+	rs.SetPrivValProtocols(map[string]PrivValProtocol{testChainID: PrivValProtocolTendermint034})
+
+	vote := &cometproto.Vote{
+		Type:      cometproto.PrecommitType,
+		Height:    5,
+		Round:     1,
+		BlockID:   cometproto.BlockID{Hash: make([]byte, 32)},
+		Extension: []byte("extension"),
+	}
+
+	// legacy protocols never sign vote extensions.
+	require.NotEmpty(t, PrivValProtocolCometBFT038.VoteToBlock(testChainID, vote).VoteExtensionSignBytes)
+	require.Empty(t, PrivValProtocolTendermint034.VoteToBlock(testChainID, vote).VoteExtensionSignBytes)
+
+	voteMsg := rs.handleRequest(ctx, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignVoteRequest{
+		SignVoteRequest: &cometprotoprivval.SignVoteRequest{ChainId: testChainID, Vote: vote},
+	}})
+	res := voteMsg.GetSignedVoteResponse()
+	require.Nil(t, res.Error)
+
+	// the whole vote is returned, since older nodes replace their vote with the response.
+	require.Equal(t, vote.Height, res.Vote.Height)
+	require.Equal(t, vote.Round, res.Vote.Round)
+	require.Equal(t, vote.BlockID, res.Vote.BlockID)
+	require.NotEmpty(t, res.Vote.Signature)
+	require.Empty(t, res.Vote.Extension)
+	require.Empty(t, res.Vote.ExtensionSignature)
+
+	proposal := &cometproto.Proposal{Type: cometproto.ProposalType, Height: 5, Round: 1, PolRound: -1}
+	proposalMsg := rs.handleRequest(ctx, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignProposalRequest{
+		SignProposalRequest: &cometprotoprivval.SignProposalRequest{ChainId: testChainID, Proposal: proposal},
+	}})
+	propRes := proposalMsg.GetSignedProposalResponse()
+	require.Nil(t, propRes.Error)
+	require.Equal(t, proposal.Height, propRes.Proposal.Height)
+	require.Equal(t, proposal.PolRound, propRes.Proposal.PolRound)
+	require.NotEmpty(t, propRes.Proposal.Signature)
+
+	// vote extension sign bytes are refused for chains on a legacy protocol.
+	_, _, err := verifySignPayload(testChainID, nil, []byte("extension"), PrivValProtocolCometBFT037)
+	require.ErrorContains(t, err, "vote extensions are not signed")
+}
+
+// signingPrivValidator signs every request with its key.
+type signingPrivValidator struct {
+	privKey cometcryptoed25519.PrivKey
+	signed  int
+}
+
+func (pv *signingPrivValidator) Sign(_ context.Context, _ string, block Block) ([]byte, []byte, time.Time, error) {
+	pv.signed++
+	sig, err := pv.privKey.Sign(block.SignBytes)
+	return sig, nil, block.Timestamp, err
+}
+
+func (pv *signingPrivValidator) GetPubKey(_ context.Context, _ string) (cometcrypto.PubKey, error) {
+	return pv.privKey.PubKey(), nil
+}
+
+func (pv *signingPrivValidator) Stop() {}
+
+func TestReconnRemoteSignerShadowMode(t *testing.T) {
+	testKey := &signingPrivValidator{privKey: cometcryptoed25519.GenPrivKey()}
+	productionPubKey := cometcryptoed25519.GenPrivKey().PubKey()
+
+	shadow := NewShadowValidator(cometlog.NewNopLogger(), testKey, map[string]cometcrypto.PubKey{
+		testChainID: productionPubKey,
+	})
+	rs := NewReconnRemoteSigner("tcp://127.0.0.1:1234", "", cometlog.NewNopLogger(), shadow, nil, nil, net.Dialer{})
+	ctx := context.Background()
+
+	// the full signing flow runs, but the signature is withheld.
+	res := rs.handleRequest(ctx, cometprotoprivval.Message{Sum: &cometprotoprivval.Message_SignVoteRequest{
+		SignVoteRequest: &cometprotoprivval.SignVoteRequest{
+			ChainId: testChainID,
+			Vote:    &cometproto.Vote{Height: 1, Type: cometproto.PrevoteType},
+		},
+	}})
+	voteRes := res.GetSignedVoteResponse()
+	require.NotNil(t, voteRes)
+	require.NotNil(t, voteRes.Error)
+	require.Contains(t, voteRes.Error.Description, "shadow mode: signature withheld")
+	require.Nil(t, voteRes.Vote.Signature)
+	require.Equal(t, 1, testKey.signed)
+
+	// chain nodes are given the production key, so that they keep sending sign requests.
+	pubKey, err := shadow.GetPubKey(context.Background(), testChainID)
+	require.NoError(t, err)
+	require.Equal(t, productionPubKey, pubKey)
+
+	pubKey, err = shadow.GetPubKey(context.Background(), testChainID2)
+	require.NoError(t, err)
+	require.Equal(t, testKey.privKey.PubKey(), pubKey)
+}
@@ -0,0 +1,104 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+	cometlog "github.com/cometbft/cometbft/libs/log"
+)
+
+// ErrShadowMode is returned in place of every signature in shadow mode, so that no signature reaches the chain.
+var ErrShadowMode = errors.New("shadow mode: signature withheld")
+
+var (
+	_ PrivValidator  = &ShadowValidator{}
+	_ RawBytesSigner = &ShadowValidator{}
+)
+
+// ShadowValidator runs the full signing flow of a PrivValidator for every sign request, records its latency
+// and result, and answers with an ErrShadowMode error instead of the signature. It is used to prove a new
+// cluster against live sign requests before cutting over to it.
+type ShadowValidator struct {
+	logger  cometlog.Logger
+	privVal PrivValidator
+
+	// pubKeys are returned for public key requests by chain ID instead of the key of privVal.
+	pubKeys map[string]cometcrypto.PubKey
+}
+
+// NewShadowValidator wraps privVal in shadow mode. The pubKeys of a chain ID are returned to chain nodes instead
+// of the public key of privVal, e.g. the production validator key while privVal signs with a throwaway test key.
+func NewShadowValidator(
+	logger cometlog.Logger,
+	privVal PrivValidator,
+	pubKeys map[string]cometcrypto.PubKey,
+) *ShadowValidator {
+	return &ShadowValidator{
+		logger:  logger,
+		privVal: privVal,
+		pubKeys: pubKeys,
+	}
+}
+
+func (v *ShadowValidator) Sign(ctx context.Context, chainID string, block Block) ([]byte, []byte, time.Time, error) {
+	start := time.Now()
+	_, _, stamp, err := v.privVal.Sign(ctx, chainID, block)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		totalShadowSigns.WithLabelValues(chainID, "error").Inc()
+		v.logger.Error(
+			"Shadow sign failed",
+			"type", signType(block.Step),
+			"chain_id", chainID,
+			"height", block.Height,
+			"round", block.Round,
+			"duration", elapsed,
+			"error", err,
+		)
+		return nil, nil, block.Timestamp, fmt.Errorf("%w: %w", ErrShadowMode, err)
+	}
+
+	totalShadowSigns.WithLabelValues(chainID, "success").Inc()
+	timedShadowSignLag.WithLabelValues(chainID, signType(block.Step)).Observe(elapsed.Seconds())
+	v.logger.Info(
+		"Shadow signed",
+		"type", signType(block.Step),
+		"chain_id", chainID,
+		"height", block.Height,
+		"round", block.Round,
+		"duration", elapsed,
+	)
+
+	return nil, nil, stamp, ErrShadowMode
+}
+
+// SignRawBytes runs the raw bytes signing of privVal, if it supports it, and withholds the signature.
+func (v *ShadowValidator) SignRawBytes(ctx context.Context, chainID, uniqueID string, rawBytes []byte) ([]byte, error) {
+	signer, ok := v.privVal.(RawBytesSigner)
+	if !ok {
+		return nil, fmt.Errorf("%w: raw bytes signing is not supported", ErrShadowMode)
+	}
+	if _, err := signer.SignRawBytes(ctx, chainID, uniqueID, rawBytes); err != nil {
+		totalShadowSigns.WithLabelValues(chainID, "error").Inc()
+		v.logger.Error("Shadow raw bytes sign failed", "chain_id", chainID, "unique_id", uniqueID, "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrShadowMode, err)
+	}
+	totalShadowSigns.WithLabelValues(chainID, "success").Inc()
+	v.logger.Info("Shadow signed raw bytes", "chain_id", chainID, "unique_id", uniqueID)
+	return nil, ErrShadowMode
+}
+
+func (v *ShadowValidator) GetPubKey(ctx context.Context, chainID string) (cometcrypto.PubKey, error) {
+	if pubKey, ok := v.pubKeys[chainID]; ok {
+		return pubKey, nil
+	}
+	return v.privVal.GetPubKey(ctx, chainID)
+}
+
+func (v *ShadowValidator) Stop() {
+	v.privVal.Stop()
+}
@@ -0,0 +1,230 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// SignFence is passed by the leader before it requests signature shares, so that a cluster can only
+// sign while it holds the signing lease. It keeps an active and a standby cluster holding shards of
+// the same key from signing at the same time.
+type SignFence interface {
+	// Fence returns nil if this cluster holds the lease to sign hrs on chainID.
+	Fence(ctx context.Context, chainID string, hrs HRSKey) error
+}
+
+const (
+	// SignFenceLockService fences signing with a lease from a horcrux fence-server lock service.
+	SignFenceLockService = "lock-service"
+
+	defaultFenceLeaseDuration = 30 * time.Second
+)
+
+// ErrSignLeaseRefused is returned by a SignFence when another cluster holds the signing lease,
+// or has signed at or above the HRS.
+var ErrSignLeaseRefused = errors.New("sign lease refused by the fence")
+
+// SignFenceFactory creates the SignFence from the fence config.
+type SignFenceFactory func(config *RuntimeConfig) (SignFence, error)
+
+var (
+	signFences = map[string]SignFenceFactory{
+		SignFenceLockService: func(config *RuntimeConfig) (SignFence, error) {
+			return NewLockServiceFence(config)
+		},
+	}
+	signFencesMu sync.RWMutex
+)
+
+// RegisterSignFence makes a fence available to the fence.type config, e.g. for an external lock such as etcd.
+// Registering a name twice replaces the earlier fence.
+func RegisterSignFence(name string, factory SignFenceFactory) {
+	signFencesMu.Lock()
+	defer signFencesMu.Unlock()
+	signFences[name] = factory
+}
+
+// SignFences returns the names of the registered fences.
+func SignFences() []string {
+	signFencesMu.RLock()
+	defer signFencesMu.RUnlock()
+	names := make([]string, 0, len(signFences))
+	for name := range signFences {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getSignFence(name string) (SignFenceFactory, error) {
+	signFencesMu.RLock()
+	factory, ok := signFences[name]
+	signFencesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown sign fence %q, must be one of %v", name, SignFences())
+	}
+	return factory, nil
+}
+
+// NewSignFence creates the configured SignFence. It returns nil if no fence is configured.
+func NewSignFence(config *RuntimeConfig) (SignFence, error) {
+	thresholdCfg := config.Config.ThresholdModeConfig
+	if thresholdCfg == nil || thresholdCfg.Fence == nil {
+		return nil, nil
+	}
+	factory, err := getSignFence(thresholdCfg.Fence.FenceType())
+	if err != nil {
+		return nil, err
+	}
+	return factory(config)
+}
+
+var _ SignFence = &LockServiceFence{}
+
+// LockServiceFence holds the signing lease from a FenceServer. The lease is renewed with every signature,
+// so the lease duration must be longer than the time between blocks.
+type LockServiceFence struct {
+	client    proto.FenceClient
+	clusterID string
+	lease     time.Duration
+}
+
+func NewLockServiceFence(config *RuntimeConfig) (*LockServiceFence, error) {
+	fenceCfg := config.Config.ThresholdModeConfig.Fence
+
+	lease, err := fenceCfg.Lease()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := config.SignFenceTLS()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fence tls: %w", err)
+	}
+
+	client, err := NewFenceClient(fenceCfg.Address, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LockServiceFence{
+		client:    client,
+		clusterID: fenceCfg.ClusterID,
+		lease:     lease,
+	}, nil
+}
+
+// NewFenceClient returns a client for the lock service at address. The connection uses mutual TLS if tlsConfig is set.
+func NewFenceClient(address string, tlsConfig *tls.Config) (proto.FenceClient, error) {
+	grpcAddress := address
+	if u, err := url.Parse(address); err == nil && u.Host != "" {
+		grpcAddress = u.Host
+	}
+
+	var creds credentials.TransportCredentials = insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.Dial(grpcAddress, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return proto.NewFenceClient(conn), nil
+}
+
+func (f *LockServiceFence) Fence(ctx context.Context, chainID string, hrs HRSKey) error {
+	res, err := f.client.Fence(ctx, &proto.FenceRequest{
+		ClusterID:     f.clusterID,
+		ChainID:       chainID,
+		Height:        hrs.Height,
+		Round:         hrs.Round,
+		Step:          int32(hrs.Step),
+		LeaseDuration: int64(f.lease),
+	})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return fmt.Errorf("%w: %s", ErrSignLeaseRefused, status.Convert(err).Message())
+		}
+		return fmt.Errorf("failed to reach the lock service: %w", err)
+	}
+	if !time.Unix(0, res.LeaseExpiration).After(time.Now()) {
+		return fmt.Errorf("%w: lease expired at %s", ErrSignLeaseRefused, time.Unix(0, res.LeaseExpiration))
+	}
+	return nil
+}
+
+// LoadFenceTLS loads the mutual TLS config for the lock service and its clients.
+// Both sides must present certificates signed by the CA in caCertFile.
+func LoadFenceTLS(caCertFile, certFile, keyFile string, server bool) (*tls.Config, error) {
+	caCertPEM, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCertPEM) {
+		return nil, fmt.Errorf("failed to parse CA certificate %s", caCertFile)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if server {
+		return &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS13,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    certPool,
+		}, nil
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+		// The lock service is verified against the fence CA in VerifyPeerCertificate,
+		// since its certificate need not name the address it is reached on.
+		InsecureSkipVerify:    true, //nolint:gosec
+		VerifyPeerCertificate: verifyFencePeer(certPool),
+	}, nil
+}
+
+func verifyFencePeer(roots *x509.CertPool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("lock service presented no certificate")
+		}
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return err
+			}
+			certs[i] = cert
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		return err
+	}
+}
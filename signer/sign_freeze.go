@@ -0,0 +1,122 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+)
+
+// ErrSignFrozen is returned for heights at or above the halt height of a chain, or during a freeze window.
+var ErrSignFrozen = errors.New("signing is frozen")
+
+// FreezeWindow is a period of time during which signing is stopped. Start and End are RFC 3339 times.
+type FreezeWindow struct {
+	Start  string `json:"start" yaml:"start"`
+	End    string `json:"end" yaml:"end"`
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+func (w FreezeWindow) bounds() (start time.Time, end time.Time, err error) {
+	start, err = time.Parse(time.RFC3339, w.Start)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid freeze window start: %w", err)
+	}
+	end, err = time.Parse(time.RFC3339, w.End)
+	if err != nil {
+		return start, end, fmt.Errorf("invalid freeze window end: %w", err)
+	}
+	return start, end, nil
+}
+
+func (w FreezeWindow) Validate() error {
+	start, end, err := w.bounds()
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return fmt.Errorf("freeze window end (%s) must be after start (%s)", w.End, w.Start)
+	}
+	return nil
+}
+
+// SignFreeze stops signing for a chain at and above a halt height, and during freeze windows.
+type SignFreeze struct {
+	ChainID       string         `json:"chainID"`
+	HaltHeight    int64          `json:"haltHeight,omitempty"`
+	FreezeWindows []FreezeWindow `json:"freezeWindows,omitempty"`
+}
+
+// IsZero returns true if the freeze does not stop signing at any height or time.
+func (f SignFreeze) IsZero() bool {
+	return f.HaltHeight == 0 && len(f.FreezeWindows) == 0
+}
+
+func (f SignFreeze) Validate() error {
+	if f.HaltHeight < 0 {
+		return fmt.Errorf("haltHeight must not be negative")
+	}
+	for _, w := range f.FreezeWindows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check returns an ErrSignFrozen error if signing height for the chain is stopped at now.
+// Windows which can not be parsed stop signing.
+func (f SignFreeze) Check(height int64, now time.Time) error {
+	if f.HaltHeight != 0 && height >= f.HaltHeight {
+		return fmt.Errorf("%w: height %d for chain %s is at or above the halt height %d",
+			ErrSignFrozen, height, f.ChainID, f.HaltHeight)
+	}
+	for _, w := range f.FreezeWindows {
+		start, end, err := w.bounds()
+		if err != nil {
+			return fmt.Errorf("%w: chain %s: %w", ErrSignFrozen, f.ChainID, err)
+		}
+		if now.Before(start) || !now.Before(end) {
+			continue
+		}
+		reason := ""
+		if w.Reason != "" {
+			reason = " (" + w.Reason + ")"
+		}
+		return fmt.Errorf("%w: chain %s is frozen from %s until %s%s", ErrSignFrozen, f.ChainID, w.Start, w.End, reason)
+	}
+	return nil
+}
+
+// observeSignFreeze updates the sign frozen metric of chainID with the result of a freeze check.
+func observeSignFreeze(chainID string, err error) {
+	if errors.Is(err, ErrSignFrozen) {
+		signFrozen.WithLabelValues(chainID).Set(1)
+		return
+	}
+	signFrozen.WithLabelValues(chainID).Set(0)
+}
+
+func SignFreezeToProto(f SignFreeze) *proto.SignFreeze {
+	windows := make([]*proto.FreezeWindow, len(f.FreezeWindows))
+	for i, w := range f.FreezeWindows {
+		windows[i] = &proto.FreezeWindow{Start: w.Start, End: w.End, Reason: w.Reason}
+	}
+	return &proto.SignFreeze{
+		ChainID:    f.ChainID,
+		HaltHeight: f.HaltHeight,
+		Windows:    windows,
+	}
+}
+
+func SignFreezeFromProto(f *proto.SignFreeze) SignFreeze {
+	freeze := SignFreeze{
+		ChainID:    f.ChainID,
+		HaltHeight: f.HaltHeight,
+	}
+	for _, w := range f.Windows {
+		freeze.FreezeWindows = append(freeze.FreezeWindows, FreezeWindow{Start: w.Start, End: w.End, Reason: w.Reason})
+	}
+	return freeze
+}
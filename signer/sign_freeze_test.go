@@ -0,0 +1,68 @@
+package signer
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignFreeze(t *testing.T) {
+	now := time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC)
+
+	freeze := SignFreeze{
+		ChainID:    testChainID,
+		HaltHeight: 100,
+		FreezeWindows: []FreezeWindow{
+			{Start: "2026-10-20T14:00:00Z", End: "2026-10-20T16:00:00Z", Reason: "upgrade"},
+		},
+	}
+	require.NoError(t, freeze.Validate())
+
+	require.NoError(t, freeze.Check(99, now.Add(-2*time.Hour)))
+	err := freeze.Check(100, now.Add(-2*time.Hour))
+	require.ErrorIs(t, err, ErrSignFrozen)
+	require.EqualError(t, err, "signing is frozen: height 100 for chain chain-1 is at or above the halt height 100")
+
+	err = freeze.Check(99, now)
+	require.ErrorIs(t, err, ErrSignFrozen)
+	require.EqualError(t, err,
+		"signing is frozen: chain chain-1 is frozen from 2026-10-20T14:00:00Z until 2026-10-20T16:00:00Z (upgrade)")
+	require.NoError(t, freeze.Check(99, now.Add(time.Hour)))
+
+	require.Error(t, FreezeWindow{Start: "2026-10-20T16:00:00Z", End: "2026-10-20T14:00:00Z"}.Validate())
+	require.Error(t, FreezeWindow{Start: "tomorrow", End: "2026-10-20T14:00:00Z"}.Validate())
+
+	c := Config{Chains: ChainsConfig{{ChainID: testChainID, HaltHeight: 100}}}
+	require.NoError(t, c.CheckSignPolicy(testChainID, 99))
+	require.ErrorIs(t, c.CheckSignPolicy(testChainID, 100), ErrSignFrozen)
+}
+
+func TestFSMSignFreeze(t *testing.T) {
+	f := &fsm{
+		m:      make(map[string]string),
+		logger: log.NewNopLogger(),
+	}
+
+	setFreeze := func(freeze SignFreeze) {
+		value, err := json.Marshal(freeze)
+		require.NoError(t, err)
+		require.Nil(t, f.applySet(raftEventSignFreeze, string(value)))
+	}
+
+	freeze := SignFreeze{ChainID: testChainID, HaltHeight: 100}
+	setFreeze(freeze)
+
+	got, ok := f.signFreezeLocked(testChainID)
+	require.True(t, ok)
+	require.Equal(t, freeze, got)
+	_, ok = f.signFreezeLocked(testChainID2)
+	require.False(t, ok)
+
+	// a freeze without a halt height or windows clears it.
+	setFreeze(SignFreeze{ChainID: testChainID})
+	_, ok = f.signFreezeLocked(testChainID)
+	require.False(t, ok)
+}
@@ -9,9 +9,7 @@ import (
 	"sync"
 
 	cometbytes "github.com/cometbft/cometbft/libs/bytes"
-	cometjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/protoio"
-	"github.com/cometbft/cometbft/libs/tempfile"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	comet "github.com/cometbft/cometbft/types"
 	"github.com/gogo/protobuf/proto"
@@ -108,7 +106,7 @@ type SignState struct {
 	SignBytes              cometbytes.HexBytes `json:"signbytes,omitempty"`
 	VoteExtensionSignature []byte              `json:"vote_ext_signature,omitempty"`
 
-	filePath string
+	store SignStateStore
 
 	// mu protects the cache and is used for signaling with cond.
 	mu    sync.RWMutex
@@ -205,6 +203,7 @@ func newConflictingDataError(existingSignBytes, newSignBytes []byte) *Conflictin
 
 // GetFromCache will return the latest signed block within the SignState
 // and the relevant SignStateConsensus from the cache, if present.
+// Older blocks are looked up in the store if it keeps a signing history.
 func (signState *SignState) GetFromCache(hrs HRSKey) (HRSKey, *SignStateConsensus) {
 	signState.mu.RLock()
 	defer signState.mu.RUnlock()
@@ -212,11 +211,28 @@ func (signState *SignState) GetFromCache(hrs HRSKey) (HRSKey, *SignStateConsensu
 	if ssc, ok := signState.cache[hrs]; ok {
 		return latestBlock, &ssc
 	}
+	if history, ok := signState.store.(SignStateHistory); ok && latestBlock.GreaterThan(hrs) {
+		// a failed lookup is treated as a cache miss, which refuses to sign the older block.
+		if ssc, err := history.Signed(hrs); err == nil && ssc != nil {
+			return latestBlock, ssc
+		}
+	}
 	return latestBlock, nil
 }
 
-// cacheAndMarshal will cache a SignStateConsensus for it's HRS and return the marshalled bytes.
-func (signState *SignState) cacheAndMarshal(ssc SignStateConsensus) []byte {
+// History returns the signing history kept by the store, or nil if the store only keeps the high watermark.
+func (signState *SignState) History() SignStateHistory {
+	history, _ := signState.store.(SignStateHistory)
+	return history
+}
+
+// Close closes the store of the SignState.
+func (signState *SignState) Close() error {
+	return signState.store.Close()
+}
+
+// cacheLatest will cache a SignStateConsensus for it's HRS and update the high watermark.
+func (signState *SignState) cacheLatest(ssc SignStateConsensus) {
 	signState.mu.Lock()
 	defer signState.mu.Unlock()
 
@@ -234,13 +250,6 @@ func (signState *SignState) cacheAndMarshal(ssc SignStateConsensus) []byte {
 	signState.Signature = ssc.Signature
 	signState.SignBytes = ssc.SignBytes
 	signState.VoteExtensionSignature = ssc.VoteExtensionSignature
-
-	jsonBytes, err := cometjson.MarshalIndent(signState, "", "  ")
-	if err != nil {
-		panic(err)
-	}
-
-	return jsonBytes
 }
 
 // Save updates the high watermark height/round/step (HRS) if it is greater
@@ -258,7 +267,7 @@ func (signState *SignState) Save(
 
 	// HRS is greater than existing state, move forward with caching and saving.
 
-	jsonBytes := signState.cacheAndMarshal(ssc)
+	signState.cacheLatest(ssc)
 
 	// Broadcast to waiting goroutines to notify them that an
 	// existing signature for their HRS may now be available.
@@ -268,27 +277,21 @@ func (signState *SignState) Save(
 		pendingDiskWG.Add(1)
 		go func() {
 			defer pendingDiskWG.Done()
-			signState.save(jsonBytes)
+			signState.save(ssc)
 		}()
 	} else {
-		signState.save(jsonBytes)
+		signState.save(ssc)
 	}
 
 	return nil
 }
 
-// Save persists the FilePvLastSignState to its filePath.
-func (signState *SignState) save(jsonBytes []byte) {
-	outFile := signState.filePath
-	if outFile == os.DevNull {
-		return
-	}
-	if outFile == "" {
-		panic("cannot save SignState: filePath not set")
+// save persists the high watermark to the store.
+func (signState *SignState) save(ssc SignStateConsensus) {
+	if signState.store == nil {
+		panic("cannot save SignState: store not set")
 	}
-
-	err := tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
-	if err != nil {
+	if err := signState.store.Save(ssc); err != nil {
 		panic(err)
 	}
 }
@@ -427,7 +430,7 @@ func (signState *SignState) FreshCache() *SignState {
 		VoteExtensionSignature: signState.VoteExtensionSignature,
 		cache:                  make(map[HRSKey]SignStateConsensus),
 
-		filePath: signState.filePath,
+		store: signState.store,
 	}
 
 	newSignState.cond = cond.New(&newSignState.mu)
@@ -450,19 +453,23 @@ func (signState *SignState) FreshCache() *SignState {
 
 // LoadSignState loads a sign state from disk.
 func LoadSignState(filepath string) (*SignState, error) {
-	stateJSONBytes, err := os.ReadFile(filepath)
-	if err != nil {
+	if _, err := os.Stat(filepath); err != nil {
 		return nil, err
 	}
+	return LoadSignStateFromStore(NewFileSignStateStore(filepath))
+}
 
-	state := new(SignState)
-
-	err = cometjson.Unmarshal(stateJSONBytes, &state)
+// LoadSignStateFromStore loads a sign state from store.
+func LoadSignStateFromStore(store SignStateStore) (*SignState, error) {
+	state, err := store.Load()
 	if err != nil {
 		return nil, err
 	}
+	if state == nil {
+		return nil, fmt.Errorf("sign state not found: %w", os.ErrNotExist)
+	}
 
-	state.filePath = filepath
+	state.store = store
 
 	return state.FreshCache(), nil
 }
@@ -471,28 +478,34 @@ func LoadSignState(filepath string) (*SignState, error) {
 // If the sign state could not be loaded, an empty sign state is initialized
 // and saved to filepath.
 func LoadOrCreateSignState(filepath string) (*SignState, error) {
-	if _, err := os.Stat(filepath); err != nil {
-		if !os.IsNotExist(err) {
-			return nil, fmt.Errorf("unexpected error checking file existence (%s): %w", filepath, err)
-		}
-		// the only scenario where we want to create a new sign state file is when the file does not exist.
-		// Make an empty sign state and save it.
-		state := &SignState{
-			filePath: filepath,
-			cache:    make(map[HRSKey]SignStateConsensus),
-		}
-		state.cond = cond.New(&state.mu)
+	if _, err := os.Stat(filepath); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("unexpected error checking file existence (%s): %w", filepath, err)
+	}
+	return LoadOrCreateSignStateFromStore(NewFileSignStateStore(filepath))
+}
 
-		jsonBytes, err := cometjson.MarshalIndent(state, "", "  ")
-		if err != nil {
-			panic(err)
-		}
+// LoadOrCreateSignStateFromStore loads the sign state from store.
+// If the store has no sign state, an empty sign state is initialized and saved to it.
+func LoadOrCreateSignStateFromStore(store SignStateStore) (*SignState, error) {
+	state, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if state != nil {
+		state.store = store
+		return state.FreshCache(), nil
+	}
 
-		state.save(jsonBytes)
-		return state, nil
+	// the only scenario where we want to create a new sign state is when none has been saved.
+	// Make an empty sign state and save it.
+	state = &SignState{
+		store: store,
+		cache: make(map[HRSKey]SignStateConsensus),
 	}
+	state.cond = cond.New(&state.mu)
 
-	return LoadSignState(filepath)
+	state.save(SignStateConsensus{})
+	return state, nil
 }
 
 // OnlyDifferByTimestamp returns true if the sign bytes of the sign state
@@ -0,0 +1,313 @@
+package signer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	cometbytes "github.com/cometbft/cometbft/libs/bytes"
+	cometjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// SignStateStoreFile keeps only the high watermark in a JSON file. This is the default.
+	SignStateStoreFile = "file"
+	// SignStateStoreDB keeps the high watermark and every signed HRS in an embedded database.
+	SignStateStoreDB = "db"
+)
+
+// SignStateStore persists the high watermark of a SignState.
+type SignStateStore interface {
+	// Load returns the saved high watermark, or nil if nothing has been saved yet.
+	Load() (*SignState, error)
+
+	// Save persists ssc as the high watermark.
+	Save(ssc SignStateConsensus) error
+
+	// Close releases the store.
+	Close() error
+}
+
+// SignStateHistory is implemented by stores which keep every signed HRS, not only the high watermark.
+type SignStateHistory interface {
+	// Signed returns the state signed for hrs, or nil if hrs was not signed.
+	Signed(hrs HRSKey) (*SignStateConsensus, error)
+
+	// History calls fn for every signed state with a height between from and to inclusive, in HRS order.
+	// A to of 0 is unbounded.
+	History(from, to int64, fn func(SignStateConsensus) error) error
+}
+
+// signStateJSON is the on-disk format of a sign state.
+type signStateJSON struct {
+	Height                 int64               `json:"height"`
+	Round                  int64               `json:"round"`
+	Step                   int8                `json:"step"`
+	NoncePublic            []byte              `json:"nonce_public"`
+	Signature              []byte              `json:"signature,omitempty"`
+	SignBytes              cometbytes.HexBytes `json:"signbytes,omitempty"`
+	VoteExtensionSignature []byte              `json:"vote_ext_signature,omitempty"`
+}
+
+func newSignStateJSON(ssc SignStateConsensus) signStateJSON {
+	return signStateJSON{
+		Height:                 ssc.Height,
+		Round:                  ssc.Round,
+		Step:                   ssc.Step,
+		Signature:              ssc.Signature,
+		SignBytes:              ssc.SignBytes,
+		VoteExtensionSignature: ssc.VoteExtensionSignature,
+	}
+}
+
+func (s signStateJSON) signState() *SignState {
+	return &SignState{
+		Height:                 s.Height,
+		Round:                  s.Round,
+		Step:                   s.Step,
+		NoncePublic:            s.NoncePublic,
+		Signature:              s.Signature,
+		SignBytes:              s.SignBytes,
+		VoteExtensionSignature: s.VoteExtensionSignature,
+	}
+}
+
+func (s signStateJSON) consensus() SignStateConsensus {
+	return SignStateConsensus{
+		Height:                 s.Height,
+		Round:                  s.Round,
+		Step:                   s.Step,
+		Signature:              s.Signature,
+		SignBytes:              s.SignBytes,
+		VoteExtensionSignature: s.VoteExtensionSignature,
+	}
+}
+
+var _ SignStateStore = &FileSignStateStore{}
+
+// FileSignStateStore overwrites a JSON file with the high watermark on every save.
+type FileSignStateStore struct {
+	filePath string
+}
+
+// NewFileSignStateStore returns a store for the JSON file at filePath.
+// Saves are discarded if filePath is os.DevNull.
+func NewFileSignStateStore(filePath string) *FileSignStateStore {
+	return &FileSignStateStore{filePath: filePath}
+}
+
+func (s *FileSignStateStore) Load() (*SignState, error) {
+	bz, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var state signStateJSON
+	if err := cometjson.Unmarshal(bz, &state); err != nil {
+		return nil, err
+	}
+	return state.signState(), nil
+}
+
+func (s *FileSignStateStore) Save(ssc SignStateConsensus) error {
+	if s.filePath == os.DevNull {
+		return nil
+	}
+	if s.filePath == "" {
+		return errors.New("cannot save SignState: filePath not set")
+	}
+
+	jsonBytes, err := cometjson.MarshalIndent(newSignStateJSON(ssc), "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(s.filePath, jsonBytes, 0600)
+}
+
+func (s *FileSignStateStore) Close() error {
+	return nil
+}
+
+var (
+	_ SignStateStore   = &DBSignStateStore{}
+	_ SignStateHistory = &DBSignStateStore{}
+)
+
+var (
+	signStateWatermarkBucket = []byte("watermark")
+	signStateHistoryBucket   = []byte("history")
+	signStateWatermarkKey    = []byte("state")
+)
+
+// DBSignStateStore keeps the high watermark and a record of every signed HRS,
+// with its sign bytes and signature, in an embedded bbolt database.
+type DBSignStateStore struct {
+	db *bbolt.DB
+}
+
+// NewDBSignStateStore opens or creates the database at filePath.
+// The database is locked while open, so only one process can use it at a time.
+func NewDBSignStateStore(filePath string) (*DBSignStateStore, error) {
+	db, err := bbolt.Open(filePath, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		if errors.Is(err, bbolt.ErrTimeout) {
+			return nil, fmt.Errorf("sign state database %s is locked, is horcrux running?", filePath)
+		}
+		return nil, fmt.Errorf("failed to open sign state database %s: %w", filePath, err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		for _, bucket := range [][]byte{signStateWatermarkBucket, signStateHistoryBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &DBSignStateStore{db: db}, nil
+}
+
+// signStateHistoryKey orders history entries by height, round and step.
+func signStateHistoryKey(hrs HRSKey) []byte {
+	key := make([]byte, 17)
+	binary.BigEndian.PutUint64(key[0:8], uint64(hrs.Height))
+	binary.BigEndian.PutUint64(key[8:16], uint64(hrs.Round))
+	key[16] = byte(hrs.Step)
+	return key
+}
+
+func (s *DBSignStateStore) Load() (*SignState, error) {
+	var state *SignState
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bz := tx.Bucket(signStateWatermarkBucket).Get(signStateWatermarkKey)
+		if bz == nil {
+			return nil
+		}
+		var ss signStateJSON
+		if err := cometjson.Unmarshal(bz, &ss); err != nil {
+			return err
+		}
+		state = ss.signState()
+		return nil
+	})
+	return state, err
+}
+
+// Save records ssc as the high watermark and, if it was signed, adds it to the history.
+// Resetting the watermark, e.g. with horcrux state set, does not remove history.
+func (s *DBSignStateStore) Save(ssc SignStateConsensus) error {
+	bz, err := cometjson.Marshal(newSignStateJSON(ssc))
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket(signStateWatermarkBucket).Put(signStateWatermarkKey, bz); err != nil {
+			return err
+		}
+		if ssc.SignBytes == nil {
+			return nil
+		}
+		return tx.Bucket(signStateHistoryBucket).Put(signStateHistoryKey(ssc.HRSKey()), bz)
+	})
+}
+
+func (s *DBSignStateStore) Signed(hrs HRSKey) (*SignStateConsensus, error) {
+	var ssc *SignStateConsensus
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bz := tx.Bucket(signStateHistoryBucket).Get(signStateHistoryKey(hrs))
+		if bz == nil {
+			return nil
+		}
+		var ss signStateJSON
+		if err := cometjson.Unmarshal(bz, &ss); err != nil {
+			return err
+		}
+		c := ss.consensus()
+		ssc = &c
+		return nil
+	})
+	return ssc, err
+}
+
+func (s *DBSignStateStore) History(from, to int64, fn func(SignStateConsensus) error) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(signStateHistoryBucket).Cursor()
+		for k, v := c.Seek(signStateHistoryKey(HRSKey{Height: from})); k != nil; k, v = c.Next() {
+			var ss signStateJSON
+			if err := cometjson.Unmarshal(v, &ss); err != nil {
+				return err
+			}
+			if to != 0 && ss.Height > to {
+				return nil
+			}
+			if err := fn(ss.consensus()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *DBSignStateStore) Close() error {
+	return s.db.Close()
+}
+
+// signStateDBFile returns the database file which replaces the JSON sign state file.
+func signStateDBFile(stateFile string) string {
+	return strings.TrimSuffix(stateFile, ".json") + ".db"
+}
+
+// NewSignStateStore opens the configured kind of store for the sign state JSON file stateFile.
+// When a database is first created, it starts from the high watermark in stateFile, if present.
+func NewSignStateStore(kind string, stateFile string) (SignStateStore, error) {
+	switch kind {
+	case "", SignStateStoreFile:
+		return NewFileSignStateStore(stateFile), nil
+	case SignStateStoreDB:
+		store, err := NewDBSignStateStore(signStateDBFile(stateFile))
+		if err != nil {
+			return nil, err
+		}
+		if err := migrateSignState(NewFileSignStateStore(stateFile), store); err != nil {
+			_ = store.Close()
+			return nil, fmt.Errorf("failed to import sign state from %s: %w", stateFile, err)
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown sign state store %q, must be one of %v",
+			kind, []string{SignStateStoreFile, SignStateStoreDB})
+	}
+}
+
+// migrateSignState copies the high watermark from one store to another which has none.
+func migrateSignState(from, to SignStateStore) error {
+	existing, err := to.Load()
+	if err != nil || existing != nil {
+		return err
+	}
+	state, err := from.Load()
+	if err != nil || state == nil {
+		return err
+	}
+	return to.Save(SignStateConsensus{
+		Height:                 state.Height,
+		Round:                  state.Round,
+		Step:                   state.Step,
+		Signature:              state.Signature,
+		SignBytes:              state.SignBytes,
+		VoteExtensionSignature: state.VoteExtensionSignature,
+	})
+}
@@ -0,0 +1,64 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBSignStateStore(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "chain-1_priv_validator_state.json")
+
+	// an existing JSON sign state is imported into a new database.
+	fileState, err := LoadOrCreateSignState(stateFile)
+	require.NoError(t, err)
+	require.NoError(t, fileState.Save(SignStateConsensus{Height: 9, Round: 0, Step: stepPrecommit}, nil))
+
+	store, err := NewSignStateStore(SignStateStoreDB, stateFile)
+	require.NoError(t, err)
+
+	signState, err := LoadOrCreateSignStateFromStore(store)
+	require.NoError(t, err)
+	require.Equal(t, HRSKey{Height: 9, Round: 0, Step: stepPrecommit}, signState.HRSKey())
+
+	for h := int64(10); h < 20; h++ {
+		require.NoError(t, signState.Save(SignStateConsensus{
+			Height:    h,
+			Round:     0,
+			Step:      stepPropose,
+			Signature: []byte{byte(h)},
+			SignBytes: []byte{byte(h), 1},
+		}, nil))
+	}
+	require.NoError(t, signState.Close())
+
+	store, err = NewSignStateStore(SignStateStoreDB, stateFile)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	signState, err = LoadSignStateFromStore(store)
+	require.NoError(t, err)
+	require.Equal(t, HRSKey{Height: 19, Round: 0, Step: stepPropose}, signState.HRSKey())
+
+	// blocks older than the in-memory cache are still found after a restart.
+	latest, ssc := signState.GetFromCache(HRSKey{Height: 11, Round: 0, Step: stepPropose})
+	require.Equal(t, int64(19), latest.Height)
+	require.NotNil(t, ssc)
+	require.Equal(t, []byte{11}, ssc.Signature)
+
+	_, ssc = signState.GetFromCache(HRSKey{Height: 11, Round: 1, Step: stepPropose})
+	require.Nil(t, ssc)
+
+	var heights []int64
+	require.NoError(t, signState.History().History(12, 14, func(ssc SignStateConsensus) error {
+		heights = append(heights, ssc.Height)
+		return nil
+	}))
+	require.Equal(t, []int64{12, 13, 14}, heights)
+
+	// the file store keeps no history.
+	fileState, err = LoadSignState(stateFile)
+	require.NoError(t, err)
+	require.Nil(t, fileState.History())
+}
@@ -6,15 +6,23 @@ import (
 	"os"
 	"sync"
 	"time"
+
+	"github.com/cometbft/cometbft/crypto"
 )
 
-var _ PrivValidator = &SingleSignerValidator{}
+var (
+	_ PrivValidator  = &SingleSignerValidator{}
+	_ RawBytesSigner = &SingleSignerValidator{}
+)
 
 // SingleSignerValidator guards access to an underlying PrivValidator by using mutexes
 // for each of the PrivValidator interface functions
 type SingleSignerValidator struct {
 	config     *RuntimeConfig
 	chainState sync.Map
+
+	// journal records every signature produced, if enabled.
+	journal *AuditJournal
 }
 
 // SingleSignerChainState holds the priv validator and associated mutex for a single chain.
@@ -25,6 +33,9 @@ type SingleSignerChainState struct {
 	// The high-watermark/last-signed-state within the FilePV prevents double sign
 	// as long as operations are synchronous. This lock is used to ensure that.
 	pvMutex sync.Mutex
+
+	// rawBytes is loaded on the first SignRawBytes request for the chain, with pvMutex held.
+	rawBytes *RawBytesSignState
 }
 
 // NewSingleSignerValidator constructs a validator for single-sign mode (not recommended).
@@ -36,16 +47,12 @@ func NewSingleSignerValidator(config *RuntimeConfig) *SingleSignerValidator {
 }
 
 // GetPubKey implements types.PrivValidator
-func (pv *SingleSignerValidator) GetPubKey(_ context.Context, chainID string) ([]byte, error) {
+func (pv *SingleSignerValidator) GetPubKey(_ context.Context, chainID string) (crypto.PubKey, error) {
 	chainState, err := pv.loadChainStateIfNecessary(chainID)
 	if err != nil {
 		return nil, err
 	}
-	pubKey, err := chainState.filePV.GetPubKey()
-	if err != nil {
-		return nil, err
-	}
-	return pubKey.Bytes(), nil
+	return chainState.filePV.GetPubKey()
 }
 
 // SignVote implements types.PrivValidator
@@ -62,13 +69,80 @@ func (pv *SingleSignerValidator) Sign(
 	if err != nil {
 		return nil, nil, block.Timestamp, err
 	}
+	err = pv.config.Config.CheckSignPolicy(chainID, block.Height)
+	observeSignFreeze(chainID, err)
+	if err != nil {
+		return nil, nil, block.Timestamp, err
+	}
+	protocol := pv.config.Config.ChainPrivValProtocol(chainID)
+	if err := protocol.checkVoteExtension(chainID, block.VoteExtensionSignBytes); err != nil {
+		return nil, nil, block.Timestamp, err
+	}
+	chainState.pvMutex.Lock()
+	defer chainState.pvMutex.Unlock()
+
+	sig, voteExtSig, stamp, err := chainState.filePV.Sign(chainID, block)
+	if err != nil {
+		return nil, nil, stamp, err
+	}
+
+	pv.journal.Record(NewAuditEntry(chainID, block, sig, voteExtSig))
+
+	return sig, voteExtSig, stamp, nil
+}
+
+// SignRawBytes signs the domain separated rawBytes for uniqueID, if the chain policy allows it
+// and uniqueID was not signed for other raw bytes.
+func (pv *SingleSignerValidator) SignRawBytes(
+	_ context.Context,
+	chainID, uniqueID string,
+	rawBytes []byte,
+) ([]byte, error) {
+	chainState, err := pv.loadChainStateIfNecessary(chainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := pv.config.Config.CheckRawBytesPolicy(chainID, uniqueID); err != nil {
+		return nil, err
+	}
+	signBytes, err := RawBytesSignBytes(uniqueID, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
 	chainState.pvMutex.Lock()
 	defer chainState.pvMutex.Unlock()
 
-	return chainState.filePV.Sign(chainID, block)
+	if chainState.rawBytes == nil {
+		chainState.rawBytes, err = LoadOrCreateRawBytesSignState(pv.config.RawBytesStateFile(chainID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := chainState.rawBytes.Record(uniqueID, rawBytes); err != nil {
+		return nil, err
+	}
+
+	sig, err := chainState.filePV.Key.PrivKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pv.journal.Record(NewRawBytesAuditEntry(chainID, uniqueID, signBytes, sig))
+
+	return sig, nil
+}
+
+// SetAuditJournal records every signature produced to journal.
+func (pv *SingleSignerValidator) SetAuditJournal(journal *AuditJournal) {
+	pv.journal = journal
 }
 
 func (pv *SingleSignerValidator) loadChainStateIfNecessary(chainID string) (*SingleSignerChainState, error) {
+	if err := pv.config.Config.CheckChainID(chainID); err != nil {
+		return nil, err
+	}
+
 	cachedChainState, ok := pv.chainState.Load(chainID)
 	if ok {
 		return cachedChainState.(*SingleSignerChainState), nil
@@ -106,4 +180,6 @@ func (pv *SingleSignerValidator) loadChainStateIfNecessary(chainID string) (*Sin
 	return chainState, nil
 }
 
-func (pv *SingleSignerValidator) Stop() {}
+func (pv *SingleSignerValidator) Stop() {
+	_ = pv.journal.Close()
+}
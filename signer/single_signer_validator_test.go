@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	cometcryptoed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cometcryptosecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cometjson "github.com/cometbft/cometbft/libs/json"
 	cometrand "github.com/cometbft/cometbft/libs/rand"
@@ -125,3 +126,147 @@ func TestSingleSignerValidator(t *testing.T) {
 		"vote extension signature verification failed")
 
 }
+
+func TestSingleSignerValidatorSecp256k1(t *testing.T) {
+	tmpDir := t.TempDir()
+	runtimeConfig := &RuntimeConfig{
+		HomeDir:  tmpDir,
+		StateDir: tmpDir,
+	}
+
+	privateKey := cometcryptosecp256k1.GenPrivKey()
+
+	marshaled, err := cometjson.Marshal(cometprivval.FilePVKey{
+		Address: privateKey.PubKey().Address(),
+		PubKey:  privateKey.PubKey(),
+		PrivKey: privateKey,
+	})
+	require.NoError(t, err)
+
+	keyFile := runtimeConfig.KeyFilePathSingleSigner(testChainID)
+	require.NoError(t, os.WriteFile(keyFile, marshaled, 0600))
+
+	validator := NewSingleSignerValidator(runtimeConfig)
+
+	ctx := context.Background()
+
+	pubKey, err := validator.GetPubKey(ctx, testChainID)
+	require.NoError(t, err)
+	require.Equal(t, privateKey.PubKey(), pubKey)
+
+	block := ProposalToBlock(testChainID, &cometproto.Proposal{
+		Height: 1,
+		Round:  0,
+		Type:   cometproto.ProposalType,
+	})
+
+	signature, _, _, err := validator.Sign(ctx, testChainID, block)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(block.SignBytes, signature))
+
+	// secp256k1 keys have no threshold signature scheme to shard them with.
+	_, err = CreateCosignerEd25519ShardsFromFile(keyFile, 2, 3)
+	require.ErrorContains(t, err, "threshold signing is not supported for secp256k1 keys")
+}
+
+func TestSingleSignerValidatorChainNotAllowed(t *testing.T) {
+	tmpDir := t.TempDir()
+	runtimeConfig := &RuntimeConfig{
+		HomeDir:  tmpDir,
+		StateDir: tmpDir,
+		Config: Config{
+			Chains: ChainsConfig{{ChainID: testChainID}},
+		},
+	}
+
+	privateKey := cometcryptoed25519.GenPrivKey()
+
+	marshaled, err := cometjson.Marshal(cometprivval.FilePVKey{
+		Address: privateKey.PubKey().Address(),
+		PubKey:  privateKey.PubKey(),
+		PrivKey: privateKey,
+	})
+	require.NoError(t, err)
+
+	for _, chainID := range []string{testChainID, testChainID2} {
+		require.NoError(t, os.WriteFile(runtimeConfig.KeyFilePathSingleSigner(chainID), marshaled, 0600))
+	}
+
+	validator := NewSingleSignerValidator(runtimeConfig)
+
+	ctx := context.Background()
+
+	_, err = validator.GetPubKey(ctx, testChainID)
+	require.NoError(t, err)
+
+	_, err = validator.GetPubKey(ctx, testChainID2)
+	require.ErrorIs(t, err, ErrChainNotAllowed)
+
+	block := ProposalToBlock(testChainID2, &cometproto.Proposal{
+		Height: 1,
+		Round:  0,
+		Type:   cometproto.ProposalType,
+	})
+	_, _, _, err = validator.Sign(ctx, testChainID2, block)
+	require.ErrorIs(t, err, ErrChainNotAllowed)
+
+	// no sign state is created for a chain which is not allowed.
+	_, err = os.Stat(runtimeConfig.PrivValStateFile(testChainID2))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestSingleSignerValidatorSignRawBytes(t *testing.T) {
+	tmpDir := t.TempDir()
+	runtimeConfig := &RuntimeConfig{
+		HomeDir:  tmpDir,
+		StateDir: tmpDir,
+		Config: Config{
+			Chains: ChainsConfig{
+				{ChainID: testChainID, RawBytes: &RawBytesConfig{UniqueIDPrefixes: []string{"oracle/"}}},
+				{ChainID: testChainID2},
+			},
+		},
+	}
+
+	privateKey := cometcryptoed25519.GenPrivKey()
+
+	marshaled, err := cometjson.Marshal(cometprivval.FilePVKey{
+		Address: privateKey.PubKey().Address(),
+		PubKey:  privateKey.PubKey(),
+		PrivKey: privateKey,
+	})
+	require.NoError(t, err)
+
+	for _, chainID := range []string{testChainID, testChainID2} {
+		require.NoError(t, os.WriteFile(runtimeConfig.KeyFilePathSingleSigner(chainID), marshaled, 0600))
+	}
+
+	validator := NewSingleSignerValidator(runtimeConfig)
+
+	ctx := context.Background()
+
+	sig, err := validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("price"))
+	require.NoError(t, err)
+
+	// the signature is over the domain separated sign bytes, never the raw bytes alone.
+	signBytes, err := RawBytesSignBytes("oracle/1", []byte("price"))
+	require.NoError(t, err)
+	require.True(t, privateKey.PubKey().VerifySignature(signBytes, sig))
+	require.False(t, privateKey.PubKey().VerifySignature([]byte("price"), sig))
+
+	// the same raw bytes may be signed again for a unique ID, but not different raw bytes.
+	_, err = validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("price"))
+	require.NoError(t, err)
+	_, err = validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("other price"))
+	require.ErrorIs(t, err, ErrRawBytesConflict)
+
+	// which holds across a restart.
+	validator = NewSingleSignerValidator(runtimeConfig)
+	_, err = validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("other price"))
+	require.ErrorIs(t, err, ErrRawBytesConflict)
+
+	_, err = validator.SignRawBytes(ctx, testChainID, "bridge/1", []byte("price"))
+	require.ErrorIs(t, err, ErrRawBytesNotAllowed)
+	_, err = validator.SignRawBytes(ctx, testChainID2, "oracle/1", []byte("price"))
+	require.ErrorIs(t, err, ErrRawBytesNotAllowed)
+}
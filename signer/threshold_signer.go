@@ -1,6 +1,11 @@
 package signer
 
-import "time"
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
 
 // Interface for the local signer whether it's a soft sign or HSM
 type ThresholdSigner interface {
@@ -14,10 +19,74 @@ type ThresholdSigner interface {
 	CombineSignatures([]PartialSignature) ([]byte, error)
 }
 
+const (
+	// ThresholdSignerBackendSoft loads the key shard into the horcrux process.
+	ThresholdSignerBackendSoft = "soft"
+	// ThresholdSignerBackendExternal signs with a key shard held by an external process over a unix socket.
+	ThresholdSignerBackendExternal = "external"
+)
+
+// ThresholdSignerBackend creates the ThresholdSigner for cosigner id on chainID.
+type ThresholdSignerBackend func(config *RuntimeConfig, id int, chainID string) (ThresholdSigner, error)
+
+var (
+	thresholdSignerBackends = map[string]ThresholdSignerBackend{
+		ThresholdSignerBackendSoft: func(config *RuntimeConfig, id int, chainID string) (ThresholdSigner, error) {
+			return NewThresholdSignerSoft(config, id, chainID)
+		},
+		ThresholdSignerBackendExternal: func(config *RuntimeConfig, id int, chainID string) (ThresholdSigner, error) {
+			return NewThresholdSignerExternal(config, id, chainID)
+		},
+	}
+	thresholdSignerBackendsMu sync.RWMutex
+)
+
+// RegisterThresholdSignerBackend makes a backend available to the backend.type config, e.g. for an HSM.
+// Registering a name twice replaces the earlier backend.
+func RegisterThresholdSignerBackend(name string, backend ThresholdSignerBackend) {
+	thresholdSignerBackendsMu.Lock()
+	defer thresholdSignerBackendsMu.Unlock()
+	thresholdSignerBackends[name] = backend
+}
+
+// ThresholdSignerBackends returns the names of the registered backends.
+func ThresholdSignerBackends() []string {
+	thresholdSignerBackendsMu.RLock()
+	defer thresholdSignerBackendsMu.RUnlock()
+	names := make([]string, 0, len(thresholdSignerBackends))
+	for name := range thresholdSignerBackends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getThresholdSignerBackend(name string) (ThresholdSignerBackend, error) {
+	thresholdSignerBackendsMu.RLock()
+	backend, ok := thresholdSignerBackends[name]
+	thresholdSignerBackendsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown threshold signer backend %q, must be one of %v", name, ThresholdSignerBackends())
+	}
+	return backend, nil
+}
+
+// NewThresholdSigner creates the ThresholdSigner for cosigner id on chainID with the configured backend.
+func NewThresholdSigner(config *RuntimeConfig, id int, chainID string) (ThresholdSigner, error) {
+	backend, err := getThresholdSignerBackend(config.Config.ThresholdModeConfig.Backend.BackendType())
+	if err != nil {
+		return nil, err
+	}
+	return backend(config, id, chainID)
+}
+
 // Nonces contains the ephemeral information generated by one cosigner for all other cosigners.
 type Nonces struct {
 	PubKey []byte
 	Shares [][]byte
+
+	// Commitments are the public keys of each of the shares.
+	Commitments [][]byte
 }
 
 type NoncesWithExpiration struct {
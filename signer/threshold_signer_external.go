@@ -0,0 +1,236 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	cometservice "github.com/cometbft/cometbft/libs/service"
+	"github.com/strangelove-ventures/horcrux/v3/signer/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var _ ThresholdSigner = &ThresholdSignerExternal{}
+
+// ThresholdSignerExternal signs with a key shard held by a ShardSignerServer in another process,
+// reached over a unix socket, so that the shard is never loaded into the horcrux process.
+type ThresholdSignerExternal struct {
+	client  proto.ShardSignerClient
+	chainID string
+	pubKey  []byte
+	total   uint8
+	timeout time.Duration
+}
+
+var (
+	shardSignerConns   = make(map[string]*grpc.ClientConn)
+	shardSignerConnsMu sync.Mutex
+)
+
+// shardSignerClient returns a client for the shard signer listening on socket.
+// Connections are shared, since a signer is created for every chain and on every shard reload.
+func shardSignerClient(socket string) (proto.ShardSignerClient, error) {
+	shardSignerConnsMu.Lock()
+	defer shardSignerConnsMu.Unlock()
+
+	conn, ok := shardSignerConns[socket]
+	if !ok {
+		var err error
+		conn, err = grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		shardSignerConns[socket] = conn
+	}
+	return proto.NewShardSignerClient(conn), nil
+}
+
+func NewThresholdSignerExternal(config *RuntimeConfig, id int, chainID string) (*ThresholdSignerExternal, error) {
+	thresholdCfg := config.Config.ThresholdModeConfig
+	socket := config.ShardSignerSocket()
+	if socket == "" {
+		return nil, fmt.Errorf("backend socket must not be empty for the %s backend", ThresholdSignerBackendExternal)
+	}
+
+	timeout, err := time.ParseDuration(thresholdCfg.GRPCTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grpcTimeout: %w", err)
+	}
+
+	client, err := shardSignerClient(socket)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	res, err := client.LoadShard(ctx, &proto.LoadShardRequest{
+		ChainID: chainID,
+		ShardID: int32(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key shard from shard signer: %w", err)
+	}
+
+	return &ThresholdSignerExternal{
+		client:  client,
+		chainID: chainID,
+		pubKey:  res.PubKey,
+		total:   uint8(thresholdCfg.Cosigners.MaxShardID()),
+		timeout: timeout,
+	}, nil
+}
+
+func (s *ThresholdSignerExternal) PubKey() []byte {
+	return s.pubKey
+}
+
+func (s *ThresholdSignerExternal) Sign(nonces []Nonce, payload []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	res, err := s.client.SignWithShard(ctx, &proto.SignWithShardRequest{
+		ChainID: s.chainID,
+		Nonces:  noncesToProto(nonces),
+		Payload: payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("shard signer failed to sign: %w", err)
+	}
+	return res.Signature, nil
+}
+
+func (s *ThresholdSignerExternal) CombineSignatures(signatures []PartialSignature) ([]byte, error) {
+	return combineSignatures(s.total, signatures)
+}
+
+func noncesToProto(nonces []Nonce) []*proto.Nonce {
+	out := make([]*proto.Nonce, len(nonces))
+	for i, n := range nonces {
+		out[i] = &proto.Nonce{
+			SourceID: int32(n.ID),
+			PubKey:   n.PubKey,
+			Share:    n.Share,
+		}
+	}
+	return out
+}
+
+func noncesFromProto(nonces []*proto.Nonce) []Nonce {
+	out := make([]Nonce, len(nonces))
+	for i, n := range nonces {
+		out[i] = Nonce{
+			ID:     int(n.SourceID),
+			PubKey: n.PubKey,
+			Share:  n.Share,
+		}
+	}
+	return out
+}
+
+var _ proto.ShardSignerServer = &ShardSignerServer{}
+
+// ShardSignerServer holds the key shards of a cosigner and signs for a ThresholdSignerExternal
+// over a unix socket. It is run as a separate process with horcrux shard-signer.
+type ShardSignerServer struct {
+	cometservice.BaseService
+
+	logger cometlog.Logger
+	config *RuntimeConfig
+	socket string
+
+	// socketGID is the group which can connect to the socket, or -1 for only the user of the process.
+	socketGID int
+
+	signers   map[string]*ThresholdSignerSoft
+	signersMu sync.RWMutex
+
+	server *grpc.Server
+
+	proto.UnimplementedShardSignerServer
+}
+
+func NewShardSignerServer(logger cometlog.Logger, config *RuntimeConfig, socket string) *ShardSignerServer {
+	s := &ShardSignerServer{
+		logger:    logger,
+		config:    config,
+		socket:    socket,
+		socketGID: -1,
+		signers:   make(map[string]*ThresholdSignerSoft),
+	}
+	s.BaseService = *cometservice.NewBaseService(logger, "ShardSignerServer", s)
+	return s
+}
+
+// SetSocketGroup lets the members of group gid connect to the socket, in addition to the user of the process.
+// It must be called before Start.
+func (s *ShardSignerServer) SetSocketGroup(gid int) {
+	s.socketGID = gid
+}
+
+func (s *ShardSignerServer) OnStart() error {
+	mode := os.FileMode(0600)
+	if s.socketGID != -1 {
+		mode = 0660
+	}
+	sock, err := listenUnix(s.socket, mode, s.socketGID)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Shard signer listening", "socket", s.socket)
+	s.server = grpc.NewServer()
+	proto.RegisterShardSignerServer(s.server, s)
+	go func() {
+		if err := s.server.Serve(sock); err != nil {
+			s.logger.Error("Shard signer stopped serving", "error", err)
+		}
+	}()
+	return nil
+}
+
+func (s *ShardSignerServer) OnStop() {
+	s.server.GracefulStop()
+}
+
+// LoadShard (re)loads the key shard for a chain ID, e.g. after the shard was refreshed.
+func (s *ShardSignerServer) LoadShard(
+	_ context.Context,
+	req *proto.LoadShardRequest,
+) (*proto.LoadShardResponse, error) {
+	signer, err := NewThresholdSignerSoft(s.config, int(req.ShardID), req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.signersMu.Lock()
+	s.signers[req.ChainID] = signer
+	s.signersMu.Unlock()
+
+	s.logger.Info("Loaded key shard", "chain_id", req.ChainID, "shard_id", req.ShardID)
+
+	return &proto.LoadShardResponse{PubKey: signer.PubKey()}, nil
+}
+
+func (s *ShardSignerServer) SignWithShard(
+	_ context.Context,
+	req *proto.SignWithShardRequest,
+) (*proto.SignWithShardResponse, error) {
+	s.signersMu.RLock()
+	signer, ok := s.signers[req.ChainID]
+	s.signersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("key shard for chain ID %s is not loaded", req.ChainID)
+	}
+
+	sig, err := signer.Sign(noncesFromProto(req.Nonces), req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.SignWithShardResponse{Signature: sig}, nil
+}
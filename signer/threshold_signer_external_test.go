@@ -0,0 +1,67 @@
+package signer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cometlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdSignerExternal(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+
+	// unix socket paths are limited to ~100 characters, so avoid the long t.TempDir() path.
+	socketDir, err := os.MkdirTemp("", "horcrux")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(socketDir) })
+	socket := filepath.Join(socketDir, "shard_signer.sock")
+
+	cosigner := cosigners[0]
+
+	server := NewShardSignerServer(cometlog.NewNopLogger(), cosigner.config, socket)
+	require.NoError(t, server.Start())
+	t.Cleanup(func() { _ = server.Stop() })
+
+	cosigner.config.Config.ThresholdModeConfig.GRPCTimeout = "5s"
+	cosigner.config.Config.ThresholdModeConfig.Backend = &ThresholdSignerBackendConfig{
+		Type:   ThresholdSignerBackendExternal,
+		Socket: socket,
+	}
+	cosigner.chainState.Delete(testChainID)
+	require.NoError(t, cosigner.LoadSignStateIfNecessary(testChainID))
+
+	ccs, err := cosigner.getChainState(testChainID)
+	require.NoError(t, err)
+	require.IsType(t, &ThresholdSignerExternal{}, ccs.signer)
+	require.Equal(t, pubKey.Bytes(), ccs.signer.PubKey())
+
+	for _, c := range cosigners[1:] {
+		require.NoError(t, c.LoadSignStateIfNecessary(testChainID))
+	}
+
+	signBytes, sig := testSignWithLocalCosigners(t, cosigners[:2], testChainID, 1)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+}
+
+func TestThresholdSignerBackendConfig(t *testing.T) {
+	var unset *ThresholdSignerBackendConfig
+	require.Equal(t, ThresholdSignerBackendSoft, unset.BackendType())
+	require.NoError(t, unset.Validate())
+
+	require.ErrorContains(t, (&ThresholdSignerBackendConfig{Type: "hsm"}).Validate(),
+		`unknown threshold signer backend "hsm"`)
+	require.ErrorContains(t, (&ThresholdSignerBackendConfig{Type: ThresholdSignerBackendExternal}).Validate(),
+		"backend socket must not be empty")
+
+	RegisterThresholdSignerBackend("hsm", func(config *RuntimeConfig, id int, chainID string) (ThresholdSigner, error) {
+		return NewThresholdSignerSoft(config, id, chainID)
+	})
+	t.Cleanup(func() {
+		thresholdSignerBackendsMu.Lock()
+		delete(thresholdSignerBackends, "hsm")
+		thresholdSignerBackendsMu.Unlock()
+	})
+	require.NoError(t, (&ThresholdSignerBackendConfig{Type: "hsm"}).Validate())
+}
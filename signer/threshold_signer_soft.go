@@ -10,9 +10,13 @@ import (
 	tsed25519 "gitlab.com/unit410/threshold-ed25519/pkg"
 )
 
-var _ ThresholdSigner = &ThresholdSignerSoft{}
+var (
+	_ ThresholdSigner      = &ThresholdSignerSoft{}
+	_ FROSTThresholdSigner = &ThresholdSignerSoft{}
+)
 
 type ThresholdSignerSoft struct {
+	id              int
 	privateKeyShard []byte
 	pubKey          []byte
 	threshold       uint8
@@ -35,10 +39,11 @@ func NewThresholdSignerSoft(config *RuntimeConfig, id int, chainID string) (*Thr
 	}
 
 	s := ThresholdSignerSoft{
+		id:              key.ID,
 		privateKeyShard: key.PrivateShard,
 		pubKey:          key.PubKey.Bytes(),
 		threshold:       uint8(config.Config.ThresholdModeConfig.Threshold),
-		total:           uint8(len(config.Config.ThresholdModeConfig.Cosigners)),
+		total:           uint8(config.Config.ThresholdModeConfig.Cosigners.MaxShardID()),
 	}
 
 	return &s, nil
@@ -59,6 +64,23 @@ func (s *ThresholdSignerSoft) Sign(nonces []Nonce, payload []byte) ([]byte, erro
 	return append(noncePub, sig...), nil
 }
 
+// SignFROST implements FROSTThresholdSigner.
+func (s *ThresholdSignerSoft) SignFROST(
+	nonces *FROSTNonces,
+	commitments FROSTCommitments,
+	payload []byte,
+) ([]byte, error) {
+	p, err := newFROSTSigningPackage(s.pubKey, commitments, payload)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := scalarFromShard(s.privateKeyShard)
+	if err != nil {
+		return nil, err
+	}
+	return p.signShare(s.id, secret, nonces)
+}
+
 func (s *ThresholdSignerSoft) sumNonces(nonces []Nonce) (tsed25519.Scalar, tsed25519.Element, error) {
 	shareParts := make([]tsed25519.Scalar, len(nonces))
 	publicKeys := make([]tsed25519.Element, len(nonces))
@@ -92,20 +114,28 @@ func GenerateNonces(threshold, total uint8) (Nonces, error) {
 	}
 
 	nonces := Nonces{
-		PubKey: tsed25519.ScalarMultiplyBase(secret),
-		Shares: make([][]byte, total),
+		PubKey:      tsed25519.ScalarMultiplyBase(secret),
+		Shares:      make([][]byte, total),
+		Commitments: make([][]byte, total),
 	}
 
 	shares := tsed25519.DealShares(secret, threshold, total)
 
 	for i, sh := range shares {
 		nonces.Shares[i] = sh
+		nonces.Commitments[i] = tsed25519.ScalarMultiplyBase(sh)
 	}
 
 	return nonces, nil
 }
 
 func (s *ThresholdSignerSoft) CombineSignatures(signatures []PartialSignature) ([]byte, error) {
+	return combineSignatures(s.total, signatures)
+}
+
+// combineSignatures combines partial signatures from cosigners with shard IDs up to total.
+// It only needs public information, so it is shared by backends which hold the shard elsewhere.
+func combineSignatures(total uint8, signatures []PartialSignature) ([]byte, error) {
 	sigIds := make([]int, len(signatures))
 	shareSigs := make([][]byte, len(signatures))
 	var ephPub []byte
@@ -119,7 +149,7 @@ func (s *ThresholdSignerSoft) CombineSignatures(signatures []PartialSignature) (
 		}
 		shareSigs[i] = sig.Signature[32:]
 	}
-	combinedSig := tsed25519.CombineShares(s.total, sigIds, shareSigs)
+	combinedSig := tsed25519.CombineShares(total, sigIds, shareSigs)
 
 	return append(ephPub, combinedSig...), nil
 }
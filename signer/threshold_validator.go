@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	cometcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/log"
 	cometrpcjsontypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/google/uuid"
@@ -19,7 +20,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var _ PrivValidator = &ThresholdValidator{}
+var (
+	_ PrivValidator  = &ThresholdValidator{}
+	_ RawBytesSigner = &ThresholdValidator{}
+)
 
 type ThresholdValidator struct {
 	config *RuntimeConfig
@@ -35,6 +39,8 @@ type ThresholdValidator struct {
 
 	// peer cosigners
 	peerCosigners Cosigners
+	// protects peerCosigners and threshold, which change with cluster membership and resharing
+	peerCosignersMu sync.RWMutex
 
 	leader Leader
 
@@ -47,6 +53,18 @@ type ThresholdValidator struct {
 	cosignerHealth *CosignerHealth
 
 	nonceCache *CosignerNonceCache
+
+	// frostCommitments are the commitments preprocessed by the cosigners for chains which sign with FROST.
+	frostCommitments *FROSTCommitmentCache
+
+	// journal records every signature this cosigner produces as leader or as coordinator of raw bytes, if enabled.
+	journal *AuditJournal
+
+	// fence must grant the signing lease before this cosigner signs as leader, if configured.
+	fence SignFence
+
+	// leaderAffinity moves the leadership to the cosigner preferred by the config, if set.
+	leaderAffinity *LeaderAffinity
 }
 
 type ChainSignState struct {
@@ -100,9 +118,51 @@ func NewThresholdValidator(
 		leader:                      leader,
 		cosignerHealth:              NewCosignerHealth(logger, peerCosigners, leader),
 		nonceCache:                  nc,
+		frostCommitments:            NewFROSTCommitmentCache(logger, grpcTimeout),
+	}
+}
+
+// getPeerCosigners returns the current peer cosigners.
+func (pv *ThresholdValidator) getPeerCosigners() Cosigners {
+	pv.peerCosignersMu.RLock()
+	defer pv.peerCosignersMu.RUnlock()
+	return pv.peerCosigners
+}
+
+// SetPeerCosigners replaces the peer cosigners after a cluster membership change.
+func (pv *ThresholdValidator) SetPeerCosigners(peerCosigners []Cosigner) {
+	pv.peerCosignersMu.Lock()
+	pv.peerCosigners = peerCosigners
+	pv.peerCosignersMu.Unlock()
+
+	allCosigners := make([]Cosigner, len(peerCosigners)+1)
+	allCosigners[0] = pv.myCosigner
+	copy(allCosigners[1:], peerCosigners)
+
+	pv.cosignerHealth.SetCosigners(peerCosigners)
+	pv.nonceCache.SetCosigners(allCosigners)
+
+	for _, cosigner := range peerCosigners {
+		pv.logger.Debug("Peer cosigner", "id", cosigner.GetID())
 	}
 }
 
+// getThreshold returns the current threshold.
+func (pv *ThresholdValidator) getThreshold() int {
+	pv.peerCosignersMu.RLock()
+	defer pv.peerCosignersMu.RUnlock()
+	return pv.threshold
+}
+
+// SetThreshold changes the threshold after a reshare.
+func (pv *ThresholdValidator) SetThreshold(threshold int) {
+	pv.peerCosignersMu.Lock()
+	pv.threshold = threshold
+	pv.peerCosignersMu.Unlock()
+
+	pv.nonceCache.SetThreshold(uint8(threshold))
+}
+
 // Start starts the ThresholdValidator.
 func (pv *ThresholdValidator) Start(ctx context.Context) error {
 	pv.logger.Info("Starting ThresholdValidator services")
@@ -113,6 +173,10 @@ func (pv *ThresholdValidator) Start(ctx context.Context) error {
 
 	go pv.myCosigner.StartNoncePruner(ctx)
 
+	if pv.leaderAffinity != nil {
+		go pv.leaderAffinity.Start(ctx)
+	}
+
 	return nil
 }
 
@@ -263,6 +327,28 @@ func (pv *ThresholdValidator) notifyBlockSignError(chainID string, hrs HRSKey, s
 // Stop safely shuts down the ThresholdValidator.
 func (pv *ThresholdValidator) Stop() {
 	pv.waitForSignStatesToFlushToDisk()
+	if err := pv.journal.Close(); err != nil {
+		pv.logger.Error("Failed to close audit journal", "error", err)
+	}
+}
+
+// SetAuditJournal records every signature produced by this cosigner as leader to journal.
+// It must be called before the validator is started.
+func (pv *ThresholdValidator) SetAuditJournal(journal *AuditJournal) {
+	pv.journal = journal
+}
+
+// SetSignFence requires the signing lease from fence before every signature.
+// It must be called before the validator is started.
+func (pv *ThresholdValidator) SetSignFence(fence SignFence) {
+	pv.fence = fence
+}
+
+// SetLeadershipTransferer lets the leader transfer the leadership with transferer to a healthy cosigner
+// which ranks higher by the leaderRegions and leaderPriority of the config.
+// It must be called before the validator is started.
+func (pv *ThresholdValidator) SetLeadershipTransferer(transferer LeadershipTransferer) {
+	pv.leaderAffinity = NewLeaderAffinity(pv.logger, pv.myCosigner, pv.leader, transferer, pv.cosignerHealth)
 }
 
 // waitForSignStatesToFlushToDisk waits for any sign states to finish writing to disk.
@@ -274,12 +360,8 @@ func (pv *ThresholdValidator) waitForSignStatesToFlushToDisk() {
 
 // GetPubKey returns the public key of the validator.
 // Implements PrivValidator.
-func (pv *ThresholdValidator) GetPubKey(_ context.Context, chainID string) ([]byte, error) {
-	pubKey, err := pv.myCosigner.GetPubKey(chainID)
-	if err != nil {
-		return nil, err
-	}
-	return pubKey.Bytes(), nil
+func (pv *ThresholdValidator) GetPubKey(_ context.Context, chainID string) (cometcrypto.PubKey, error) {
+	return pv.myCosigner.GetPubKey(chainID)
 }
 
 type Block struct {
@@ -376,17 +458,21 @@ func newSameBlockError(chainID string, hrs HRSKey) *SameBlockError {
 }
 
 func (pv *ThresholdValidator) LoadSignStateIfNecessary(chainID string) error {
+	if err := pv.config.Config.CheckChainID(chainID); err != nil {
+		return err
+	}
+
 	if _, ok := pv.chainState.Load(chainID); ok {
 		return nil
 	}
 
-	signState, err := LoadOrCreateSignState(pv.config.PrivValStateFile(chainID))
+	signState, err := pv.config.LoadOrCreateSignState(pv.config.PrivValStateFile(chainID))
 	if err != nil {
 		return err
 	}
 
 	lastSignStateInitiated := signState.FreshCache()
-	lastSignStateInitiated.filePath = os.DevNull
+	lastSignStateInitiated.store = NewFileSignStateStore(os.DevNull)
 
 	pv.chainState.Store(chainID, ChainSignState{
 		lastSignState:          signState,
@@ -479,12 +565,23 @@ func (pv *ThresholdValidator) compareBlockSignatureAgainstHRS(
 func (pv *ThresholdValidator) getNoncesFallback(
 	ctx context.Context,
 	count int,
+	threshold int,
 ) (*CosignersAndNonces, error) {
 	drainedNonceCache.Inc()
 	totalDrainedNonceCache.Inc()
 
+	return pv.getNoncesFromCosigners(ctx, count, threshold)
+}
+
+// getNoncesFromCosigners requests count nonces from all cosigners,
+// and returns the nonces of the first threshold cosigners to respond.
+func (pv *ThresholdValidator) getNoncesFromCosigners(
+	ctx context.Context,
+	count int,
+	threshold int,
+) (*CosignersAndNonces, error) {
 	var wg sync.WaitGroup
-	wg.Add(pv.threshold)
+	wg.Add(threshold)
 
 	var mu sync.Mutex
 
@@ -493,14 +590,15 @@ func (pv *ThresholdValidator) getNoncesFallback(
 		uuids[i] = uuid.New()
 	}
 
-	allCosigners := make([]Cosigner, len(pv.peerCosigners)+1)
+	peerCosigners := pv.getPeerCosigners()
+	allCosigners := make([]Cosigner, len(peerCosigners)+1)
 	allCosigners[0] = pv.myCosigner
-	copy(allCosigners[1:], pv.peerCosigners)
+	copy(allCosigners[1:], peerCosigners)
 
 	var thresholdNonces CosignersAndNonces
 
 	for _, c := range allCosigners {
-		go pv.waitForPeerNonces(ctx, uuids, c, &wg, &thresholdNonces, &mu)
+		go pv.waitForPeerNonces(ctx, uuids, c, threshold, &wg, &thresholdNonces, &mu)
 	}
 
 	// Wait for threshold cosigners to be complete
@@ -535,6 +633,7 @@ func (pv *ThresholdValidator) waitForPeerNonces(
 	ctx context.Context,
 	uuids []uuid.UUID,
 	peer Cosigner,
+	threshold int,
 	wg *sync.WaitGroup,
 	thresholdNonces *CosignersAndNonces,
 	mu sync.Locker,
@@ -555,7 +654,7 @@ func (pv *ThresholdValidator) waitForPeerNonces(
 
 	// Check so that wg.Done is not called more than (threshold - 1) times which causes hardlock
 	mu.Lock()
-	if len(thresholdNonces.Cosigners) < pv.threshold {
+	if len(thresholdNonces.Cosigners) < threshold {
 		thresholdNonces.Cosigners = append(thresholdNonces.Cosigners, peer)
 		for _, n := range peerNonces {
 			var found bool
@@ -611,7 +710,7 @@ func (pv *ThresholdValidator) proxyIfNecessary(
 	)
 	totalNotRaftLeader.Inc()
 
-	cosignerLeader := pv.peerCosigners.GetByID(leader)
+	cosignerLeader := pv.getPeerCosigners().GetByID(leader)
 	if cosignerLeader == nil {
 		return true, nil, nil, stamp, fmt.Errorf("failed to find cosigner with id %d", leader)
 	}
@@ -633,6 +732,300 @@ func (pv *ThresholdValidator) proxyIfNecessary(
 	return true, signRes.Signature, signRes.VoteExtensionSignature, stamp, nil
 }
 
+// verifyPartialSignatures verifies the partial signatures of cosigner before they are combined, and blames
+// the cosigner if one is invalid. Partial signatures which cannot be verified, e.g. from cosigners on older
+// releases, are accepted, and only the combined signature is verified.
+func (pv *ThresholdValidator) verifyPartialSignatures(
+	chainID string,
+	cosigner Cosigner,
+	nonces, voteExtNonces *CosignerUUIDNonces,
+	signBytes, voteExtensionSignBytes []byte,
+	res *CosignerSignResponse,
+) error {
+	err := pv.myCosigner.VerifyPartialSignature(chainID, nonces, signBytes, PartialSignature{
+		ID:        cosigner.GetID(),
+		Signature: res.Signature,
+	})
+	if err == nil && voteExtNonces != nil {
+		err = pv.myCosigner.VerifyPartialSignature(chainID, voteExtNonces, voteExtensionSignBytes, PartialSignature{
+			ID:        cosigner.GetID(),
+			Signature: res.VoteExtensionSignature,
+		})
+	}
+
+	var partialErr *PartialSignatureError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &partialErr):
+		pv.blameCosigner(chainID, cosigner, partialErr)
+		return err
+	default:
+		pv.logger.Debug("Partial signature not verified", "chain_id", chainID, "cosigner", cosigner.GetID(), "err", err)
+		return nil
+	}
+}
+
+// blameNonceDealers blames the cosigners which dealt nonce shares that do not combine to their nonce,
+// once the partial signatures failed to combine to a valid signature.
+func (pv *ThresholdValidator) blameNonceDealers(
+	chainID string,
+	nonces *CosignerUUIDNonces,
+	signatures []PartialSignature,
+) {
+	dealings, err := nonceDealings(nonces)
+	if err != nil {
+		return
+	}
+	ids := make([]int, len(signatures))
+	for i, sig := range signatures {
+		ids[i] = sig.ID
+	}
+	for _, id := range faultyNonceDealers(dealings, ids) {
+		var cosigner Cosigner = pv.myCosigner
+		if id != pv.myCosigner.GetID() {
+			if cosigner = pv.getPeerCosigners().GetByID(id); cosigner == nil {
+				continue
+			}
+		}
+		pv.blameCosigner(chainID, cosigner, &PartialSignatureError{ID: id, Reason: partialSignatureReasonDealer})
+	}
+}
+
+// blameCosigner records a cosigner which caused an invalid signature, so it is picked last for later blocks.
+func (pv *ThresholdValidator) blameCosigner(chainID string, cosigner Cosigner, err *PartialSignatureError) {
+	totalFaultyPartialSignatures.WithLabelValues(cosigner.GetAddress(), err.Reason).Inc()
+	// an honest cosigner answers a retry of a block it already signed with its earlier partial signature,
+	// made with other nonces, so that is excluded from the block without marking the cosigner faulty.
+	if err.Reason != partialSignatureReasonNonce {
+		pv.cosignerHealth.MarkFaulty(cosigner)
+	}
+	pv.logger.Error(
+		"Blamed cosigner for invalid partial signature",
+		"chain_id", chainID,
+		"cosigner", cosigner.GetID(),
+		"reason", err.Reason,
+		"err", err,
+	)
+}
+
+// signFROST signs block with the FROST protocol. The leader takes a preprocessed commitment of each signer,
+// and requests the signature shares of all signers in a single round. FROST binds the signature shares to the
+// set of signers, so a signer which fails is replaced, and all signers sign again with fresh nonces.
+func (pv *ThresholdValidator) signFROST(
+	ctx context.Context,
+	log log.Logger,
+	chainID string,
+	block Block,
+	hrst HRSTKey,
+	hasVoteExtensions bool,
+) ([]byte, []byte, []int, error) {
+	threshold := pv.config.Config.ChainThreshold(chainID, pv.getThreshold())
+
+	cosignersOrderedByFastest := pv.cosignerHealth.GetFastest()
+	if len(cosignersOrderedByFastest) < threshold-1 {
+		totalInsufficientCosigners.Inc()
+		return nil, nil, nil, errors.New("not enough cosigners")
+	}
+	signers := make([]Cosigner, threshold)
+	signers[0] = pv.myCosigner
+	copy(signers[1:], cosignersOrderedByFastest[:threshold-1])
+	nextFastestCosignerIndex := threshold - 1
+
+	for {
+		signature, voteExtSig, failed, err := pv.signFROSTRound(ctx, log, chainID, block, hrst, signers, hasVoteExtensions)
+		if err == nil {
+			ids := make([]int, len(signers))
+			for i, cosigner := range signers {
+				ids[i] = cosigner.GetID()
+			}
+			return signature, voteExtSig, ids, nil
+		}
+		if failed <= 0 || nextFastestCosignerIndex >= len(cosignersOrderedByFastest) {
+			return nil, nil, nil, err
+		}
+
+		log.Error(
+			"Signing again with FROST without cosigner",
+			"cosigner", signers[failed].GetID(),
+			"err", err,
+		)
+		signers[failed] = cosignersOrderedByFastest[nextFastestCosignerIndex]
+		nextFastestCosignerIndex++
+	}
+}
+
+// signFROSTRound signs block with FROST by signers, where signers[0] is this cosigner. When a signer fails,
+// its index in signers is returned with the error, or -1 if the failure can not be attributed to a signer.
+func (pv *ThresholdValidator) signFROSTRound(
+	ctx context.Context,
+	log log.Logger,
+	chainID string,
+	block Block,
+	hrst HRSTKey,
+	signers []Cosigner,
+	hasVoteExtensions bool,
+) ([]byte, []byte, int, error) {
+	participants := make([]FROSTParticipant, len(signers))
+	for i, cosigner := range signers {
+		participant, ok := cosigner.(FROSTParticipant)
+		if !ok {
+			return nil, nil, i, fmt.Errorf("cosigner %d can not sign with FROST", cosigner.GetID())
+		}
+		participants[i] = participant
+	}
+
+	var failedMu sync.Mutex
+	failed := -1
+	fail := func(i int, err error) error {
+		failedMu.Lock()
+		defer failedMu.Unlock()
+		if failed == -1 {
+			failed = i
+		}
+		return err
+	}
+
+	// the commitments of the signers were preprocessed, so they are usually taken from the cache.
+	commitments := make(FROSTCommitments, len(signers))
+	var voteExtCommitments FROSTCommitments
+	if hasVoteExtensions {
+		voteExtCommitments = make(FROSTCommitments, len(signers))
+	}
+	var eg errgroup.Group
+	for i, participant := range participants {
+		i, participant := i, participant
+		eg.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, pv.grpcTimeout)
+			defer cancel()
+
+			var err error
+			if commitments[i], err = pv.frostCommitments.Take(ctx, participant); err != nil {
+				return fail(i, fmt.Errorf("failed to get FROST commitment of cosigner %d: %w", participant.GetID(), err))
+			}
+			if hasVoteExtensions {
+				if voteExtCommitments[i], err = pv.frostCommitments.Take(ctx, participant); err != nil {
+					return fail(i, fmt.Errorf("failed to get FROST commitment of cosigner %d: %w", participant.GetID(), err))
+				}
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, failed, err
+	}
+
+	pkg, pubShares, err := pv.myCosigner.frostSigningPackage(chainID, commitments, block.SignBytes)
+	if err != nil {
+		return nil, nil, -1, err
+	}
+	var voteExtPkg *frostSigningPackage
+	if hasVoteExtensions {
+		if voteExtPkg, _, err = pv.myCosigner.frostSigningPackage(
+			chainID, voteExtCommitments, block.VoteExtensionSignBytes,
+		); err != nil {
+			return nil, nil, -1, err
+		}
+	}
+
+	shares := make(map[int][]byte, len(signers))
+	voteExtShares := make(map[int][]byte, len(signers))
+	var sharesMu sync.Mutex
+
+	var signEg errgroup.Group
+	for i, participant := range participants {
+		i, participant, cosigner := i, participant, signers[i]
+		signEg.Go(func() error {
+			signCtx, cancel := context.WithTimeout(ctx, pv.grpcTimeout)
+			defer cancel()
+
+			peerStartTime := time.Now()
+
+			req := FROSTSignRequest{
+				ChainID:     chainID,
+				HRST:        hrst,
+				SignBytes:   block.SignBytes,
+				Commitments: commitments,
+			}
+			if hasVoteExtensions {
+				req.VoteExtensionSignBytes = block.VoteExtensionSignBytes
+				req.VoteExtensionCommitments = voteExtCommitments
+			}
+
+			res, err := participant.FROSTSign(signCtx, req)
+			if err != nil {
+				log.Error(
+					"Cosigner failed to sign with FROST",
+					"cosigner", cosigner.GetID(),
+					"err", err.Error(),
+				)
+
+				if strings.Contains(err.Error(), errUnknownFROSTNonce) {
+					pv.frostCommitments.Clear(cosigner.GetID())
+				}
+
+				if i > 0 {
+					if c := status.Code(err); c == codes.DeadlineExceeded || c == codes.NotFound || c == codes.Unavailable {
+						pv.cosignerHealth.MarkUnhealthy(cosigner)
+						pv.frostCommitments.Clear(cosigner.GetID())
+					}
+				}
+
+				return fail(i, err)
+			}
+
+			if i > 0 {
+				timedCosignerSignLag.WithLabelValues(cosigner.GetAddress()).Observe(time.Since(peerStartTime).Seconds())
+			}
+
+			// an invalid signature share would fail the signature, so the cosigner is excluded.
+			err = pkg.verifyShare(cosigner.GetID(), pubShares, res.Signature)
+			if err == nil && hasVoteExtensions {
+				err = voteExtPkg.verifyShare(cosigner.GetID(), pubShares, res.VoteExtensionSignature)
+			}
+			var partialErr *PartialSignatureError
+			switch {
+			case errors.As(err, &partialErr):
+				pv.blameCosigner(chainID, cosigner, partialErr)
+				return fail(i, err)
+			case err != nil:
+				log.Debug("FROST signature share not verified", "cosigner", cosigner.GetID(), "err", err)
+			}
+
+			sharesMu.Lock()
+			defer sharesMu.Unlock()
+			shares[cosigner.GetID()] = res.Signature
+			voteExtShares[cosigner.GetID()] = res.VoteExtensionSignature
+			return nil
+		})
+	}
+	if err := signEg.Wait(); err != nil {
+		return nil, nil, failed, fmt.Errorf("error from cosigner(s): %w", err)
+	}
+
+	signature, err := pkg.aggregate(shares)
+	if err != nil {
+		return nil, nil, -1, err
+	}
+	if !pv.myCosigner.VerifySignature(chainID, block.SignBytes, signature) {
+		totalInvalidSignature.Inc()
+		return nil, nil, -1, errors.New("combined signature is not valid")
+	}
+
+	var voteExtSig []byte
+	if hasVoteExtensions {
+		if voteExtSig, err = voteExtPkg.aggregate(voteExtShares); err != nil {
+			return nil, nil, -1, err
+		}
+		if !pv.myCosigner.VerifySignature(chainID, block.VoteExtensionSignBytes, voteExtSig) {
+			totalInvalidSignature.Inc()
+			return nil, nil, -1, errors.New("combined signature for vote extension is not valid")
+		}
+	}
+
+	return signature, voteExtSig, -1, nil
+}
+
 func (pv *ThresholdValidator) Sign(
 	ctx context.Context,
 	chainID string,
@@ -652,6 +1045,18 @@ func (pv *ThresholdValidator) Sign(
 		return nil, nil, stamp, err
 	}
 
+	err := pv.config.Config.CheckSignPolicy(chainID, height)
+	if err == nil {
+		// freezes set at runtime are replicated to every cosigner through raft.
+		if freeze, ok := pv.leader.SignFreeze(chainID); ok {
+			err = freeze.Check(height, time.Now())
+		}
+	}
+	observeSignFreeze(chainID, err)
+	if err != nil {
+		return nil, nil, stamp, err
+	}
+
 	// Only the leader can execute this function. Followers can handle the requests,
 	// but they just need to proxy the request to the raft leader
 	isProxied, proxySig, proxyVoteExtSig, proxyStamp, err := pv.proxyIfNecessary(ctx, chainID, block)
@@ -682,24 +1087,69 @@ func (pv *ThresholdValidator) Sign(
 		return existingSignature, existingVoteExtSig, existingTimestamp, nil
 	}
 
-	numPeers := len(pv.peerCosigners)
-	total := uint8(numPeers + 1)
+	// Commit the HRS through raft before requesting any signature shares,
+	// so that a newly elected leader can not sign at or below it.
+	if err := pv.leader.FenceSign(chainID, block.HRSKey()); err != nil {
+		if errors.Is(err, ErrSignFenced) {
+			totalSignFenced.WithLabelValues(chainID).Inc()
+		}
+		pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
+		return nil, nil, stamp, fmt.Errorf("failed to commit sign initiated state: %w", err)
+	}
+
+	// Hold the lease of an external fence, so that a standby cluster with the same key can not sign.
+	if pv.fence != nil {
+		fenceCtx, cancel := context.WithTimeout(ctx, pv.grpcTimeout)
+		err := pv.fence.Fence(fenceCtx, chainID, block.HRSKey())
+		cancel()
+		if err != nil {
+			if errors.Is(err, ErrSignLeaseRefused) {
+				totalSignLeaseRefused.WithLabelValues(chainID).Inc()
+			}
+			pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
+			return nil, nil, stamp, fmt.Errorf("failed to acquire signing lease: %w", err)
+		}
+	}
+
+	_, hasVoteExtensions, err := verifySignPayload(
+		chainID,
+		signBytes,
+		voteExtensionSignBytes,
+		pv.config.Config.ChainPrivValProtocol(chainID),
+	)
+	if err != nil {
+		pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
+		return nil, nil, stamp, fmt.Errorf("failed to verify payload: %w", err)
+	}
+
+	if pv.config.Config.ChainSigningProtocol(chainID) == SigningProtocolFROST {
+		signature, voteExtSig, signers, err := pv.signFROST(ctx, log, chainID, block, hrst, hasVoteExtensions)
+		if err != nil {
+			pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
+			return nil, nil, stamp, fmt.Errorf("error signing with FROST: %w", err)
+		}
+		return pv.completeSign(log, chainID, block, signature, voteExtSig, signers, timeStartSignBlock)
+	}
+
+	// signature shares are indexed by shard ID, which may not be contiguous after membership changes.
+	peerCosigners := pv.getPeerCosigners()
+	threshold := pv.config.Config.ChainThreshold(chainID, pv.getThreshold())
+	total := uint8(pv.myCosigner.GetID())
+	for _, peer := range peerCosigners {
+		if id := uint8(peer.GetID()); id > total {
+			total = id
+		}
+	}
 
 	peerStartTime := time.Now()
 
 	cosignersOrderedByFastest := pv.cosignerHealth.GetFastest()
-	cosignersForThisBlock := make([]Cosigner, pv.threshold)
+	cosignersForThisBlock := make([]Cosigner, threshold)
 	cosignersForThisBlock[0] = pv.myCosigner
-	copy(cosignersForThisBlock[1:], cosignersOrderedByFastest[:pv.threshold-1])
+	copy(cosignersForThisBlock[1:], cosignersOrderedByFastest[:threshold-1])
 
 	var dontIterateFastestCosigners bool
 
-	_, hasVoteExtensions, err := verifySignPayload(chainID, signBytes, voteExtensionSignBytes)
-	if err != nil {
-		pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
-		return nil, nil, stamp, fmt.Errorf("failed to verify payload: %w", err)
-	}
-
 	count := 1
 	if hasVoteExtensions {
 		count = 2
@@ -711,7 +1161,7 @@ func (pv *ThresholdValidator) Sign(
 		var fallbackRes *CosignersAndNonces
 		var fallbackErr error
 
-		fallbackRes, fallbackErr = pv.getNoncesFallback(ctx, count)
+		fallbackRes, fallbackErr = pv.getNoncesFallback(ctx, count, threshold)
 		if fallbackErr != nil {
 			pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
 			return nil, nil, stamp, fmt.Errorf("failed to get nonces: %w", errors.Join(err, fallbackErr))
@@ -759,7 +1209,7 @@ func (pv *ThresholdValidator) Sign(
 		}
 	}
 
-	nextFastestCosignerIndex := pv.threshold - 1
+	nextFastestCosignerIndex := threshold - 1
 	var nextFastestCosignerIndexMu sync.Mutex
 	getNextFastestCosigner := func() Cosigner {
 		nextFastestCosignerIndexMu.Lock()
@@ -774,7 +1224,7 @@ func (pv *ThresholdValidator) Sign(
 
 	timedSignBlockThresholdLag.Observe(time.Since(timeStartSignBlock).Seconds())
 
-	for _, peer := range pv.peerCosigners {
+	for _, peer := range peerCosigners {
 		missedNonces.WithLabelValues(peer.GetAddress()).Set(0)
 		timedCosignerNonceLag.WithLabelValues(peer.GetAddress()).Observe(time.Since(peerStartTime).Seconds())
 	}
@@ -785,6 +1235,9 @@ func (pv *ThresholdValidator) Sign(
 		cosignersForThisBlockInt[i] = cosigner.GetID()
 	}
 
+	// cosigners which replace a cosigner for this block sign with the nonces of the cosigners picked first.
+	nonceDealers := nonces.Dealers()
+
 	// destination for share signatures
 	shareSignatures := make([][]byte, total)
 	voteExtShareSignatures := make([][]byte, total)
@@ -800,10 +1253,11 @@ func (pv *ThresholdValidator) Sign(
 				peerStartTime := time.Now()
 
 				sigReq := CosignerSetNoncesAndSignRequest{
-					ChainID:   chainID,
-					Nonces:    nonces.For(cosigner.GetID()),
-					HRST:      hrst,
-					SignBytes: signBytes,
+					ChainID:      chainID,
+					Nonces:       nonces.For(cosigner.GetID()),
+					HRST:         hrst,
+					SignBytes:    signBytes,
+					NonceDealers: nonceDealers,
 				}
 
 				if voteExtNonces != nil {
@@ -847,6 +1301,24 @@ func (pv *ThresholdValidator) Sign(
 				if cosigner != pv.myCosigner {
 					timedCosignerSignLag.WithLabelValues(cosigner.GetAddress()).Observe(time.Since(peerStartTime).Seconds())
 				}
+
+				// an invalid partial signature would fail the combined signature, so the cosigner is excluded.
+				if err := pv.verifyPartialSignatures(
+					chainID, cosigner, nonces, voteExtNonces, signBytes, voteExtensionSignBytes, sigRes,
+				); err != nil {
+					if cosigner.GetID() == pv.myCosigner.GetID() {
+						return err
+					}
+
+					if dontIterateFastestCosigners {
+						cosigner = nil
+						continue
+					}
+
+					cosigner = getNextFastestCosigner()
+					continue
+				}
+
 				shareSignatures[cosigner.GetID()-1] = sigRes.Signature
 				voteExtShareSignatures[cosigner.GetID()-1] = sigRes.VoteExtensionSignature
 
@@ -864,7 +1336,7 @@ func (pv *ThresholdValidator) Sign(
 	timedSignBlockCosignerLag.Observe(time.Since(timeStartSignBlock).Seconds())
 
 	// collect all valid responses into array of partial signatures
-	shareSigs := make([]PartialSignature, 0, pv.threshold)
+	shareSigs := make([]PartialSignature, 0, threshold)
 	for idx, shareSig := range shareSignatures {
 		if len(shareSig) == 0 {
 			continue
@@ -881,7 +1353,7 @@ func (pv *ThresholdValidator) Sign(
 		})
 	}
 
-	if len(shareSigs) < pv.threshold {
+	if len(shareSigs) < threshold {
 		totalInsufficientCosigners.Inc()
 		pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
 		return nil, nil, stamp, errors.New("not enough cosigners")
@@ -897,6 +1369,7 @@ func (pv *ThresholdValidator) Sign(
 	// verify the combined signature before saving to watermark
 	if !pv.myCosigner.VerifySignature(chainID, signBytes, signature) {
 		totalInvalidSignature.Inc()
+		pv.blameNonceDealers(chainID, nonces, shareSigs)
 
 		pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
 		return nil, nil, stamp, errors.New("combined signature is not valid")
@@ -906,7 +1379,7 @@ func (pv *ThresholdValidator) Sign(
 
 	if hasVoteExtensions {
 		// collect all valid responses into array of partial signatures
-		voteExtShareSigs := make([]PartialSignature, 0, pv.threshold)
+		voteExtShareSigs := make([]PartialSignature, 0, threshold)
 		for idx, shareSig := range voteExtShareSignatures {
 			if len(shareSig) == 0 {
 				continue
@@ -923,7 +1396,7 @@ func (pv *ThresholdValidator) Sign(
 			})
 		}
 
-		if len(voteExtShareSigs) < pv.threshold {
+		if len(voteExtShareSigs) < threshold {
 			totalInsufficientCosigners.Inc()
 			pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
 			return nil, nil, stamp, errors.New("not enough cosigners for vote extension")
@@ -939,20 +1412,40 @@ func (pv *ThresholdValidator) Sign(
 		// verify the combined signature before saving to watermark
 		if !pv.myCosigner.VerifySignature(chainID, voteExtensionSignBytes, voteExtSig) {
 			totalInvalidSignature.Inc()
+			pv.blameNonceDealers(chainID, voteExtNonces, voteExtShareSigs)
 
 			pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
 			return nil, nil, stamp, errors.New("combined signature for vote extension is not valid")
 		}
 	}
 
+	signers := make([]int, len(shareSigs))
+	for i, s := range shareSigs {
+		signers[i] = s.ID
+	}
+
+	return pv.completeSign(log, chainID, block, signature, voteExtSig, signers, timeStartSignBlock)
+}
+
+// completeSign saves and emits the signature of block as the new high watermark, and records it in the journal.
+func (pv *ThresholdValidator) completeSign(
+	log log.Logger,
+	chainID string,
+	block Block,
+	signature, voteExtSig []byte,
+	signers []int,
+	timeStartSignBlock time.Time,
+) ([]byte, []byte, time.Time, error) {
+	stamp := block.Timestamp
+
 	newLss := ChainSignStateConsensus{
 		ChainID: chainID,
 		SignStateConsensus: SignStateConsensus{
-			Height:                 height,
-			Round:                  round,
-			Step:                   step,
+			Height:                 block.Height,
+			Round:                  block.Round,
+			Step:                   block.Step,
 			Signature:              signature,
-			SignBytes:              signBytes,
+			SignBytes:              block.SignBytes,
 			VoteExtensionSignature: voteExtSig,
 		},
 	}
@@ -961,12 +1454,12 @@ func (pv *ThresholdValidator) Sign(
 
 	// Err will be present if newLss is not above high watermark
 	css.lastSignStateMutex.Lock()
-	err = css.lastSignState.Save(newLss.SignStateConsensus, &pv.pendingDiskWG)
+	err := css.lastSignState.Save(newLss.SignStateConsensus, &pv.pendingDiskWG)
 	css.lastSignStateMutex.Unlock()
 	if err != nil {
 		if _, isSameHRSError := err.(*SameHRSError); !isSameHRSError {
 
-			pv.notifyBlockSignError(chainID, block.HRSKey(), signBytes)
+			pv.notifyBlockSignError(chainID, block.HRSKey(), block.SignBytes)
 			return nil, nil, stamp, fmt.Errorf("error saving last sign state: %w", err)
 		}
 	}
@@ -979,6 +1472,11 @@ func (pv *ThresholdValidator) Sign(
 		log.Error("Error emitting LSS", err.Error())
 	}
 
+	entry := NewAuditEntry(chainID, block, signature, voteExtSig)
+	entry.Leader = pv.myCosigner.GetID()
+	entry.Cosigners = signers
+	pv.journal.Record(entry)
+
 	timeSignBlock := time.Since(timeStartSignBlock)
 	timeSignBlockSec := timeSignBlock.Seconds()
 	timedSignBlockLag.Observe(timeSignBlockSec)
@@ -990,3 +1488,94 @@ func (pv *ThresholdValidator) Sign(
 
 	return signature, voteExtSig, stamp, nil
 }
+
+// SignRawBytes signs the domain separated rawBytes for uniqueID with the cosigners, if the chain policy allows it.
+// Raw bytes are not ordered like blocks, so any cosigner signs them without proxying to the leader. Every cosigner
+// checks the policy and records uniqueID before it signs, and any two sets of threshold cosigners share a cosigner,
+// so a unique ID can never be signed for two different raw bytes. Raw bytes are always signed with nonces, not FROST.
+func (pv *ThresholdValidator) SignRawBytes(
+	ctx context.Context,
+	chainID, uniqueID string,
+	rawBytes []byte,
+) ([]byte, error) {
+	if err := pv.LoadSignStateIfNecessary(chainID); err != nil {
+		return nil, err
+	}
+
+	err := pv.config.Config.CheckRawBytesPolicy(chainID, uniqueID)
+	if err == nil {
+		// freezes set at runtime are replicated to every cosigner through raft.
+		if freeze, ok := pv.leader.SignFreeze(chainID); ok {
+			err = freeze.Check(0, time.Now())
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := RawBytesSignBytes(uniqueID, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := pv.config.Config.ChainThreshold(chainID, pv.getThreshold())
+	cosignersAndNonces, err := pv.getNoncesFromCosigners(ctx, 1, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonces: %w", err)
+	}
+	nonces := cosignersAndNonces.Nonces[0]
+	nonceDealers := nonces.Dealers()
+
+	shareSigs := make([]PartialSignature, len(cosignersAndNonces.Cosigners))
+	var eg errgroup.Group
+	for i, cosigner := range cosignersAndNonces.Cosigners {
+		i, cosigner := i, cosigner
+		eg.Go(func() error {
+			signCtx, cancel := context.WithTimeout(ctx, pv.grpcTimeout)
+			defer cancel()
+
+			res, err := cosigner.SetNoncesAndSign(signCtx, CosignerSetNoncesAndSignRequest{
+				ChainID:          chainID,
+				Nonces:           nonces.For(cosigner.GetID()),
+				NonceDealers:     nonceDealers,
+				RawBytesUniqueID: uniqueID,
+				RawBytes:         rawBytes,
+			})
+			if err != nil {
+				return fmt.Errorf("cosigner %d failed to sign raw bytes: %w", cosigner.GetID(), err)
+			}
+			if err := pv.verifyPartialSignatures(chainID, cosigner, nonces, nil, signBytes, nil, res); err != nil {
+				return err
+			}
+			shareSigs[i] = PartialSignature{
+				ID:        cosigner.GetID(),
+				Signature: res.Signature,
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, fmt.Errorf("error from cosigner(s): %w", err)
+	}
+
+	signature, err := pv.myCosigner.CombineSignatures(chainID, shareSigs)
+	if err != nil {
+		return nil, fmt.Errorf("error combining signatures: %w", err)
+	}
+	if !pv.myCosigner.VerifySignature(chainID, signBytes, signature) {
+		totalInvalidSignature.Inc()
+		pv.blameNonceDealers(chainID, nonces, shareSigs)
+		return nil, errors.New("combined signature is not valid")
+	}
+
+	entry := NewRawBytesAuditEntry(chainID, uniqueID, signBytes, signature)
+	entry.Leader = pv.myCosigner.GetID()
+	entry.Cosigners = make([]int, len(shareSigs))
+	for i, s := range shareSigs {
+		entry.Cosigners[i] = s.ID
+	}
+	pv.journal.Record(entry)
+
+	pv.logger.Info("Signed raw bytes", "chain_id", chainID, "unique_id", uniqueID)
+	return signature, nil
+}
@@ -49,11 +49,13 @@ func loadKeyForLocalCosigner(
 	pubKey cometcrypto.PubKey,
 	chainID string,
 	privateShard []byte,
+	pubShares [][]byte,
 ) error {
 	key := CosignerEd25519Key{
 		PubKey:       pubKey,
 		PrivateShard: privateShard,
 		ID:           cosigner.GetID(),
+		PubShares:    pubShares,
 	}
 
 	keyBz, err := key.MarshalJSON()
@@ -338,7 +340,152 @@ func testThresholdValidator(t *testing.T, threshold, total uint8) {
 	}
 }
 
-func getTestLocalCosigners(t *testing.T, threshold, total uint8) ([]*LocalCosigner, cometcrypto.PubKey) {
+// faultyCosigner corrupts its partial signatures.
+type faultyCosigner struct {
+	Cosigner
+}
+
+func (c *faultyCosigner) SetNoncesAndSign(
+	ctx context.Context,
+	req CosignerSetNoncesAndSignRequest,
+) (*CosignerSignResponse, error) {
+	res, err := c.Cosigner.SetNoncesAndSign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.Signature[len(res.Signature)-1] ^= 1
+	return res, nil
+}
+
+func TestThresholdValidatorBlamesFaultyCosigner(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+	// the sign states are saved in the background, and must be written before the test removes its directory.
+	for _, cosigner := range cosigners {
+		defer cosigner.waitForSignStatesToFlushToDisk()
+	}
+
+	faulty := &faultyCosigner{Cosigner: cosigners[1]}
+	leader := &MockLeader{id: 1}
+
+	validator := NewThresholdValidator(
+		cometlog.NewNopLogger(),
+		cosigners[0].config,
+		2,
+		time.Second,
+		1,
+		cosigners[0],
+		[]Cosigner{faulty, cosigners[2]},
+		leader,
+	)
+	defer validator.Stop()
+
+	leader.leader = validator
+
+	// the faulty cosigner is the fastest, so it is picked first.
+	validator.cosignerHealth.rtt = map[int]int64{2: 100, 3: 200}
+
+	ctx := context.Background()
+	require.NoError(t, validator.LoadSignStateIfNecessary(testChainID))
+	validator.nonceCache.LoadN(ctx, 1)
+
+	block := ProposalToBlock(testChainID, &cometproto.Proposal{
+		Height: 1,
+		Round:  0,
+		Type:   cometproto.ProposalType,
+	})
+
+	// the invalid partial signature is excluded, and cosigner 3 signs in its place.
+	signature, _, _, err := validator.Sign(ctx, testChainID, block)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(block.SignBytes, signature))
+
+	require.True(t, validator.cosignerHealth.IsFaulty(2))
+	require.False(t, validator.cosignerHealth.IsFaulty(3))
+	require.Equal(t, 3, validator.cosignerHealth.GetFastest()[0].GetID())
+}
+
+// newTestRawBytesValidator returns a ThresholdValidator led by cosigners[0], with all other cosigners as peers,
+// where every cosigner allows raw bytes with the oracle/ prefix for testChainID, and none for testChainID2.
+func newTestRawBytesValidator(t testing.TB, cosigners []*LocalCosigner, threshold int) *ThresholdValidator {
+	peers := make([]Cosigner, 0, len(cosigners)-1)
+	for _, cosigner := range cosigners {
+		cosigner.config.Config.Chains = ChainsConfig{
+			{ChainID: testChainID, RawBytes: &RawBytesConfig{UniqueIDPrefixes: []string{"oracle/"}}},
+			{ChainID: testChainID2},
+		}
+		if cosigner != cosigners[0] {
+			peers = append(peers, cosigner)
+		}
+	}
+
+	leader := &MockLeader{id: 1}
+	validator := NewThresholdValidator(
+		cometlog.NewNopLogger(),
+		cosigners[0].config,
+		threshold,
+		time.Second,
+		1,
+		cosigners[0],
+		peers,
+		leader,
+	)
+	t.Cleanup(validator.Stop)
+	leader.leader = validator
+	return validator
+}
+
+func TestThresholdValidatorSignRawBytes(t *testing.T) {
+	cosigners, pubKey := getTestLocalCosigners(t, 2, 3)
+	validator := newTestRawBytesValidator(t, cosigners, 2)
+
+	journalFile := filepath.Join(t.TempDir(), "audit_journal.jsonl")
+	journal, err := OpenAuditJournal(cometlog.NewNopLogger(), journalFile)
+	require.NoError(t, err)
+	validator.SetAuditJournal(journal)
+
+	ctx := context.Background()
+
+	sig, err := validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("price"))
+	require.NoError(t, err)
+	signBytes, err := RawBytesSignBytes("oracle/1", []byte("price"))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(signBytes, sig))
+
+	// the same raw bytes may be signed again for a unique ID, but not different raw bytes,
+	// which the cosigners that signed the unique ID refuse.
+	_, err = validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("price"))
+	require.NoError(t, err)
+	_, err = validator.SignRawBytes(ctx, testChainID, "oracle/1", []byte("other price"))
+	require.ErrorIs(t, err, ErrRawBytesConflict)
+
+	// every raw bytes signature is journaled, but not the refused ones.
+	var entries []AuditEntry
+	require.NoError(t, ReadAuditJournal(journalFile, func(e AuditEntry) error {
+		entries = append(entries, e)
+		return nil
+	}))
+	require.Len(t, entries, 2)
+	signBytesHash := sha256.Sum256(signBytes)
+	require.Equal(t, testChainID, entries[0].ChainID)
+	require.Equal(t, "raw_bytes", entries[0].Type)
+	require.Equal(t, "oracle/1", entries[0].UniqueID)
+	require.Equal(t, signBytesHash[:], []byte(entries[0].SignBytesHash))
+	require.Equal(t, sig, entries[0].Signature)
+	require.Equal(t, cosigners[0].GetID(), entries[0].Leader)
+	require.Len(t, entries[0].Cosigners, 2)
+
+	_, err = validator.SignRawBytes(ctx, testChainID, "bridge/1", []byte("price"))
+	require.ErrorIs(t, err, ErrRawBytesNotAllowed)
+	_, err = validator.SignRawBytes(ctx, testChainID2, "oracle/1", []byte("price"))
+	require.ErrorIs(t, err, ErrRawBytesNotAllowed)
+
+	// cosigners check the policy themselves, so they refuse raw bytes which only the coordinator allows.
+	validator.config.Config.Chains[1].RawBytes = &RawBytesConfig{}
+	_, err = validator.SignRawBytes(ctx, testChainID2, "oracle/1", []byte("price"))
+	require.ErrorIs(t, err, ErrRawBytesNotAllowed)
+}
+
+func getTestLocalCosigners(t testing.TB, threshold, total uint8) ([]*LocalCosigner, cometcrypto.PubKey) {
 	eciesKeys := make([]*ecies.PrivateKey, total)
 	pubKeys := make([]*ecies.PublicKey, total)
 	cosigners := make([]*LocalCosigner, total)
@@ -355,6 +502,10 @@ func getTestLocalCosigners(t *testing.T, threshold, total uint8) ([]*LocalCosign
 	privateKey := cometcryptoed25519.GenPrivKey()
 	privKeyBytes := privateKey[:]
 	privShards := tsed25519.DealShares(tsed25519.ExpandSecret(privKeyBytes[:32]), threshold, total)
+	pubShares := make([][]byte, total)
+	for i, shard := range privShards {
+		pubShares[i] = tsed25519.ScalarMultiplyBase(shard)
+	}
 
 	tmpDir := t.TempDir()
 
@@ -398,10 +549,10 @@ func getTestLocalCosigners(t *testing.T, threshold, total uint8) ([]*LocalCosign
 
 		cosigners[i] = cosigner
 
-		err = loadKeyForLocalCosigner(cosigner, privateKey.PubKey(), testChainID, privShards[i])
+		err = loadKeyForLocalCosigner(cosigner, privateKey.PubKey(), testChainID, privShards[i], pubShares)
 		require.NoError(t, err)
 
-		err = loadKeyForLocalCosigner(cosigner, privateKey.PubKey(), testChainID2, privShards[i])
+		err = loadKeyForLocalCosigner(cosigner, privateKey.PubKey(), testChainID2, privShards[i], pubShares)
 		require.NoError(t, err)
 	}
 
@@ -0,0 +1,76 @@
+package signer
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// removeStaleUnixSocket removes a unix socket left behind at path by an earlier run.
+// Any other file at path is refused, so that a misconfigured path never deletes it.
+func removeStaleUnixSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a unix socket, refusing to replace it", path)
+	}
+	return os.Remove(path)
+}
+
+// listenUnix listens on a unix socket at path with permissions mode, owned by group gid, or the
+// default group if gid is -1. The socket is created in a private directory and only moved to path
+// once its permissions are set, so it can never be connected to with wider permissions.
+func listenUnix(path string, mode os.FileMode, gid int) (net.Listener, error) {
+	if err := removeStaleUnixSocket(path); err != nil {
+		return nil, err
+	}
+
+	// MkdirTemp creates the directory with 0700 permissions.
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".sock-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmp := filepath.Join(dir, filepath.Base(path))
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmp, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// the socket is removed from path on close, rather than from the private directory.
+	listener.SetUnlinkOnClose(false)
+
+	err = os.Chmod(tmp, mode)
+	if err == nil && gid != -1 {
+		err = os.Chown(tmp, -1, gid)
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return &unixListener{UnixListener: listener, path: path}, nil
+}
+
+// unixListener removes its socket when it is closed.
+type unixListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l *unixListener) Close() error {
+	err := l.UnixListener.Close()
+	if rmErr := removeStaleUnixSocket(l.path); err == nil {
+		err = rmErr
+	}
+	return err
+}
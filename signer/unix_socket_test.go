@@ -0,0 +1,60 @@
+package signer
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenUnix(t *testing.T) {
+	// unix socket paths are limited to ~100 characters, so avoid the long t.TempDir() path.
+	dir, err := os.MkdirTemp("", "horcrux")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, "test.sock")
+
+	listener, err := listenUnix(path, 0600, -1)
+	require.NoError(t, err)
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NotZero(t, fi.Mode()&os.ModeSocket)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// only the socket is left in the directory.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// the socket is removed on close.
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	// a socket left behind by an earlier run is replaced.
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	stale.SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	listener, err = listenUnix(path, 0660, os.Getgid())
+	require.NoError(t, err)
+	fi, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0660), fi.Mode().Perm())
+	require.NoError(t, listener.Close())
+
+	// any other file is never removed.
+	require.NoError(t, os.WriteFile(path, []byte("key"), 0600))
+	_, err = listenUnix(path, 0600, -1)
+	require.ErrorContains(t, err, "is not a unix socket")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("key"), bz)
+}